@@ -0,0 +1,184 @@
+package activities
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PeerDB-io/peer-flow/connectors"
+	"github.com/PeerDB-io/peer-flow/connectors/utils/deadletter"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	log "github.com/sirupsen/logrus"
+	"go.temporal.io/sdk/activity"
+)
+
+// syncRecords pushes a batch of records to a destination. When the mirror has a dead letter sink, the records
+// that fail to convert are sent to it, and a batch that the destination rejects because of some of its records is
+// split in halves until the records it rejects on their own are found and sent to the sink, so that the rest of
+// the batch is synced.
+func (a *FlowableActivity) syncRecords(
+	ctx context.Context,
+	conn *protos.FlowConnectionConfigs,
+	dest connectors.Connector,
+	destinationName string,
+	records *model.RecordBatch,
+) (*model.SyncResponse, error) {
+	req := &model.SyncRecordsRequest{
+		Records:       records,
+		FlowJobName:   conn.FlowJobName,
+		SyncMode:      conn.CdcSyncMode,
+		StagingPath:   conn.CdcStagingPath,
+		OutputFormat:  conn.CdcOutputFormat,
+		DirectApply:   conn.DirectApply,
+		SoftDelete:    conn.SoftDelete,
+		SystemColumns: conn.SystemColumns,
+		HistoryMode:   conn.HistoryMode,
+	}
+	if conn.DeadLetter == nil {
+		return dest.SyncRecords(req)
+	}
+
+	sink, err := deadletter.NewSink(conn.DeadLetter, a.CatalogMirrorMonitor)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dead letter sink: %w", err)
+	}
+	handler := deadletter.NewHandler(ctx, conn.DeadLetter, sink, conn.FlowJobName, destinationName,
+		len(records.Records))
+	req.DeadLetter = handler.Add
+
+	res, err := bisectSyncRecords(ctx, dest, req, handler)
+	if err != nil {
+		return nil, err
+	}
+	if handler.NumErrors() > 0 {
+		log.WithFields(log.Fields{
+			"flowName":    conn.FlowJobName,
+			"destination": destinationName,
+		}).Warnf("sent %d failed records of the batch to dead letters", handler.NumErrors())
+	}
+	return res, nil
+}
+
+// bisectMaxFailedSyncs is the number of failed syncs of a batch after which it fails as a whole, instead of
+// looking further for the records the destination rejects, as a batch with many of them takes about two failed
+// syncs per level of bisection for each.
+const bisectMaxFailedSyncs = 256
+
+// bisectSyncRecords syncs the records of a request. When the destination rejects them because of some of the
+// records, see deadletter.IsRecordError, they are split in halves that are synced in order, until the records
+// rejected on their own are found and sent to the dead letter sink. Any other error fails the whole batch,
+// which is retried, so that good records never go to dead letters because of a transient failure.
+func bisectSyncRecords(
+	ctx context.Context,
+	dest connectors.Connector,
+	req *model.SyncRecordsRequest,
+	handler *deadletter.Handler,
+) (*model.SyncResponse, error) {
+	b := &syncBisector{
+		ctx:             ctx,
+		dest:            dest,
+		handler:         handler,
+		failedSyncsLeft: bisectMaxFailedSyncs,
+	}
+	return b.sync(req, nil)
+}
+
+type syncBisector struct {
+	ctx             context.Context
+	dest            connectors.Connector
+	handler         *deadletter.Handler
+	failedSyncsLeft int
+}
+
+// sync syncs the records of a request. knownErr is set when the records are the second half of a batch that
+// failed while its first half synced at once, so they are known to fail and are split without trying them,
+// a single record is still tried before it goes to dead letters.
+func (b *syncBisector) sync(req *model.SyncRecordsRequest, knownErr error) (*model.SyncResponse, error) {
+	records := req.Records.Records
+	err := knownErr
+	if err == nil || len(records) == 1 {
+		res, syncErr := b.dest.SyncRecords(req)
+		if syncErr == nil {
+			return res, nil
+		}
+		err = syncErr
+		b.failedSyncsLeft--
+	}
+
+	if !deadletter.IsRecordError(err) || len(records) == 0 {
+		return nil, err
+	}
+	if len(records) == 1 {
+		if deadLetterErr := b.handler.Add(records[0], err); deadLetterErr != nil {
+			return nil, deadLetterErr
+		}
+		// the destination doesn't know the record was handled, so its sync state stays before it
+		// and the record is only sent again, to the same letter, if no later record is synced.
+		return nil, nil
+	}
+	if b.failedSyncsLeft <= 0 {
+		return nil, fmt.Errorf("too many failed syncs looking for the records rejected by the destination: %w", err)
+	}
+
+	mid := len(records) / 2
+	firstReq := *req
+	firstReq.Records = &model.RecordBatch{
+		Records:           records[:mid],
+		FirstCheckPointID: req.Records.FirstCheckPointID,
+		LastCheckPointID:  records[mid-1].GetCheckPointID(),
+	}
+	secondReq := *req
+	secondReq.Records = &model.RecordBatch{
+		Records:           records[mid:],
+		FirstCheckPointID: records[mid].GetCheckPointID(),
+		LastCheckPointID:  req.Records.LastCheckPointID,
+	}
+	log.WithFields(log.Fields{
+		"flowName": req.FlowJobName,
+	}).Warnf("failed to push %d records, retrying them in halves: %v", len(records), err)
+
+	failedSyncsLeft := b.failedSyncsLeft
+	firstRes, halfErr := b.sync(&firstReq, nil)
+	if halfErr != nil {
+		return nil, halfErr
+	}
+	activity.RecordHeartbeat(b.ctx, fmt.Sprintf("pushed %d records", len(firstReq.Records.Records)))
+
+	// the records that failed are in the second half when the first one synced at once.
+	var secondKnownErr error
+	if b.failedSyncsLeft == failedSyncsLeft {
+		secondKnownErr = err
+	}
+	secondRes, halfErr := b.sync(&secondReq, secondKnownErr)
+	if halfErr != nil {
+		return nil, halfErr
+	}
+	activity.RecordHeartbeat(b.ctx, fmt.Sprintf("pushed %d records", len(secondReq.Records.Records)))
+
+	return mergeSyncResponses(firstRes, secondRes), nil
+}
+
+// mergeSyncResponses combines the responses of consecutive syncs, either of which may be nil
+// when all of its records went to dead letters.
+func mergeSyncResponses(first *model.SyncResponse, second *model.SyncResponse) *model.SyncResponse {
+	if first == nil {
+		return second
+	}
+	if second == nil {
+		return first
+	}
+
+	tableNameRowsMapping := make(map[string]uint32)
+	for _, res := range []*model.SyncResponse{first, second} {
+		for table, rows := range res.TableNameRowsMapping {
+			tableNameRowsMapping[table] += rows
+		}
+	}
+	return &model.SyncResponse{
+		FirstSyncedCheckPointID: first.FirstSyncedCheckPointID,
+		LastSyncedCheckPointID:  second.LastSyncedCheckPointID,
+		NumRecordsSynced:        first.NumRecordsSynced + second.NumRecordsSynced,
+		CurrentSyncBatchID:      second.CurrentSyncBatchID,
+		TableNameRowsMapping:    tableNameRowsMapping,
+	}
+}
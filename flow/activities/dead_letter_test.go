@@ -0,0 +1,151 @@
+package activities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/connectors"
+	"github.com/PeerDB-io/peer-flow/connectors/utils/deadletter"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+type memorySink struct {
+	letters []*deadletter.Letter
+}
+
+func (s *memorySink) Write(_ context.Context, letter *deadletter.Letter) error {
+	s.letters = append(s.letters, letter)
+	return nil
+}
+
+// fakeDestination rejects the batches with any of its bad records, with err when set
+// and with a record error otherwise.
+type fakeDestination struct {
+	connectors.Connector
+	bad    map[int64]bool
+	err    error
+	calls  int
+	synced []int64
+}
+
+func (d *fakeDestination) SyncRecords(req *model.SyncRecordsRequest) (*model.SyncResponse, error) {
+	d.calls++
+	for _, record := range req.Records.Records {
+		if !d.bad[record.GetCheckPointID()] {
+			continue
+		}
+		if d.err != nil {
+			return nil, d.err
+		}
+		return nil, model.NewRecordError(fmt.Errorf("invalid value in record %d", record.GetCheckPointID()))
+	}
+
+	for _, record := range req.Records.Records {
+		d.synced = append(d.synced, record.GetCheckPointID())
+	}
+	return &model.SyncResponse{
+		FirstSyncedCheckPointID: req.Records.FirstCheckPointID,
+		LastSyncedCheckPointID:  req.Records.LastCheckPointID,
+		NumRecordsSynced:        int64(len(req.Records.Records)),
+		TableNameRowsMapping:    map[string]uint32{"users": uint32(len(req.Records.Records))},
+	}, nil
+}
+
+func runBisectSyncRecords(dest *fakeDestination, numRecords int) (*model.SyncResponse, *memorySink, error) {
+	records := make([]model.Record, 0, numRecords)
+	for i := 1; i <= numRecords; i++ {
+		records = append(records, &model.InsertRecord{DestinationTableName: "users", CheckPointID: int64(i)})
+	}
+	req := &model.SyncRecordsRequest{
+		FlowJobName: "flow",
+		Records: &model.RecordBatch{
+			Records:           records,
+			FirstCheckPointID: 1,
+			LastCheckPointID:  int64(numRecords),
+		},
+	}
+
+	sink := &memorySink{}
+	var res *model.SyncResponse
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	activity := func(ctx context.Context) error {
+		handler := deadletter.NewHandler(ctx, &protos.DeadLetterConfig{}, sink, "flow", "dest", numRecords)
+		var err error
+		res, err = bisectSyncRecords(ctx, dest, req, handler)
+		return err
+	}
+	env.RegisterActivity(activity)
+	_, err := env.ExecuteActivity(activity)
+	return res, sink, err
+}
+
+func TestBisectSyncRecords(t *testing.T) {
+	dest := &fakeDestination{bad: map[int64]bool{3: true, 11: true}}
+	res, sink, err := runBisectSyncRecords(dest, 16)
+	require.NoError(t, err)
+
+	require.Len(t, sink.letters, 2)
+	require.Equal(t, int64(3), sink.letters[0].LSN)
+	require.Equal(t, int64(11), sink.letters[1].LSN)
+	require.Equal(t, int64(14), res.NumRecordsSynced)
+	require.Equal(t, int64(16), res.LastSyncedCheckPointID)
+	require.Equal(t, map[string]uint32{"users": 14}, res.TableNameRowsMapping)
+	require.Len(t, dest.synced, 14)
+	// halves known to fail aren't tried as a whole.
+	require.Less(t, dest.calls, 2*2*4)
+}
+
+func TestBisectSyncRecordsOtherErrors(t *testing.T) {
+	// errors that aren't caused by the records fail the whole batch, nothing goes to dead letters.
+	dest := &fakeDestination{bad: map[int64]bool{3: true}, err: errors.New("connection reset by peer")}
+	_, sink, err := runBisectSyncRecords(dest, 16)
+	require.ErrorContains(t, err, "connection reset by peer")
+	require.Empty(t, sink.letters)
+	require.Equal(t, 1, dest.calls)
+}
+
+func TestBisectSyncRecordsTooManyFailures(t *testing.T) {
+	bad := make(map[int64]bool)
+	for i := int64(1); i <= 2*bisectMaxFailedSyncs; i++ {
+		bad[i] = true
+	}
+	dest := &fakeDestination{bad: bad}
+	_, _, err := runBisectSyncRecords(dest, 2*bisectMaxFailedSyncs)
+	require.ErrorContains(t, err, "too many failed syncs")
+	// a few more records are tried on their own than the limit, one per level of the bisection.
+	require.Less(t, dest.calls, bisectMaxFailedSyncs+16)
+}
+
+func TestMergeSyncResponses(t *testing.T) {
+	first := &model.SyncResponse{
+		FirstSyncedCheckPointID: 1,
+		LastSyncedCheckPointID:  5,
+		NumRecordsSynced:        5,
+		CurrentSyncBatchID:      7,
+		TableNameRowsMapping:    map[string]uint32{"users": 3, "orders": 2},
+	}
+	second := &model.SyncResponse{
+		FirstSyncedCheckPointID: 7,
+		LastSyncedCheckPointID:  9,
+		NumRecordsSynced:        3,
+		CurrentSyncBatchID:      8,
+		TableNameRowsMapping:    map[string]uint32{"users": 3},
+	}
+
+	require.Nil(t, mergeSyncResponses(nil, nil))
+	require.Same(t, first, mergeSyncResponses(first, nil))
+	require.Same(t, second, mergeSyncResponses(nil, second))
+	require.Equal(t, &model.SyncResponse{
+		FirstSyncedCheckPointID: 1,
+		LastSyncedCheckPointID:  9,
+		NumRecordsSynced:        8,
+		CurrentSyncBatchID:      8,
+		TableNameRowsMapping:    map[string]uint32{"users": 6, "orders": 2},
+	}, mergeSyncResponses(first, second))
+}
@@ -236,29 +236,40 @@ func (a *FlowableActivity) StartFlow(ctx context.Context, input *protos.StartFlo
 		return nil, nil
 	}
 
-	res, err := dest.SyncRecords(&model.SyncRecordsRequest{
-		Records:     records,
-		FlowJobName: input.FlowConnectionConfigs.FlowJobName,
-		SyncMode:    input.FlowConnectionConfigs.CdcSyncMode,
-		StagingPath: input.FlowConnectionConfigs.CdcStagingPath,
-	})
-	if err != nil {
-		log.Warnf("failed to push records: %v", err)
-		return nil, fmt.Errorf("failed to push records: %w", err)
+	// destinations that are ahead of the slowest one only get the records they haven't seen yet.
+	var res *model.SyncResponse
+	destRecords := records.RecordsAfterCheckpoint(
+		utils.GetCheckpoint(input.DestinationSyncStates[conn.Destination.Name]))
+	if len(destRecords.Records) > 0 {
+		res, err = a.syncRecords(ctx, conn, dest, conn.Destination.Name, destRecords)
+		if err != nil {
+			log.Warnf("failed to push records: %v", err)
+			return nil, fmt.Errorf("failed to push records: %w", err)
+		}
 	}
-	log.WithFields(log.Fields{
-		"flowName": input.FlowConnectionConfigs.FlowJobName,
-	}).Infof("pushed %d records", res.NumRecordsSynced)
+	// res is nil when the destination was already ahead or every record went to dead letters.
+	if res != nil {
+		log.WithFields(log.Fields{
+			"flowName": input.FlowConnectionConfigs.FlowJobName,
+		}).Infof("pushed %d records", res.NumRecordsSynced)
 
-	err = a.CatalogMirrorMonitor.
-		UpdateLatestLSNAtTargetForCDCFlow(ctx, input.FlowConnectionConfigs.FlowJobName,
-			pglogrepl.LSN(records.LastCheckPointID))
-	if err != nil {
-		return nil, err
+		err = a.CatalogMirrorMonitor.
+			UpdateLatestLSNAtTargetForCDCFlow(ctx, input.FlowConnectionConfigs.FlowJobName,
+				pglogrepl.LSN(records.LastCheckPointID))
+		if err != nil {
+			return nil, err
+		}
+		if res.TableNameRowsMapping != nil {
+			err = a.CatalogMirrorMonitor.AddCDCBatchTablesForFlow(ctx, input.FlowConnectionConfigs.FlowJobName,
+				res.CurrentSyncBatchID, res.TableNameRowsMapping)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
-	if res.TableNameRowsMapping != nil {
-		err = a.CatalogMirrorMonitor.AddCDCBatchTablesForFlow(ctx, input.FlowConnectionConfigs.FlowJobName,
-			res.CurrentSyncBatchID, res.TableNameRowsMapping)
+
+	for _, additionalDest := range conn.AdditionalDestinations {
+		err = a.syncToAdditionalDestination(ctx, input, additionalDest, records)
 		if err != nil {
 			return nil, err
 		}
@@ -269,6 +280,57 @@ func (a *FlowableActivity) StartFlow(ctx context.Context, input *protos.StartFlo
 	return res, nil
 }
 
+// syncToAdditionalDestination pushes the records of a fanned out mirror to one of its
+// additional destinations, skipping the records that destination has already synced.
+func (a *FlowableActivity) syncToAdditionalDestination(
+	ctx context.Context,
+	input *protos.StartFlowInput,
+	peer *protos.Peer,
+	records *model.RecordBatch,
+) error {
+	destRecords := records.RecordsAfterCheckpoint(utils.GetCheckpoint(input.DestinationSyncStates[peer.Name]))
+	if len(destRecords.Records) == 0 {
+		log.WithFields(log.Fields{
+			"flowName":    input.FlowConnectionConfigs.FlowJobName,
+			"destination": peer.Name,
+		}).Info("no records to push")
+		return nil
+	}
+
+	dest, err := connectors.GetConnector(ctx, peer)
+	defer connectors.CloseConnector(dest)
+	if err != nil {
+		return fmt.Errorf("failed to get destination connector for %s: %w", peer.Name, err)
+	}
+
+	err = dest.InitializeTableSchema(input.FlowConnectionConfigs.TableNameSchemaMapping)
+	if err != nil {
+		return fmt.Errorf("failed to initialize table schema on %s: %w", peer.Name, err)
+	}
+
+	res, err := a.syncRecords(ctx, input.FlowConnectionConfigs, dest, peer.Name, destRecords)
+	if err != nil {
+		log.Warnf("failed to push records to %s: %v", peer.Name, err)
+		return fmt.Errorf("failed to push records to %s: %w", peer.Name, err)
+	}
+	if res != nil {
+		log.WithFields(log.Fields{
+			"flowName":    input.FlowConnectionConfigs.FlowJobName,
+			"destination": peer.Name,
+		}).Infof("pushed %d records", res.NumRecordsSynced)
+
+		err = a.CatalogMirrorMonitor.UpdateLatestLSNAtTargetForCDCFlowDestination(ctx,
+			input.FlowConnectionConfigs.FlowJobName, peer.Name, pglogrepl.LSN(records.LastCheckPointID),
+			res.CurrentSyncBatchID)
+		if err != nil {
+			return err
+		}
+	}
+	activity.RecordHeartbeat(ctx, fmt.Sprintf("pushed records to %s", peer.Name))
+
+	return nil
+}
+
 func (a *FlowableActivity) StartNormalize(
 	ctx context.Context,
 	input *protos.StartNormalizeInput,
@@ -282,12 +344,6 @@ func (a *FlowableActivity) StartNormalize(
 		return nil, fmt.Errorf("failed to get source connector: %w", err)
 	}
 
-	dest, err := connectors.GetConnector(ctx, conn.Destination)
-	defer connectors.CloseConnector(dest)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get destination connector: %w", err)
-	}
-
 	shutdown := utils.HeartbeatRoutine(ctx, 2*time.Minute, func() string {
 		return fmt.Sprintf("normalizing records from batch for job - %s", input.FlowConnectionConfigs.FlowJobName)
 	})
@@ -295,18 +351,9 @@ func (a *FlowableActivity) StartNormalize(
 		shutdown <- true
 	}()
 
-	log.Info("initializing table schema...")
-	err = dest.InitializeTableSchema(input.FlowConnectionConfigs.TableNameSchemaMapping)
+	res, err := normalizeDestination(ctx, input, conn.Destination)
 	if err != nil {
-		return nil, fmt.Errorf("failed to initialize table schema: %w", err)
-	}
-
-	res, err := dest.NormalizeRecords(&model.NormalizeRecordsRequest{
-		FlowJobName: input.FlowConnectionConfigs.FlowJobName,
-		SoftDelete:  input.FlowConnectionConfigs.SoftDelete,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to normalized records: %w", err)
+		return nil, err
 	}
 
 	err = a.CatalogMirrorMonitor.UpdateEndTimeForCDCBatch(ctx, input.FlowConnectionConfigs.FlowJobName,
@@ -320,6 +367,49 @@ func (a *FlowableActivity) StartNormalize(
 		log.Printf("normalized records from batch %d to batch %d\n", res.StartBatchID, res.EndBatchID)
 	}
 
+	for _, additionalDest := range conn.AdditionalDestinations {
+		additionalRes, err := normalizeDestination(ctx, input, additionalDest)
+		if err != nil {
+			return nil, err
+		}
+		if additionalRes != nil {
+			log.Printf("normalized records from batch %d to batch %d on %s\n",
+				additionalRes.StartBatchID, additionalRes.EndBatchID, additionalDest.Name)
+		}
+	}
+
+	return res, nil
+}
+
+// normalizeDestination normalizes the synced batches of a mirror on one of its destinations.
+func normalizeDestination(
+	ctx context.Context,
+	input *protos.StartNormalizeInput,
+	peer *protos.Peer,
+) (*model.NormalizeResponse, error) {
+	dest, err := connectors.GetConnector(ctx, peer)
+	defer connectors.CloseConnector(dest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get destination connector for %s: %w", peer.Name, err)
+	}
+
+	log.Info("initializing table schema...")
+	err = dest.InitializeTableSchema(input.FlowConnectionConfigs.TableNameSchemaMapping)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize table schema: %w", err)
+	}
+
+	res, err := dest.NormalizeRecords(&model.NormalizeRecordsRequest{
+		FlowJobName:          input.FlowConnectionConfigs.FlowJobName,
+		SoftDelete:           input.FlowConnectionConfigs.SoftDelete,
+		SystemColumns:        input.FlowConnectionConfigs.SystemColumns,
+		HistoryMode:          input.FlowConnectionConfigs.HistoryMode,
+		BigQueryTableOptions: input.FlowConnectionConfigs.BigqueryTableOptions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to normalized records on %s: %w", peer.Name, err)
+	}
+
 	return res, nil
 }
 
@@ -425,13 +515,12 @@ func (a *FlowableActivity) replicateQRepPartition(ctx context.Context,
 		return err
 	}
 	var goroutineErr error = nil
-	if config.SourcePeer.Type == protos.DBType_POSTGRES {
+	if streamConn, ok := srcConn.(connectors.QRepPullStreamConnector); ok {
 		stream = model.NewQRecordStream(bufferSize)
 		wg.Add(1)
 
-		pullPgRecords := func() {
-			pgConn := srcConn.(*connpostgres.PostgresConnector)
-			tmp, err := pgConn.PullQRepRecordStream(config, partition, stream)
+		pullRecords := func() {
+			tmp, err := streamConn.PullQRepRecordStream(config, partition, stream)
 			numRecords = int64(tmp)
 			if err != nil {
 				log.WithFields(log.Fields{
@@ -447,7 +536,7 @@ func (a *FlowableActivity) replicateQRepPartition(ctx context.Context,
 			wg.Done()
 		}
 
-		go pullPgRecords()
+		go pullRecords()
 	} else {
 		recordBatch, err := srcConn.PullQRepRecords(config, partition)
 		if err != nil {
@@ -558,5 +647,27 @@ func (a *FlowableActivity) DropFlow(ctx context.Context, config *protos.Shutdown
 	if err != nil {
 		return fmt.Errorf("failed to cleanup destination: %w", err)
 	}
+
+	for _, additionalDestPeer := range config.AdditionalDestinationPeers {
+		err = dropFlowDestination(ctx, additionalDestPeer, config.FlowJobName)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// dropFlowDestination cleans up one of the additional destinations of a fanned out mirror.
+func dropFlowDestination(ctx context.Context, peer *protos.Peer, flowJobName string) error {
+	dest, err := connectors.GetConnector(ctx, peer)
+	defer connectors.CloseConnector(dest)
+	if err != nil {
+		return fmt.Errorf("failed to get destination connector for %s: %w", peer.Name, err)
+	}
+
+	err = dest.SyncFlowCleanup(flowJobName)
+	if err != nil {
+		return fmt.Errorf("failed to cleanup destination %s: %w", peer.Name, err)
+	}
 	return nil
 }
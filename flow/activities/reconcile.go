@@ -0,0 +1,330 @@
+package activities
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/connectors"
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/shared"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// number of missing keys deleted from the destination at a time.
+	reconcileDeleteBatchSize = 10000
+	// keys are compared by buckets of their hashes, only the keys of buckets that differ are compared one by one.
+	reconcileNumBuckets = 4096
+	// upper bound of the number of source keys held in memory to compare the keys of differing buckets.
+	reconcileMaxKeysInMemory = 1_000_000
+)
+
+// ReconcileQRepDeletes removes the rows of the destination table whose upsert keys are no longer
+// present in the source table, by deleting or soft deleting them depending on the delete mode.
+// It returns the number of rows deleted.
+func (a *FlowableActivity) ReconcileQRepDeletes(ctx context.Context,
+	config *protos.QRepConfig,
+	runUUID string,
+) (int64, error) {
+	if config.DeleteMode == protos.QRepDeleteMode_QREP_DELETE_MODE_NONE {
+		return 0, nil
+	}
+	if config.WriteMode == nil || len(config.WriteMode.UpsertKeyColumns) == 0 {
+		return 0, fmt.Errorf("upsert key columns are required to reconcile deletes")
+	}
+	if config.DeleteMode == protos.QRepDeleteMode_QREP_DELETE_MODE_SOFT && config.SoftDeleteColumn == "" {
+		return 0, fmt.Errorf("soft delete column is required for soft delete mode")
+	}
+
+	ctx = context.WithValue(ctx, shared.EnableMetricsKey, a.EnableMetrics)
+	srcConn, err := connectors.GetConnector(ctx, config.SourcePeer)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get source connector: %w", err)
+	}
+	defer connectors.CloseConnector(srcConn)
+
+	destConn, err := connectors.GetConnector(ctx, config.DestinationPeer)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get destination connector: %w", err)
+	}
+	defer connectors.CloseConnector(destConn)
+
+	reconcileConn, ok := destConn.(connectors.QRepReconcileConnector)
+	if !ok {
+		return 0, fmt.Errorf("destination peer %s does not support reconciling deletes",
+			config.DestinationPeer.Name)
+	}
+
+	shutdown := utils.HeartbeatRoutine(ctx, 2*time.Minute, func() string {
+		return fmt.Sprintf("reconciling deletes for job - %s", config.FlowJobName)
+	})
+
+	defer func() {
+		shutdown <- true
+	}()
+
+	sourceKeys, err := sourceKeyPuller(srcConn, config)
+	if err != nil {
+		return 0, err
+	}
+	destinationKeys := func(fn func(record *model.QRecord) error) error {
+		stream := model.NewQRecordStream(shared.FetchAndChannelSize)
+		err := consumeKeyStream(stream, func() (int, error) {
+			return reconcileConn.PullQRepKeys(config, stream)
+		}, fn)
+		if err != nil {
+			return fmt.Errorf("failed to pull keys from destination: %w", err)
+		}
+		return nil
+	}
+	deleteKeys := func(keys []*model.QRecord) (int64, error) {
+		n, err := reconcileConn.DeleteQRepKeys(config, keys)
+		if err != nil {
+			return 0, fmt.Errorf("failed to delete keys from destination: %w", err)
+		}
+		return n, nil
+	}
+
+	numRowsDeleted, err := reconcileKeys(sourceKeys, destinationKeys, deleteKeys, reconcileMaxKeysInMemory)
+	if err != nil {
+		return 0, err
+	}
+
+	log.WithFields(log.Fields{
+		"flowName": config.FlowJobName,
+		"runUUID":  runUUID,
+	}).Infof("reconciled deletes, %d rows deleted from destination", numRowsDeleted)
+	return numRowsDeleted, nil
+}
+
+// keyPuller passes the keys of a table to fn, one at a time.
+type keyPuller func(fn func(record *model.QRecord) error) error
+
+// reconcileKeys deletes the keys of the destination that are missing at the source, it returns the number of rows
+// deleted. The keys of both sides are first summed up by buckets of their hashes, then the keys of the buckets that
+// differ are compared one by one, a group of buckets at a time so that at most maxKeysInMemory source keys are held
+// in memory, unless a single bucket has more.
+func reconcileKeys(sourceKeys keyPuller,
+	destinationKeys keyPuller,
+	deleteKeys func(keys []*model.QRecord) (int64, error),
+	maxKeysInMemory int64,
+) (int64, error) {
+	sourceDigests, err := digestKeys(sourceKeys)
+	if err != nil {
+		return 0, err
+	}
+	destinationDigests, err := digestKeys(destinationKeys)
+	if err != nil {
+		return 0, err
+	}
+
+	var groups [][]bool
+	var group []bool
+	var groupSize int64
+	for bucket := range sourceDigests {
+		if sourceDigests[bucket] == destinationDigests[bucket] {
+			continue
+		}
+		if group != nil && groupSize+sourceDigests[bucket].count > maxKeysInMemory {
+			groups = append(groups, group)
+			group = nil
+		}
+		if group == nil {
+			group = make([]bool, reconcileNumBuckets)
+			groupSize = 0
+		}
+		group[bucket] = true
+		groupSize += sourceDigests[bucket].count
+	}
+	if group != nil {
+		groups = append(groups, group)
+	}
+
+	var numRowsDeleted int64
+	for _, group := range groups {
+		keys := make(map[string]struct{})
+		err := sourceKeys(func(record *model.QRecord) error {
+			key := reconcileKey(record)
+			if group[keyBucket(key)] {
+				keys[key] = struct{}{}
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		// the keys of the destination are streamed, and the ones missing at the source are deleted in batches.
+		missingKeys := make([]*model.QRecord, 0, reconcileDeleteBatchSize)
+		deleteMissingKeys := func() error {
+			if len(missingKeys) == 0 {
+				return nil
+			}
+			n, err := deleteKeys(missingKeys)
+			if err != nil {
+				return err
+			}
+			numRowsDeleted += n
+			missingKeys = missingKeys[:0]
+			return nil
+		}
+		err = destinationKeys(func(record *model.QRecord) error {
+			key := reconcileKey(record)
+			if !group[keyBucket(key)] {
+				return nil
+			}
+			if _, ok := keys[key]; ok {
+				return nil
+			}
+			missingKeys = append(missingKeys, record)
+			if len(missingKeys) < reconcileDeleteBatchSize {
+				return nil
+			}
+			return deleteMissingKeys()
+		})
+		if err != nil {
+			return 0, err
+		}
+		if err := deleteMissingKeys(); err != nil {
+			return 0, err
+		}
+	}
+	return numRowsDeleted, nil
+}
+
+// bucketDigest sums up the keys of a bucket, buckets with the same keys have the same digest.
+type bucketDigest struct {
+	count int64
+	sum   uint64
+}
+
+func digestKeys(keys keyPuller) ([]bucketDigest, error) {
+	digests := make([]bucketDigest, reconcileNumBuckets)
+	err := keys(func(record *model.QRecord) error {
+		hash := keyHash(reconcileKey(record))
+		digest := &digests[hash%reconcileNumBuckets]
+		digest.count++
+		digest.sum += hash
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return digests, nil
+}
+
+func keyHash(key string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	return h.Sum64()
+}
+
+func keyBucket(key string) uint64 {
+	return keyHash(key) % reconcileNumBuckets
+}
+
+// sourceKeyPuller streams the upsert keys of the whole watermark table of the source.
+func sourceKeyPuller(srcConn connectors.Connector, config *protos.QRepConfig) (keyPuller, error) {
+	streamConn, ok := srcConn.(connectors.QRepPullStreamConnector)
+	if !ok {
+		return nil, fmt.Errorf("source peer %s does not support streaming keys to reconcile deletes",
+			config.SourcePeer.Name)
+	}
+
+	quotedColumns := make([]string, 0, len(config.WriteMode.UpsertKeyColumns))
+	for _, column := range config.WriteMode.UpsertKeyColumns {
+		if config.SourcePeer.Type == protos.DBType_BIGQUERY {
+			quotedColumns = append(quotedColumns, fmt.Sprintf("`%s`", column))
+		} else {
+			quotedColumns = append(quotedColumns, utils.QuoteIdentifier(column))
+		}
+	}
+	keysConfig := proto.Clone(config).(*protos.QRepConfig)
+	keysConfig.Query = fmt.Sprintf("SELECT %s FROM %s", strings.Join(quotedColumns, ", "), config.WatermarkTable)
+	keysConfig.WatermarkColumn = ""
+
+	return func(fn func(record *model.QRecord) error) error {
+		partition := &protos.QRepPartition{
+			PartitionId:        uuid.New().String(),
+			FullTablePartition: true,
+		}
+		stream := model.NewQRecordStream(shared.FetchAndChannelSize)
+		err := consumeKeyStream(stream, func() (int, error) {
+			return streamConn.PullQRepRecordStream(keysConfig, partition, stream)
+		}, fn)
+		if err != nil {
+			return fmt.Errorf("failed to pull keys from source: %w", err)
+		}
+		return nil
+	}, nil
+}
+
+// consumeKeyStream runs pull, which fills and closes the stream, and passes the records of the stream to fn.
+// The stream is drained when fn fails, so that pull doesn't block.
+func consumeKeyStream(
+	stream *model.QRecordStream,
+	pull func() (int, error),
+	fn func(record *model.QRecord) error,
+) error {
+	pullErr := make(chan error, 1)
+	go func() {
+		_, err := pull()
+		pullErr <- err
+	}()
+
+	var consumeErr error
+	for recordOrErr := range stream.Records {
+		if consumeErr != nil {
+			continue
+		}
+		if recordOrErr.Err != nil {
+			consumeErr = recordOrErr.Err
+			continue
+		}
+		consumeErr = fn(recordOrErr.Record)
+	}
+
+	if err := <-pullErr; err != nil {
+		return err
+	}
+	return consumeErr
+}
+
+// reconcileKey normalizes the values of a key so that keys read from the source and the destination
+// compare equal, as the same column can be read as different types by different connectors.
+func reconcileKey(record *model.QRecord) string {
+	parts := make([]string, 0, len(record.Entries))
+	for _, entry := range record.Entries {
+		var part string
+		switch v := entry.Value.(type) {
+		case nil:
+			part = ""
+		case time.Time:
+			part = v.UTC().Format(time.RFC3339Nano)
+		case [16]byte:
+			part = uuid.UUID(v).String()
+		case *big.Rat:
+			// numerics in decimal form like connectors reading them as strings or floats,
+			// a denominator of 10^n has at least n bits so no digits are lost.
+			part = v.FloatString(v.Denom().BitLen())
+			if strings.Contains(part, ".") {
+				part = strings.TrimRight(strings.TrimRight(part, "0"), ".")
+			}
+		case []byte:
+			part = hex.EncodeToString(v)
+		default:
+			part = fmt.Sprint(v)
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, "\x00")
+}
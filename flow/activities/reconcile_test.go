@@ -0,0 +1,87 @@
+package activities
+
+import (
+	"math/big"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func keyRecord(values ...interface{}) *model.QRecord {
+	record := model.NewQRecord(len(values))
+	for i, value := range values {
+		record.Set(i, qvalue.QValue{Value: value})
+	}
+	return record
+}
+
+func TestReconcileKey(t *testing.T) {
+	id := uuid.New()
+	ts := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	// the same key read as different types by different connectors.
+	require.Equal(t,
+		reconcileKey(keyRecord(id.String(), ts, "1.5")),
+		reconcileKey(keyRecord([16]byte(id), ts.In(time.FixedZone("UTC+2", 2*3600)), big.NewRat(3, 2))))
+	require.Equal(t, reconcileKey(keyRecord(int64(42))), reconcileKey(keyRecord(int32(42))))
+	require.Equal(t, reconcileKey(keyRecord(int64(42))), reconcileKey(keyRecord(big.NewRat(42, 1))))
+
+	// values are separated, so that composite keys don't collide.
+	require.NotEqual(t, reconcileKey(keyRecord("a", "bc")), reconcileKey(keyRecord("ab", "c")))
+	require.NotEqual(t, reconcileKey(keyRecord(nil, "a")), reconcileKey(keyRecord("a", nil)))
+}
+
+func TestReconcileKeys(t *testing.T) {
+	keyPullerOf := func(keys []int64) keyPuller {
+		return func(fn func(record *model.QRecord) error) error {
+			for _, key := range keys {
+				if err := fn(keyRecord(key)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	var sourceKeys, destinationKeys []int64
+	for i := int64(0); i < 2000; i++ {
+		destinationKeys = append(destinationKeys, i)
+		if i%7 != 0 {
+			sourceKeys = append(sourceKeys, i)
+		}
+	}
+
+	for _, maxKeysInMemory := range []int64{1, 50, reconcileMaxKeysInMemory} {
+		deleted := make([]int64, 0)
+		numRowsDeleted, err := reconcileKeys(keyPullerOf(sourceKeys), keyPullerOf(destinationKeys),
+			func(keys []*model.QRecord) (int64, error) {
+				for _, key := range keys {
+					deleted = append(deleted, key.Entries[0].Value.(int64))
+				}
+				return int64(len(keys)), nil
+			}, maxKeysInMemory)
+		require.NoError(t, err)
+
+		sort.Slice(deleted, func(i, j int) bool { return deleted[i] < deleted[j] })
+		expected := make([]int64, 0)
+		for i := int64(0); i < 2000; i += 7 {
+			expected = append(expected, i)
+		}
+		require.Equal(t, expected, deleted, "max keys in memory: %d", maxKeysInMemory)
+		require.Equal(t, int64(len(expected)), numRowsDeleted)
+	}
+
+	// nothing is compared one by one when all buckets match.
+	numRowsDeleted, err := reconcileKeys(keyPullerOf(sourceKeys), keyPullerOf(sourceKeys),
+		func(keys []*model.QRecord) (int64, error) {
+			t.Fatalf("unexpected delete of %d keys", len(keys))
+			return 0, nil
+		}, reconcileMaxKeysInMemory)
+	require.NoError(t, err)
+	require.Zero(t, numRowsDeleted)
+}
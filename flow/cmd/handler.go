@@ -84,6 +84,8 @@ func (h *FlowRequestHandler) CreateQRepFlow(
 		cfg,                       // workflow input
 		lastPartition,             // last partition
 		numPartitionsProcessed,    // number of partitions processed
+		nil,                       // initial state
+		nil,                       // no partitions pending from a previous run window
 	)
 	if err != nil {
 		return nil, fmt.Errorf("unable to start QRepFlow workflow: %w", err)
@@ -5,13 +5,20 @@ import (
 	"fmt"
 
 	"github.com/PeerDB-io/peer-flow/generated/protos"
+	util "github.com/PeerDB-io/peer-flow/utils"
 )
 
 const (
-	SyncDataFormatAvro    = "avro"
-	SyncDataFormatDefault = "default"
-	WriteModeAppend       = "append"
-	WriteModeUpsert       = "upsert"
+	SyncDataFormatAvro         = "avro"
+	SyncDataFormatDefault      = "default"
+	SyncDataFormatStorageWrite = "storage_write"
+	WriteModeAppend            = "append"
+	WriteModeUpsert            = "upsert"
+	OutputFormatAvro           = "avro"
+	OutputFormatParquet        = "parquet"
+	DeleteModeNone             = "none"
+	DeleteModeHard             = "hard"
+	DeleteModeSoft             = "soft"
 )
 
 type UnsupportedOptionError struct {
@@ -64,10 +71,66 @@ func genConfigForQRepFlow(
 		}
 	case SyncDataFormatDefault:
 		config.SyncMode = protos.QRepSyncMode_QREP_SYNC_MODE_MULTI_INSERT
+	case SyncDataFormatStorageWrite:
+		config.SyncMode = protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_WRITE
 	default:
 		return &UnsupportedOptionError{"sync_data_format", syncDataFormat}
 	}
 
+	if outputFormat, ok := flowOptions["output_format"].(string); ok {
+		switch outputFormat {
+		case OutputFormatAvro:
+			config.OutputFormat = protos.QRepOutputFormat_QREP_OUTPUT_FORMAT_AVRO
+		case OutputFormatParquet:
+			config.OutputFormat = protos.QRepOutputFormat_QREP_OUTPUT_FORMAT_PARQUET
+		default:
+			return &UnsupportedOptionError{"output_format", outputFormat}
+		}
+	}
+	if outputCompression, ok := flowOptions["output_compression"].(string); ok {
+		config.OutputCompression = outputCompression
+	}
+	if rowGroupSize, ok := flowOptions["parquet_row_group_size"].(float64); ok {
+		config.ParquetRowGroupSize = uint32(rowGroupSize)
+	}
+	if targetFileSize, ok := flowOptions["target_file_size_bytes"].(float64); ok {
+		config.TargetFileSizeBytes = uint64(targetFileSize)
+	}
+	if numObjects, ok := flowOptions["num_objects_per_partition"].(float64); ok {
+		config.NumObjectsPerPartition = uint32(numObjects)
+	}
+	if numKeyPartitions, ok := flowOptions["num_key_partitions"].(float64); ok {
+		config.NumKeyPartitions = uint32(numKeyPartitions)
+	}
+	if deleteMode, ok := flowOptions["delete_mode"].(string); ok {
+		switch deleteMode {
+		case DeleteModeNone:
+			config.DeleteMode = protos.QRepDeleteMode_QREP_DELETE_MODE_NONE
+		case DeleteModeHard:
+			config.DeleteMode = protos.QRepDeleteMode_QREP_DELETE_MODE_HARD
+		case DeleteModeSoft:
+			config.DeleteMode = protos.QRepDeleteMode_QREP_DELETE_MODE_SOFT
+		default:
+			return &UnsupportedOptionError{"delete_mode", deleteMode}
+		}
+	}
+	if softDeleteColumn, ok := flowOptions["soft_delete_column"].(string); ok {
+		config.SoftDeleteColumn = softDeleteColumn
+	}
+	if reconcileInterval, ok := flowOptions["reconcile_interval_seconds"].(float64); ok {
+		config.ReconcileIntervalSeconds = uint32(reconcileInterval)
+	}
+
+	if scheduleCron, ok := flowOptions["schedule_cron"].(string); ok {
+		config.ScheduleCron = scheduleCron
+	}
+	if runWindows, ok := flowOptions["run_windows"].(string); ok {
+		config.RunWindows = runWindows
+	}
+	if _, err := util.ParseSchedule(config.ScheduleCron, config.RunWindows); err != nil {
+		return fmt.Errorf("invalid schedule: %w", err)
+	}
+
 	mode, ok := flowOptions["mode"].(string)
 	if !ok {
 		return errors.New("mode must be a string")
@@ -8,9 +8,11 @@ import (
 	"reflect"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/bigquery/storage/managedwriter"
 	"cloud.google.com/go/storage"
 	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/connectors/utils/metrics"
@@ -44,6 +46,17 @@ const (
 	SyncRecordsBatchSize = 1024
 )
 
+// BigQuery types of the system columns of normalized tables.
+var systemColumnTypes = map[string]bigquery.FieldType{
+	utils.SyncedAtColumnName:        bigquery.TimestampFieldType,
+	utils.IsDeletedColumnName:       bigquery.BooleanFieldType,
+	utils.SourceLSNColumnName:       bigquery.IntegerFieldType,
+	utils.CommitTimestampColumnName: bigquery.TimestampFieldType,
+	utils.ValidFromColumnName:       bigquery.TimestampFieldType,
+	utils.ValidToColumnName:         bigquery.TimestampFieldType,
+	utils.IsCurrentColumnName:       bigquery.BooleanFieldType,
+}
+
 type BigQueryServiceAccount struct {
 	Type                    string `json:"type"`
 	ProjectID               string `json:"project_id"`
@@ -65,6 +78,10 @@ type BigQueryConnector struct {
 	storageClient          *storage.Client
 	tableNameSchemaMapping map[string]*protos.TableSchema
 	datasetID              string
+	serviceAccount         *BigQueryServiceAccount
+	// the Storage Write API client is only created by the first sync that uses it, see getWriteClient.
+	writeClient     *managedwriter.Client
+	writeClientLock sync.Mutex
 }
 
 type StagingBQRecord struct {
@@ -78,6 +95,8 @@ type StagingBQRecord struct {
 	batchID               int64     `bigquery:"_peerdb_batch_id"`
 	stagingBatchID        int64     `bigquery:"_peerdb_staging_batch_id"`
 	unchangedToastColumns string    `bigquery:"_peerdb_unchanged_toast_columns"`
+	sourceLSN             int64     `bigquery:"_peerdb_source_lsn"`
+	commitTimestamp       int64     `bigquery:"_peerdb_commit_ts"`
 }
 
 // Create BigQueryServiceAccount from BigqueryConfig
@@ -154,6 +173,25 @@ func (bqsa *BigQueryServiceAccount) CreateStorageClient(ctx context.Context) (*s
 	return client, nil
 }
 
+// CreateStorageWriteClient creates a new Storage Write API client from a BigQueryServiceAccount.
+func (bqsa *BigQueryServiceAccount) CreateStorageWriteClient(ctx context.Context) (*managedwriter.Client, error) {
+	bqsaJSON, err := bqsa.ToJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get json: %v", err)
+	}
+
+	client, err := managedwriter.NewClient(
+		ctx,
+		bqsa.ProjectID,
+		option.WithCredentialsJSON(bqsaJSON),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Storage Write client: %v", err)
+	}
+
+	return client, nil
+}
+
 // NewBigQueryConnector creates a new BigQueryConnector from a PeerConnectionConfig.
 func NewBigQueryConnector(ctx context.Context, config *protos.BigqueryConfig) (*BigQueryConnector, error) {
 	bqsa, err := NewBigQueryServiceAccount(config)
@@ -172,19 +210,42 @@ func NewBigQueryConnector(ctx context.Context, config *protos.BigqueryConfig) (*
 	}
 
 	return &BigQueryConnector{
-		ctx:           ctx,
-		bqConfig:      config,
-		client:        client,
-		datasetID:     config.GetDatasetId(),
-		storageClient: storageClient,
+		ctx:            ctx,
+		bqConfig:       config,
+		client:         client,
+		datasetID:      config.GetDatasetId(),
+		storageClient:  storageClient,
+		serviceAccount: bqsa,
 	}, nil
 }
 
+// getWriteClient returns the Storage Write API client of the connector, creating it on first use.
+func (c *BigQueryConnector) getWriteClient() (*managedwriter.Client, error) {
+	c.writeClientLock.Lock()
+	defer c.writeClientLock.Unlock()
+
+	if c.writeClient == nil {
+		writeClient, err := c.serviceAccount.CreateStorageWriteClient(c.ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Storage Write client: %w", err)
+		}
+		c.writeClient = writeClient
+	}
+	return c.writeClient, nil
+}
+
 // Close closes the BigQuery driver.
 func (c *BigQueryConnector) Close() error {
 	if c == nil || c.client == nil {
 		return nil
 	}
+	c.writeClientLock.Lock()
+	defer c.writeClientLock.Unlock()
+	if c.writeClient != nil {
+		if err := c.writeClient.Close(); err != nil {
+			log.Errorf("failed to close Storage Write client: %v", err)
+		}
+	}
 	return c.client.Close()
 }
 
@@ -400,6 +461,8 @@ func (r StagingBQRecord) Save() (map[string]bigquery.Value, string, error) {
 		"_peerdb_batch_id":                r.batchID,
 		"_peerdb_staging_batch_id":        r.stagingBatchID,
 		"_peerdb_unchanged_toast_columns": r.unchangedToastColumns,
+		"_peerdb_source_lsn":              r.sourceLSN,
+		"_peerdb_commit_ts":               r.commitTimestamp,
 	}, bigquery.NoDedupeID, nil
 }
 
@@ -428,6 +491,11 @@ func (c *BigQueryConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 	}
 	syncBatchID = syncBatchID + 1
 
+	err = c.addRawTableSystemColumns(req.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+
 	var res *model.SyncResponse
 	if req.SyncMode == protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO {
 		res, err = c.SyncRecordsViaAvro(req, rawTableName, syncBatchID)
@@ -441,6 +509,12 @@ func (c *BigQueryConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 			return nil, err
 		}
 	}
+	if req.SyncMode == protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_WRITE {
+		res, err = c.SyncRecordsViaStorageWrite(req, rawTableName, syncBatchID)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return res, nil
 }
@@ -473,7 +547,11 @@ func (c *BigQueryConnector) SyncRecordsViaSQL(req *model.SyncRecordsRequest,
 			//   3. _peerdb_data - itemsJSON of `r.Items`
 			itemsJSON, err := r.Items.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to create items to json: %v", err)
+				err = req.SkipFailedRecord(record, fmt.Errorf("failed to create items to json: %v", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			// append the row to the records
@@ -488,6 +566,8 @@ func (c *BigQueryConnector) SyncRecordsViaSQL(req *model.SyncRecordsRequest,
 				batchID:               syncBatchID,
 				stagingBatchID:        stagingBatchID,
 				unchangedToastColumns: utils.KeysToString(r.UnchangedToastColumns),
+				sourceLSN:             r.CheckPointID,
+				commitTimestamp:       r.CommitTime.UnixMicro(),
 			})
 			tableNameRowsMapping[r.DestinationTableName] += 1
 		case *model.UpdateRecord:
@@ -500,12 +580,20 @@ func (c *BigQueryConnector) SyncRecordsViaSQL(req *model.SyncRecordsRequest,
 
 			newItemsJSON, err := r.NewItems.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to create new items to json: %v", err)
+				err = req.SkipFailedRecord(record, fmt.Errorf("failed to create new items to json: %v", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			oldItemsJSON, err := r.OldItems.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to create old items to json: %v", err)
+				err = req.SkipFailedRecord(record, fmt.Errorf("failed to create old items to json: %v", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			// append the row to the records
@@ -520,6 +608,8 @@ func (c *BigQueryConnector) SyncRecordsViaSQL(req *model.SyncRecordsRequest,
 				batchID:               syncBatchID,
 				stagingBatchID:        stagingBatchID,
 				unchangedToastColumns: utils.KeysToString(r.UnchangedToastColumns),
+				sourceLSN:             r.CheckPointID,
+				commitTimestamp:       r.CommitTime.UnixMicro(),
 			})
 			tableNameRowsMapping[r.DestinationTableName] += 1
 		case *model.DeleteRecord:
@@ -532,7 +622,11 @@ func (c *BigQueryConnector) SyncRecordsViaSQL(req *model.SyncRecordsRequest,
 			// json.Marshal converts bytes in Hex automatically to BASE64 string.
 			itemsJSON, err := r.Items.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to create items to json: %v", err)
+				err = req.SkipFailedRecord(record, fmt.Errorf("failed to create items to json: %v", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			// append the row to the records
@@ -547,6 +641,8 @@ func (c *BigQueryConnector) SyncRecordsViaSQL(req *model.SyncRecordsRequest,
 				batchID:               syncBatchID,
 				stagingBatchID:        stagingBatchID,
 				unchangedToastColumns: utils.KeysToString(r.UnchangedToastColumns),
+				sourceLSN:             r.CheckPointID,
+				commitTimestamp:       r.CommitTime.UnixMicro(),
 			})
 
 			tableNameRowsMapping[r.DestinationTableName] += 1
@@ -584,7 +680,7 @@ func (c *BigQueryConnector) SyncRecordsViaSQL(req *model.SyncRecordsRequest,
 		chunk := records[i:end]
 		err = stagingInserter.Put(c.ctx, chunk)
 		if err != nil {
-			return nil, fmt.Errorf("failed to insert chunked rows into staging table: %v", err)
+			return nil, fmt.Errorf("failed to insert chunked rows into staging table: %w", err)
 		}
 	}
 
@@ -607,7 +703,7 @@ func (c *BigQueryConnector) SyncRecordsViaSQL(req *model.SyncRecordsRequest,
 	startTime := time.Now()
 	_, err = c.client.Query(strings.Join(stmts, "\n")).Read(c.ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute statements in a transaction: %v", err)
+		return nil, fmt.Errorf("failed to execute statements in a transaction: %w", err)
 	}
 
 	metrics.LogSyncMetrics(c.ctx, req.FlowJobName, int64(numRecords), time.Since(startTime))
@@ -622,12 +718,13 @@ func (c *BigQueryConnector) SyncRecordsViaSQL(req *model.SyncRecordsRequest,
 	}, nil
 }
 
-func (c *BigQueryConnector) SyncRecordsViaAvro(req *model.SyncRecordsRequest,
-	rawTableName string, syncBatchID int64) (*model.SyncResponse, error) {
+// rawRecordStream converts a batch of records to rows of the raw table, it returns the rows with
+// the checkpoint of the first record and the number of records of each destination table.
+func (c *BigQueryConnector) rawRecordStream(req *model.SyncRecordsRequest,
+	syncBatchID int64) (*model.QRecordStream, int64, map[string]uint32, error) {
 	tableNameRowsMapping := make(map[string]uint32)
 	first := true
 	var firstCP int64 = 0
-	lastCP := req.Records.LastCheckPointID
 	recordStream := model.NewQRecordStream(len(req.Records.Records))
 	err := recordStream.SetSchema(&model.QRecordSchema{
 		Fields: []*model.QField{
@@ -681,21 +778,35 @@ func (c *BigQueryConnector) SyncRecordsViaAvro(req *model.SyncRecordsRequest,
 				Type:     qvalue.QValueKindString,
 				Nullable: true,
 			},
+			{
+				Name:     "_peerdb_source_lsn",
+				Type:     qvalue.QValueKindInt64,
+				Nullable: true,
+			},
+			{
+				Name:     "_peerdb_commit_ts",
+				Type:     qvalue.QValueKindInt64,
+				Nullable: true,
+			},
 		},
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, nil, err
 	}
 
 	// loop over req.Records
 	for _, record := range req.Records.Records {
-		var entries [10]qvalue.QValue
+		var entries [12]qvalue.QValue
 		switch r := record.(type) {
 		case *model.InsertRecord:
 
 			itemsJSON, err := r.Items.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to create items to json: %v", err)
+				err = req.SkipFailedRecord(record, fmt.Errorf("failed to create items to json: %v", err))
+				if err != nil {
+					return nil, 0, nil, err
+				}
+				continue
 			}
 
 			entries[3] = qvalue.QValue{
@@ -723,12 +834,20 @@ func (c *BigQueryConnector) SyncRecordsViaAvro(req *model.SyncRecordsRequest,
 		case *model.UpdateRecord:
 			newItemsJSON, err := r.NewItems.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to create new items to json: %v", err)
+				err = req.SkipFailedRecord(record, fmt.Errorf("failed to create new items to json: %v", err))
+				if err != nil {
+					return nil, 0, nil, err
+				}
+				continue
 			}
 
 			oldItemsJSON, err := r.OldItems.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to create old items to json: %v", err)
+				err = req.SkipFailedRecord(record, fmt.Errorf("failed to create old items to json: %v", err))
+				if err != nil {
+					return nil, 0, nil, err
+				}
+				continue
 			}
 
 			entries[3] = qvalue.QValue{
@@ -756,7 +875,11 @@ func (c *BigQueryConnector) SyncRecordsViaAvro(req *model.SyncRecordsRequest,
 		case *model.DeleteRecord:
 			itemsJSON, err := r.Items.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to create items to json: %v", err)
+				err = req.SkipFailedRecord(record, fmt.Errorf("failed to create items to json: %v", err))
+				if err != nil {
+					return nil, 0, nil, err
+				}
+				continue
 			}
 
 			entries[3] = qvalue.QValue{
@@ -782,7 +905,7 @@ func (c *BigQueryConnector) SyncRecordsViaAvro(req *model.SyncRecordsRequest,
 
 			tableNameRowsMapping[r.DestinationTableName] += 1
 		default:
-			return nil, fmt.Errorf("record type %T not supported", r)
+			return nil, 0, nil, fmt.Errorf("record type %T not supported", r)
 		}
 
 		if first {
@@ -810,16 +933,35 @@ func (c *BigQueryConnector) SyncRecordsViaAvro(req *model.SyncRecordsRequest,
 			Kind:  qvalue.QValueKindInt64,
 			Value: syncBatchID,
 		}
+		entries[10] = qvalue.QValue{
+			Kind:  qvalue.QValueKindInt64,
+			Value: record.GetCheckPointID(),
+		}
+		entries[11] = qvalue.QValue{
+			Kind:  qvalue.QValueKindInt64,
+			Value: record.GetCommitTime().UnixMicro(),
+		}
 		recordStream.Records <- &model.QRecordOrError{
 			Record: &model.QRecord{
-				NumEntries: 10,
+				NumEntries: 12,
 				Entries:    entries[:],
 			},
 		}
 	}
 
-	startTime := time.Now()
 	close(recordStream.Records)
+	return recordStream, firstCP, tableNameRowsMapping, nil
+}
+
+func (c *BigQueryConnector) SyncRecordsViaAvro(req *model.SyncRecordsRequest,
+	rawTableName string, syncBatchID int64) (*model.SyncResponse, error) {
+	lastCP := req.Records.LastCheckPointID
+	recordStream, firstCP, tableNameRowsMapping, err := c.rawRecordStream(req, syncBatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
 	avroSync := NewQRepAvroSyncMethod(c, req.StagingPath)
 	rawTableMetadata, err := c.client.Dataset(c.datasetID).Table(rawTableName).Metadata(c.ctx)
 	if err != nil {
@@ -829,7 +971,42 @@ func (c *BigQueryConnector) SyncRecordsViaAvro(req *model.SyncRecordsRequest,
 	numRecords, err := avroSync.SyncRecords(rawTableName, req.FlowJobName,
 		lastCP, rawTableMetadata, syncBatchID, recordStream)
 	if err != nil {
-		return nil, fmt.Errorf("failed to sync records via avro : %v", err)
+		return nil, fmt.Errorf("failed to sync records via avro : %w", err)
+	}
+
+	metrics.LogSyncMetrics(c.ctx, req.FlowJobName, int64(numRecords), time.Since(startTime))
+	log.Printf("pushed %d records to %s.%s", numRecords, c.datasetID, rawTableName)
+
+	return &model.SyncResponse{
+		FirstSyncedCheckPointID: firstCP,
+		LastSyncedCheckPointID:  lastCP,
+		NumRecordsSynced:        int64(numRecords),
+		CurrentSyncBatchID:      syncBatchID,
+		TableNameRowsMapping:    tableNameRowsMapping,
+	}, nil
+}
+
+// SyncRecordsViaStorageWrite streams the records to the raw table with the Storage Write API. A retry of
+// a batch whose rows were already written deletes them and writes the whole batch again.
+func (c *BigQueryConnector) SyncRecordsViaStorageWrite(req *model.SyncRecordsRequest,
+	rawTableName string, syncBatchID int64) (*model.SyncResponse, error) {
+	lastCP := req.Records.LastCheckPointID
+	recordStream, firstCP, tableNameRowsMapping, err := c.rawRecordStream(req, syncBatchID)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime := time.Now()
+	rawTableMetadata, err := c.client.Dataset(c.datasetID).Table(rawTableName).Metadata(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get metadata of destination table: %v", err)
+	}
+
+	storageWriteSync := NewQRepStorageWriteSyncMethod(c)
+	numRecords, err := storageWriteSync.SyncRecords(rawTableName, req.FlowJobName,
+		lastCP, rawTableMetadata, syncBatchID, recordStream)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync records via storage write: %w", err)
 	}
 
 	metrics.LogSyncMetrics(c.ctx, req.FlowJobName, int64(numRecords), time.Since(startTime))
@@ -883,6 +1060,11 @@ func (c *BigQueryConnector) NormalizeRecords(req *model.NormalizeRecordsRequest)
 		return nil, fmt.Errorf("couldn't get tablename to unchanged cols mapping: %w", err)
 	}
 
+	err = c.addMissingColumns(rawTableName, rawTableSchema)
+	if err != nil {
+		return nil, err
+	}
+
 	stmts := []string{}
 	// append all the statements to one list
 	log.Printf("merge raw records to corresponding tables: %s %s %v", c.datasetID, rawTableName, distinctTableNames)
@@ -898,6 +1080,10 @@ func (c *BigQueryConnector) NormalizeRecords(req *model.NormalizeRecordsRequest)
 			SyncBatchID:           syncBatchID,
 			NormalizeBatchID:      normalizeBatchID,
 			UnchangedToastColumns: tableNametoUnchangedToastCols[tableName],
+			SoftDelete:            req.SoftDelete,
+			SystemColumns:         req.SystemColumns,
+			HistoryMode:           req.HistoryMode,
+			PartitionColumn:       req.BigQueryTableOptions[tableName].GetPartitionColumn(),
 		}
 		// normalize anything between last normalized batch id to last sync batchid
 		mergeStmts := mergeGen.GenerateMergeStmts()
@@ -926,6 +1112,85 @@ func (c *BigQueryConnector) NormalizeRecords(req *model.NormalizeRecordsRequest)
 	}, nil
 }
 
+// rawTableSchema is the schema of the raw table of a mirror.
+var rawTableSchema = bigquery.Schema{
+	{Name: "_peerdb_uid", Type: bigquery.StringFieldType},
+	{Name: "_peerdb_timestamp", Type: bigquery.TimestampFieldType},
+	{Name: "_peerdb_timestamp_nanos", Type: bigquery.IntegerFieldType},
+	{Name: "_peerdb_destination_table_name", Type: bigquery.StringFieldType},
+	{Name: "_peerdb_data", Type: bigquery.StringFieldType},
+	{Name: "_peerdb_record_type", Type: bigquery.IntegerFieldType},
+	{Name: "_peerdb_match_data", Type: bigquery.StringFieldType},
+	{Name: "_peerdb_batch_id", Type: bigquery.IntegerFieldType},
+	{Name: "_peerdb_unchanged_toast_columns", Type: bigquery.StringFieldType},
+	{Name: "_peerdb_source_lsn", Type: bigquery.IntegerFieldType},
+	{Name: "_peerdb_commit_ts", Type: bigquery.IntegerFieldType},
+}
+
+// stagingTableSchema is the schema of the staging table records are inserted into before the raw table.
+var stagingTableSchema = bigquery.Schema{
+	{Name: "_peerdb_uid", Type: bigquery.StringFieldType},
+	{Name: "_peerdb_timestamp", Type: bigquery.TimestampFieldType},
+	{Name: "_peerdb_timestamp_nanos", Type: bigquery.IntegerFieldType},
+	{Name: "_peerdb_destination_table_name", Type: bigquery.StringFieldType},
+	{Name: "_peerdb_data", Type: bigquery.StringFieldType},
+	{Name: "_peerdb_record_type", Type: bigquery.IntegerFieldType},
+	{Name: "_peerdb_match_data", Type: bigquery.StringFieldType},
+	{Name: "_peerdb_batch_id", Type: bigquery.IntegerFieldType},
+	{Name: "_peerdb_staging_batch_id", Type: bigquery.IntegerFieldType},
+	{Name: "_peerdb_unchanged_toast_columns", Type: bigquery.StringFieldType},
+	{Name: "_peerdb_source_lsn", Type: bigquery.IntegerFieldType},
+	{Name: "_peerdb_commit_ts", Type: bigquery.IntegerFieldType},
+}
+
+// missingFields returns the fields of the expected schema that the existing schema does not have.
+func missingFields(existing bigquery.Schema, expected bigquery.Schema) bigquery.Schema {
+	existingFields := make(map[string]bool, len(existing))
+	for _, field := range existing {
+		existingFields[field.Name] = true
+	}
+	var missing bigquery.Schema
+	for _, field := range expected {
+		if !existingFields[field.Name] {
+			missing = append(missing, field)
+		}
+	}
+	return missing
+}
+
+// addMissingColumns appends the columns of the schema that a table does not have yet,
+// for tables created by an older version.
+func (c *BigQueryConnector) addMissingColumns(tableName string, schema bigquery.Schema) error {
+	table := c.client.Dataset(c.datasetID).Table(tableName)
+	meta, err := table.Metadata(c.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get metadata of table %s.%s: %w", c.datasetID, tableName, err)
+	}
+
+	missing := missingFields(meta.Schema, schema)
+	if len(missing) == 0 {
+		return nil
+	}
+	_, err = table.Update(c.ctx, bigquery.TableMetadataToUpdate{
+		Schema: append(meta.Schema, missing...),
+	}, meta.ETag)
+	if err != nil {
+		return fmt.Errorf("failed to add columns to table %s.%s: %w", c.datasetID, tableName, err)
+	}
+	log.Infof("added %d columns to table %s.%s", len(missing), c.datasetID, tableName)
+	return nil
+}
+
+// addRawTableSystemColumns adds the source LSN and commit timestamp columns to the raw and staging
+// tables of a mirror created before they existed.
+func (c *BigQueryConnector) addRawTableSystemColumns(flowJobName string) error {
+	err := c.addMissingColumns(c.getRawTableName(flowJobName), rawTableSchema)
+	if err != nil {
+		return err
+	}
+	return c.addMissingColumns(c.getStagingTableName(flowJobName), stagingTableSchema)
+}
+
 // CreateRawTable creates a raw table, implementing the Connector interface.
 // create a table with the following schema
 // _peerdb_uid STRING
@@ -936,50 +1201,32 @@ func (c *BigQueryConnector) NormalizeRecords(req *model.NormalizeRecordsRequest)
 func (c *BigQueryConnector) CreateRawTable(req *protos.CreateRawTableInput) (*protos.CreateRawTableOutput, error) {
 	rawTableName := c.getRawTableName(req.FlowJobName)
 
-	schema := bigquery.Schema{
-		{Name: "_peerdb_uid", Type: bigquery.StringFieldType},
-		{Name: "_peerdb_timestamp", Type: bigquery.TimestampFieldType},
-		{Name: "_peerdb_timestamp_nanos", Type: bigquery.IntegerFieldType},
-		{Name: "_peerdb_destination_table_name", Type: bigquery.StringFieldType},
-		{Name: "_peerdb_data", Type: bigquery.StringFieldType},
-		{Name: "_peerdb_record_type", Type: bigquery.IntegerFieldType},
-		{Name: "_peerdb_match_data", Type: bigquery.StringFieldType},
-		{Name: "_peerdb_batch_id", Type: bigquery.IntegerFieldType},
-		{Name: "_peerdb_unchanged_toast_columns", Type: bigquery.StringFieldType},
-	}
-
-	staging_schema := bigquery.Schema{
-		{Name: "_peerdb_uid", Type: bigquery.StringFieldType},
-		{Name: "_peerdb_timestamp", Type: bigquery.TimestampFieldType},
-		{Name: "_peerdb_timestamp_nanos", Type: bigquery.IntegerFieldType},
-		{Name: "_peerdb_destination_table_name", Type: bigquery.StringFieldType},
-		{Name: "_peerdb_data", Type: bigquery.StringFieldType},
-		{Name: "_peerdb_record_type", Type: bigquery.IntegerFieldType},
-		{Name: "_peerdb_match_data", Type: bigquery.StringFieldType},
-		{Name: "_peerdb_batch_id", Type: bigquery.IntegerFieldType},
-		{Name: "_peerdb_staging_batch_id", Type: bigquery.IntegerFieldType},
-		{Name: "_peerdb_unchanged_toast_columns", Type: bigquery.StringFieldType},
-	}
-
 	// create the table
 	table := c.client.Dataset(c.datasetID).Table(rawTableName)
 
 	// check if the table exists
-	meta, err := table.Metadata(c.ctx)
+	_, err := table.Metadata(c.ctx)
 	if err == nil {
-		// table exists, check if the schema matches
-		if !reflect.DeepEqual(meta.Schema, schema) {
+		// table exists, add the columns it is missing and check if the schema matches
+		err = c.addRawTableSystemColumns(req.FlowJobName)
+		if err != nil {
+			return nil, err
+		}
+		meta, err := table.Metadata(c.ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get metadata of table %s.%s: %w", c.datasetID, rawTableName, err)
+		}
+		if !reflect.DeepEqual(meta.Schema, rawTableSchema) {
 			return nil, fmt.Errorf("table %s.%s already exists with different schema", c.datasetID, rawTableName)
-		} else {
-			return &protos.CreateRawTableOutput{
-				TableIdentifier: rawTableName,
-			}, nil
 		}
+		return &protos.CreateRawTableOutput{
+			TableIdentifier: rawTableName,
+		}, nil
 	}
 
 	// table does not exist, create it
 	err = table.Create(c.ctx, &bigquery.TableMetadata{
-		Schema: schema,
+		Schema: rawTableSchema,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create table %s.%s: %w", c.datasetID, rawTableName, err)
@@ -989,7 +1236,7 @@ func (c *BigQueryConnector) CreateRawTable(req *protos.CreateRawTableInput) (*pr
 	stagingTableName := c.getStagingTableName(req.FlowJobName)
 	stagingTable := c.client.Dataset(c.datasetID).Table(stagingTableName)
 	err = stagingTable.Create(c.ctx, &bigquery.TableMetadata{
-		Schema: staging_schema,
+		Schema: stagingTableSchema,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create table %s.%s: %w", c.datasetID, stagingTableName, err)
@@ -1027,7 +1274,8 @@ func (c *BigQueryConnector) getAppendStagingToRawStmt(
 	return fmt.Sprintf(
 		`INSERT INTO %s.%s SELECT _peerdb_uid,_peerdb_timestamp,_peerdb_timestamp_nanos,
 		_peerdb_destination_table_name,_peerdb_data,_peerdb_record_type,_peerdb_match_data,
-		_peerdb_batch_id,_peerdb_unchanged_toast_columns FROM %s.%s WHERE _peerdb_staging_batch_id = %d;`,
+		_peerdb_batch_id,_peerdb_unchanged_toast_columns,_peerdb_source_lsn,_peerdb_commit_ts
+		FROM %s.%s WHERE _peerdb_staging_batch_id = %d;`,
 		c.datasetID, rawTableName, c.datasetID, stagingTableName, stagingBatchID)
 }
 
@@ -1081,20 +1329,49 @@ func (c *BigQueryConnector) SetupNormalizedTables(
 			}
 			idx++
 		}
+		for _, columnName := range utils.GetSystemColumnNames(req.SoftDelete, req.SystemColumns) {
+			columns = append(columns, &bigquery.FieldSchema{
+				Name: columnName,
+				Type: systemColumnTypes[columnName],
+			})
+		}
+		if req.HistoryMode {
+			for _, columnName := range utils.GetHistoryColumnNames() {
+				columns = append(columns, &bigquery.FieldSchema{
+					Name: columnName,
+					Type: systemColumnTypes[columnName],
+				})
+			}
+		}
 
 		// create the table using the columns
 		schema := bigquery.Schema(columns)
 		table := c.client.Dataset(c.datasetID).Table(tableIdentifier)
 
+		tableOptions := req.BigqueryTableOptions[tableIdentifier]
+
 		// check if the table exists
-		_, err := table.Metadata(c.ctx)
+		existing, err := table.Metadata(c.ctx)
 		if err == nil {
+			if tableOptions != nil {
+				err = c.reconcileTableOptions(table, existing, tableOptions)
+				if err != nil {
+					return nil, err
+				}
+			}
 			// table exists, go to next table
 			tableExistsMapping[tableIdentifier] = true
 			continue
 		}
 
-		err = table.Create(c.ctx, &bigquery.TableMetadata{Schema: schema})
+		metadata := &bigquery.TableMetadata{Schema: schema}
+		if tableOptions != nil {
+			err = applyTableOptions(tableIdentifier, metadata, tableOptions)
+			if err != nil {
+				return nil, err
+			}
+		}
+		err = table.Create(c.ctx, metadata)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create table %s: %w", tableIdentifier, err)
 		}
@@ -1186,10 +1463,21 @@ type MergeStmtGenerator struct {
 	NormalizedTableSchema *protos.TableSchema
 	// array of toast column combinations that are unchanged
 	UnchangedToastColumns []string
+	// mark deleted rows with _peerdb_is_deleted instead of deleting them
+	SoftDelete bool
+	// system columns maintained on the table to merge into
+	SystemColumns *protos.SystemColumns
+	// keep every version of a row instead of only the latest
+	HistoryMode bool
+	// column the table to merge into is partitioned on, the MERGE only scans the partitions of the batch
+	PartitionColumn string
 }
 
 // GenerateMergeStmt generates a merge statements.
 func (m *MergeStmtGenerator) GenerateMergeStmts() []string {
+	if m.HistoryMode {
+		return m.generateHistoryMergeStmts()
+	}
 	// return an empty array for now
 	flattenedCTE := m.generateFlattenedCTE()
 	deDupedCTE := m.generateDeDupedCTE()
@@ -1199,11 +1487,12 @@ func (m *MergeStmtGenerator) GenerateMergeStmts() []string {
 		"CREATE TEMP TABLE %s AS (%s, %s);",
 		tempTable, flattenedCTE, deDupedCTE)
 
-	mergeStmt := m.generateMergeStmt(tempTable)
+	mergeStmts := m.wrapPartitionPruning(m.generateMergeStmt(tempTable))
 
 	dropTempTableStmt := fmt.Sprintf("DROP TABLE %s;", tempTable)
 
-	return []string{createTempTableStmt, mergeStmt, dropTempTableStmt}
+	stmts := append([]string{createTempTableStmt}, mergeStmts...)
+	return append(stmts, dropTempTableStmt)
 }
 
 // generateFlattenedCTE generates a flattened CTE.
@@ -1258,6 +1547,8 @@ func (m *MergeStmtGenerator) generateFlattenedCTE() string {
 	flattenedProjs = append(flattenedProjs, "_peerdb_timestamp_nanos")
 	flattenedProjs = append(flattenedProjs, "_peerdb_record_type")
 	flattenedProjs = append(flattenedProjs, "_peerdb_unchanged_toast_columns")
+	flattenedProjs = append(flattenedProjs, "_peerdb_source_lsn")
+	flattenedProjs = append(flattenedProjs, "_peerdb_commit_ts")
 
 	// normalize anything between last normalized batch id to last sync batchid
 	return fmt.Sprintf(`WITH _peerdb_flattened AS
@@ -1278,13 +1569,20 @@ func (m *MergeStmtGenerator) generateDeDupedCTE() string {
 			) _peerdb_ranked
 			WHERE _peerdb_rank = 1
 	) SELECT * FROM _peerdb_de_duplicated_data_res`
-	pkey := m.NormalizedTableSchema.PrimaryKeyColumn
-	return fmt.Sprintf(cte, pkey)
+	pkeys := utils.GetPrimaryKeyColumns(m.NormalizedTableSchema)
+	return fmt.Sprintf(cte, strings.Join(pkeys, ", "))
 }
 
 // generateMergeStmt generates a merge statement.
 func (m *MergeStmtGenerator) generateMergeStmt(tempTable string) string {
-	pkey := m.NormalizedTableSchema.PrimaryKeyColumn
+	// fan-in tables also match on the source identifier column
+	pkeyMatches := make([]string, 0)
+	for _, pkey := range utils.GetPrimaryKeyColumns(m.NormalizedTableSchema) {
+		pkeyMatches = append(pkeyMatches, fmt.Sprintf("_peerdb_target.%s = _peerdb_deduped.%s", pkey, pkey))
+	}
+	if m.partitionPruned() {
+		pkeyMatches = append(pkeyMatches, m.generatePartitionPredicate("_peerdb_target"))
+	}
 
 	// comma separated list of column names
 	backtickColNames := make([]string, 0)
@@ -1294,19 +1592,155 @@ func (m *MergeStmtGenerator) generateMergeStmt(tempTable string) string {
 		pureColNames = append(pureColNames, colName)
 	}
 	csep := strings.Join(backtickColNames, ", ")
+	systemColumnNames, systemColumnValues := m.generateSystemColumnValues(false)
+	insertColumns := strings.Join(append(backtickColNames, systemColumnNames...), ", ")
+	insertValues := strings.Join(append([]string{csep}, systemColumnValues...), ", ")
 
 	udateStatementsforToastCols := m.generateUpdateStatement(pureColNames, m.UnchangedToastColumns)
 	updateStringToastCols := strings.Join(udateStatementsforToastCols, " ")
 
+	deletePart := "DELETE"
+	if m.SoftDelete {
+		deletePart = fmt.Sprintf("UPDATE SET %s", strings.Join(m.generateSystemColumnSets(true), ", "))
+	}
+
 	return fmt.Sprintf(`
 	MERGE %s.%s _peerdb_target USING %s _peerdb_deduped
-	ON _peerdb_target.%s = _peerdb_deduped.%s
+	ON %s
 		WHEN NOT MATCHED and (_peerdb_deduped._peerdb_record_type != 2) THEN
 			INSERT (%s) VALUES (%s)
 		%s
 		WHEN MATCHED AND (_peerdb_deduped._peerdb_record_type = 2) THEN
-	DELETE;
-	`, m.Dataset, m.NormalizedTable, tempTable, pkey, pkey, csep, csep, updateStringToastCols)
+	%s;
+	`, m.Dataset, m.NormalizedTable, tempTable, strings.Join(pkeyMatches, " AND "), insertColumns, insertValues,
+		updateStringToastCols, deletePart)
+}
+
+// generateHistoryMergeStmts generates the statements that merge a batch in history mode. Every change
+// becomes a version, and the first change of each row closes its current version. Unchanged toast
+// columns are taken from the latest earlier version of the row that has them.
+func (m *MergeStmtGenerator) generateHistoryMergeStmts() []string {
+	pkeys := utils.GetPrimaryKeyColumns(m.NormalizedTableSchema)
+	partitionBy := strings.Join(pkeys, ", ")
+
+	toastColumns := make(map[string]struct{})
+	for _, cols := range m.UnchangedToastColumns {
+		for _, col := range strings.Split(cols, ",") {
+			if col != "" {
+				toastColumns[col] = struct{}{}
+			}
+		}
+	}
+	toastSQL := utils.GenerateHistoryToastSQL(toastColumns, &utils.HistoryToastDialect{
+		QuoteIdentifier: systemColumnDialect.QuoteIdentifier,
+		UnchangedCondition: func(columnName string) string {
+			return fmt.Sprintf("'%s' IN UNNEST(SPLIT(IFNULL(_peerdb_unchanged_toast_columns, ''), ','))", columnName)
+		},
+		GroupPartitionBy: partitionBy,
+		ValuePartitionBy: partitionBy,
+		OrderBy:          "_peerdb_timestamp_nanos",
+		VersionPrefix:    "_peerdb_versioned.",
+		CurrentPrefix:    "_peerdb_current.",
+	})
+	resolvedCols := make([]string, 0)
+	insertColumns := make([]string, 0)
+	insertValues := make([]string, 0)
+	for colName := range m.NormalizedTableSchema.Columns {
+		if resolved, ok := toastSQL.Resolved[colName]; ok {
+			resolvedCols = append(resolvedCols, fmt.Sprintf("%s AS `%s`", resolved, colName))
+		} else {
+			resolvedCols = append(resolvedCols, fmt.Sprintf("_peerdb_versioned.`%s`", colName))
+		}
+		insertColumns = append(insertColumns, fmt.Sprintf("`%s`", colName))
+		insertValues = append(insertValues, fmt.Sprintf("_peerdb_deduped.`%s`", colName))
+	}
+
+	tempTable := fmt.Sprintf("_peerdb_versioned_data_%s", util.RandomString(5))
+	createTempTableStmt := fmt.Sprintf(`CREATE TEMP TABLE %s AS (%s
+	SELECT *%s FROM (
+		SELECT *, TIMESTAMP_MICROS(_peerdb_commit_ts) AS _peerdb_valid_from,
+			LEAD(TIMESTAMP_MICROS(_peerdb_commit_ts)) OVER (
+				PARTITION BY %s ORDER BY _peerdb_timestamp_nanos
+			) AS _peerdb_valid_to,
+			ROW_NUMBER() OVER (PARTITION BY %s ORDER BY _peerdb_timestamp_nanos) AS _peerdb_version%s
+		FROM _peerdb_flattened
+	));`, tempTable, m.generateFlattenedCTE(), toastSQL.ValuesSQL, partitionBy, partitionBy, toastSQL.GroupsSQL)
+
+	currentMatches := make([]string, 0)
+	pkeyMatches := make([]string, 0)
+	for _, pkey := range pkeys {
+		currentMatches = append(currentMatches, fmt.Sprintf("_peerdb_current.%s = _peerdb_versioned.%s", pkey, pkey))
+		pkeyMatches = append(pkeyMatches, fmt.Sprintf("_peerdb_target.%s = _peerdb_deduped.%s", pkey, pkey))
+	}
+	if m.partitionPruned() {
+		currentMatches = append(currentMatches, m.generatePartitionPredicate("_peerdb_current"))
+		pkeyMatches = append(pkeyMatches, m.generatePartitionPredicate("_peerdb_target"))
+	}
+
+	// with soft delete, a delete inserts a version marked as deleted instead of only closing the row
+	systemColumnNames, systemColumnValues := m.generateSystemColumnValues(false)
+	for i, columnName := range systemColumnNames {
+		if columnName == fmt.Sprintf("`%s`", utils.IsDeletedColumnName) {
+			systemColumnValues[i] = "(_peerdb_deduped._peerdb_record_type = 2)"
+		}
+	}
+	insertColumns = append(insertColumns, systemColumnNames...)
+	insertValues = append(insertValues, systemColumnValues...)
+	for _, columnName := range utils.GetHistoryColumnNames() {
+		insertColumns = append(insertColumns, fmt.Sprintf("`%s`", columnName))
+	}
+	insertValues = append(insertValues, "_peerdb_deduped._peerdb_valid_from", "_peerdb_deduped._peerdb_valid_to",
+		"_peerdb_deduped._peerdb_valid_to IS NULL")
+	insertCondition := "(_peerdb_deduped._peerdb_record_type != 2)"
+	if m.SoftDelete {
+		insertCondition = "TRUE"
+	}
+
+	// the source is aliased _peerdb_deduped as the system column values refer to it.
+	mergeStmt := fmt.Sprintf(`
+	MERGE %s.%s _peerdb_target USING (
+		WITH _peerdb_resolved AS (
+			SELECT %s, _peerdb_versioned._peerdb_record_type, _peerdb_versioned._peerdb_source_lsn,
+				_peerdb_versioned._peerdb_commit_ts, _peerdb_versioned._peerdb_valid_from,
+				_peerdb_versioned._peerdb_valid_to, _peerdb_versioned._peerdb_version
+			FROM %s _peerdb_versioned LEFT JOIN %s.%s _peerdb_current
+			ON %s AND _peerdb_current._peerdb_is_current
+		)
+		SELECT *, FALSE AS _peerdb_close FROM _peerdb_resolved
+		UNION ALL SELECT *, TRUE AS _peerdb_close FROM _peerdb_resolved WHERE _peerdb_version = 1
+	) _peerdb_deduped
+	ON _peerdb_deduped._peerdb_close AND %s AND _peerdb_target._peerdb_is_current
+		WHEN MATCHED THEN
+			UPDATE SET _peerdb_valid_to = _peerdb_deduped._peerdb_valid_from, _peerdb_is_current = FALSE
+		WHEN NOT MATCHED AND NOT _peerdb_deduped._peerdb_close AND %s THEN
+			INSERT (%s) VALUES (%s);
+	`, m.Dataset, m.NormalizedTable, strings.Join(resolvedCols, ", "), tempTable, m.Dataset, m.NormalizedTable,
+		strings.Join(currentMatches, " AND "), strings.Join(pkeyMatches, " AND "), insertCondition,
+		strings.Join(insertColumns, ", "), strings.Join(insertValues, ", "))
+
+	dropTempTableStmt := fmt.Sprintf("DROP TABLE %s;", tempTable)
+
+	stmts := append([]string{createTempTableStmt}, m.wrapPartitionPruning(mergeStmt)...)
+	return append(stmts, dropTempTableStmt)
+}
+
+// systemColumnDialect is how the MERGE computes the system columns from _peerdb_deduped.
+var systemColumnDialect = &utils.SystemColumnDialect{
+	QuoteIdentifier: func(columnName string) string {
+		return fmt.Sprintf("`%s`", columnName)
+	},
+	CurrentTimestamp: "CURRENT_TIMESTAMP()",
+	SourceLSN:        "_peerdb_deduped._peerdb_source_lsn",
+	CommitTimestamp:  "TIMESTAMP_MICROS(_peerdb_deduped._peerdb_commit_ts)",
+	SetSeparator:     " = ",
+}
+
+func (m *MergeStmtGenerator) generateSystemColumnValues(deleted bool) ([]string, []string) {
+	return utils.GenerateSystemColumnValues(m.SoftDelete, m.SystemColumns, systemColumnDialect, deleted)
+}
+
+func (m *MergeStmtGenerator) generateSystemColumnSets(deleted bool) []string {
+	return utils.GenerateSystemColumnSets(m.SoftDelete, m.SystemColumns, systemColumnDialect, deleted)
 }
 
 /*
@@ -1334,6 +1768,7 @@ func (m *MergeStmtGenerator) generateUpdateStatement(allCols []string, unchanged
 		for _, colName := range otherCols {
 			tmpArray = append(tmpArray, fmt.Sprintf("`%s` = _peerdb_deduped.%s", colName, colName))
 		}
+		tmpArray = append(tmpArray, m.generateSystemColumnSets(false)...)
 		ssep := strings.Join(tmpArray, ", ")
 		updateStmt := fmt.Sprintf(`WHEN MATCHED AND
 		(_peerdb_deduped._peerdb_record_type != 2) AND _peerdb_unchanged_toast_columns='%s'
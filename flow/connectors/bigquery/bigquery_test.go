@@ -0,0 +1,32 @@
+package connbigquery
+
+import (
+	"reflect"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+)
+
+func TestMissingFieldsOfOldRawTable(t *testing.T) {
+	// the raw table as created before the source LSN and commit timestamp columns existed.
+	oldRawTableSchema := rawTableSchema[:len(rawTableSchema)-2]
+
+	missing := missingFields(oldRawTableSchema, rawTableSchema)
+	expected := bigquery.Schema{
+		{Name: "_peerdb_source_lsn", Type: bigquery.IntegerFieldType},
+		{Name: "_peerdb_commit_ts", Type: bigquery.IntegerFieldType},
+	}
+	if !reflect.DeepEqual(missing, expected) {
+		t.Errorf("expected missing fields %v, got %v", expected, missing)
+	}
+
+	// the columns are appended, so the upgraded table has the schema of a new raw table.
+	upgraded := append(append(bigquery.Schema{}, oldRawTableSchema...), missing...)
+	if !reflect.DeepEqual(upgraded, rawTableSchema) {
+		t.Errorf("expected upgraded schema %v, got %v", rawTableSchema, upgraded)
+	}
+
+	if missing := missingFields(rawTableSchema, rawTableSchema); len(missing) != 0 {
+		t.Errorf("expected no missing fields for an up to date raw table, got %v", missing)
+	}
+}
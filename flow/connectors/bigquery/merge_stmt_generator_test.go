@@ -1,9 +1,12 @@
 package connbigquery
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
 )
 
 func TestGenerateUpdateStatement_WithUnchangedToastCols(t *testing.T) {
@@ -68,6 +71,256 @@ func TestGenerateUpdateStatement_NoUnchangedToastCols(t *testing.T) {
 	}
 }
 
+func TestGenerateMergeStmt_FanIn(t *testing.T) {
+	m := &MergeStmtGenerator{
+		Dataset:         "dataset",
+		NormalizedTable: "orders",
+		NormalizedTableSchema: &protos.TableSchema{
+			TableIdentifier: "orders",
+			Columns: map[string]string{
+				"id":                   "int64",
+				"_peerdb_source_table": "string",
+			},
+			PrimaryKeyColumn:       "id",
+			SourceIdentifierColumn: "_peerdb_source_table",
+		},
+	}
+
+	mergeStmt := removeSpacesTabsNewlines(m.generateMergeStmt("temp"))
+	expectedOn := removeSpacesTabsNewlines("ON _peerdb_target._peerdb_source_table = _peerdb_deduped._peerdb_source_table" +
+		" AND _peerdb_target.id = _peerdb_deduped.id")
+	if !strings.Contains(mergeStmt, expectedOn) {
+		t.Errorf("Merge statement %s does not match on the source identifier column", mergeStmt)
+	}
+
+	deDupedCTE := removeSpacesTabsNewlines(m.generateDeDupedCTE())
+	expectedPartition := removeSpacesTabsNewlines("PARTITION BY _peerdb_source_table, id")
+	if !strings.Contains(deDupedCTE, expectedPartition) {
+		t.Errorf("De-duplication CTE %s does not partition by the source identifier column", deDupedCTE)
+	}
+}
+
+func TestGenerateMergeStmt_SystemColumns(t *testing.T) {
+	m := &MergeStmtGenerator{
+		Dataset:         "dataset",
+		NormalizedTable: "orders",
+		NormalizedTableSchema: &protos.TableSchema{
+			TableIdentifier: "orders",
+			Columns: map[string]string{
+				"id": "int64",
+			},
+			PrimaryKeyColumn: "id",
+		},
+		UnchangedToastColumns: []string{""},
+		SoftDelete:            true,
+		SystemColumns: &protos.SystemColumns{
+			SyncedAt:  true,
+			SourceLsn: true,
+			CommitTs:  true,
+		},
+	}
+
+	mergeStmt := removeSpacesTabsNewlines(m.generateMergeStmt("temp"))
+	expected := []string{
+		"INSERT (`id`, `_peerdb_synced_at`, `_peerdb_is_deleted`, `_peerdb_source_lsn`, `_peerdb_commit_ts`)" +
+			" VALUES (`id`, CURRENT_TIMESTAMP(), FALSE, _peerdb_deduped._peerdb_source_lsn," +
+			" TIMESTAMP_MICROS(_peerdb_deduped._peerdb_commit_ts))",
+		"UPDATE SET `id` = _peerdb_deduped.id, `_peerdb_synced_at` = CURRENT_TIMESTAMP()," +
+			" `_peerdb_is_deleted` = FALSE",
+		"WHEN MATCHED AND (_peerdb_deduped._peerdb_record_type = 2) THEN UPDATE SET" +
+			" `_peerdb_synced_at` = CURRENT_TIMESTAMP(), `_peerdb_is_deleted` = TRUE",
+	}
+	for _, e := range expected {
+		if !strings.Contains(mergeStmt, removeSpacesTabsNewlines(e)) {
+			t.Errorf("Merge statement %s does not contain %s", mergeStmt, e)
+		}
+	}
+}
+
+func TestGenerateHistoryMergeStmts(t *testing.T) {
+	m := &MergeStmtGenerator{
+		Dataset:         "dataset",
+		NormalizedTable: "orders",
+		NormalizedTableSchema: &protos.TableSchema{
+			TableIdentifier: "orders",
+			Columns: map[string]string{
+				"id": "int64",
+			},
+			PrimaryKeyColumn: "id",
+		},
+		UnchangedToastColumns: []string{""},
+		SoftDelete:            true,
+		HistoryMode:           true,
+	}
+
+	stmts := m.GenerateMergeStmts()
+	if len(stmts) != 3 {
+		t.Fatalf("expected 3 statements, got %d", len(stmts))
+	}
+	createTempTableStmt := removeSpacesTabsNewlines(stmts[0])
+	if !strings.Contains(createTempTableStmt, removeSpacesTabsNewlines(
+		"LEAD(TIMESTAMP_MICROS(_peerdb_commit_ts)) OVER (PARTITION BY id ORDER BY _peerdb_timestamp_nanos)")) {
+		t.Errorf("Temp table statement %s does not compute _peerdb_valid_to", createTempTableStmt)
+	}
+
+	mergeStmt := removeSpacesTabsNewlines(stmts[1])
+	expected := []string{
+		"ON _peerdb_deduped._peerdb_close AND _peerdb_target.id = _peerdb_deduped.id" +
+			" AND _peerdb_target._peerdb_is_current",
+		"WHEN MATCHED THEN UPDATE SET _peerdb_valid_to = _peerdb_deduped._peerdb_valid_from," +
+			" _peerdb_is_current = FALSE",
+		"WHEN NOT MATCHED AND NOT _peerdb_deduped._peerdb_close AND TRUE THEN" +
+			" INSERT (`id`, `_peerdb_is_deleted`, `_peerdb_valid_from`, `_peerdb_valid_to`, `_peerdb_is_current`)" +
+			" VALUES (_peerdb_deduped.`id`, (_peerdb_deduped._peerdb_record_type = 2)," +
+			" _peerdb_deduped._peerdb_valid_from, _peerdb_deduped._peerdb_valid_to," +
+			" _peerdb_deduped._peerdb_valid_to IS NULL)",
+	}
+	for _, e := range expected {
+		if !strings.Contains(mergeStmt, removeSpacesTabsNewlines(e)) {
+			t.Errorf("Merge statement %s does not contain %s", mergeStmt, e)
+		}
+	}
+}
+
+func TestGenerateHistoryMergeStmts_ToastColumns(t *testing.T) {
+	m := &MergeStmtGenerator{
+		Dataset:         "dataset",
+		NormalizedTable: "orders",
+		NormalizedTableSchema: &protos.TableSchema{
+			TableIdentifier: "orders",
+			Columns: map[string]string{
+				"id":   "int64",
+				"note": "string",
+			},
+			PrimaryKeyColumn: "id",
+		},
+		UnchangedToastColumns: []string{"", "note"},
+		HistoryMode:           true,
+	}
+
+	stmts := m.GenerateMergeStmts()
+	createTempTableStmt := removeSpacesTabsNewlines(stmts[0])
+	expected := []string{
+		"SUM(CASE WHEN 'note' IN UNNEST(SPLIT(IFNULL(_peerdb_unchanged_toast_columns, ''), ',')) THEN 0 ELSE 1 END)" +
+			" OVER (PARTITION BY id ORDER BY _peerdb_timestamp_nanos) AS _peerdb_toast_group_0",
+		"FIRST_VALUE(`note`) OVER (PARTITION BY id, _peerdb_toast_group_0 ORDER BY _peerdb_timestamp_nanos)" +
+			" AS _peerdb_toast_value_0",
+	}
+	for _, e := range expected {
+		if !strings.Contains(createTempTableStmt, removeSpacesTabsNewlines(e)) {
+			t.Errorf("Temp table statement %s does not contain %s", createTempTableStmt, e)
+		}
+	}
+
+	mergeStmt := removeSpacesTabsNewlines(stmts[1])
+	e := "CASE WHEN _peerdb_versioned._peerdb_toast_group_0 = 0 THEN _peerdb_current.`note`" +
+		" ELSE _peerdb_versioned._peerdb_toast_value_0 END AS `note`"
+	if !strings.Contains(mergeStmt, removeSpacesTabsNewlines(e)) {
+		t.Errorf("Merge statement %s does not contain %s", mergeStmt, e)
+	}
+}
+
+func TestGenerateMergeStmts_PartitionPruning(t *testing.T) {
+	m := &MergeStmtGenerator{
+		Dataset:         "dataset",
+		NormalizedTable: "orders",
+		NormalizedTableSchema: &protos.TableSchema{
+			TableIdentifier: "orders",
+			Columns: map[string]string{
+				"id":         "int64",
+				"created_at": "timestamp",
+			},
+			PrimaryKeyColumn: "id",
+		},
+		UnchangedToastColumns: []string{""},
+		PartitionColumn:       "created_at",
+	}
+
+	stmts := m.GenerateMergeStmts()
+	if len(stmts) != 8 || stmts[1] != "BEGIN" || stmts[6] != "END;" {
+		t.Fatalf("expected the MERGE in a block with the partition bounds, got %v", stmts)
+	}
+	if stmts[2] != "DECLARE _peerdb_partition_min, _peerdb_partition_max TIMESTAMP;" {
+		t.Errorf("unexpected partition bounds declaration %s", stmts[2])
+	}
+	boundsStmt := removeSpacesTabsNewlines(stmts[4])
+	if !strings.Contains(boundsStmt, removeSpacesTabsNewlines("COALESCE(NOT LOGICAL_OR(_peerdb_old_missing), TRUE)")) {
+		t.Errorf("Partition bounds statement %s does not compute the bounds of the batch", boundsStmt)
+	}
+
+	mergeStmt := removeSpacesTabsNewlines(stmts[5])
+	expectedOn := removeSpacesTabsNewlines("ON _peerdb_target.id = _peerdb_deduped.id" +
+		" AND (NOT _peerdb_partition_prune OR _peerdb_target.`created_at` IS NULL" +
+		" OR _peerdb_target.`created_at` BETWEEN _peerdb_partition_min AND _peerdb_partition_max)")
+	if !strings.Contains(mergeStmt, expectedOn) {
+		t.Errorf("Merge statement %s does not prune partitions", mergeStmt)
+	}
+
+	// the partitions of system columns can't be pruned from the batch.
+	m.PartitionColumn = "_peerdb_synced_at"
+	if stmts := m.GenerateMergeStmts(); len(stmts) != 3 {
+		t.Errorf("expected no partition pruning on a system column, got %d statements", len(stmts))
+	}
+}
+
+func TestGenerateMergeStmts_PartitionPruningCrossPartitionUpdate(t *testing.T) {
+	m := &MergeStmtGenerator{
+		Dataset:          "dataset",
+		RawTable:         "_peerdb_raw_orders",
+		NormalizedTable:  "orders",
+		NormalizeBatchID: 3,
+		SyncBatchID:      5,
+		NormalizedTableSchema: &protos.TableSchema{
+			TableIdentifier: "orders",
+			Columns: map[string]string{
+				"id":         "int64",
+				"created_at": "timestamp",
+			},
+			PrimaryKeyColumn: "id",
+		},
+		UnchangedToastColumns: []string{""},
+		PartitionColumn:       "created_at",
+	}
+
+	// an update moving a row from the partition of its old value to the partition of its new value must find
+	// the row in its old partition, so the bounds cover the old values of every change of the batch, not only
+	// the latest change of each row which is all the de-duplicated temp table has.
+	for _, historyMode := range []bool{false, true} {
+		m.HistoryMode = historyMode
+		stmts := m.GenerateMergeStmts()
+		boundsStmt := removeSpacesTabsNewlines(stmts[4])
+		for _, expected := range []string{
+			"LEAST(COALESCE(MIN(_peerdb_new), MIN(_peerdb_old)), COALESCE(MIN(_peerdb_old), MIN(_peerdb_new)))",
+			"GREATEST(COALESCE(MAX(_peerdb_new), MAX(_peerdb_old)), COALESCE(MAX(_peerdb_old), MAX(_peerdb_new)))",
+			"CAST(JSON_EXTRACT_SCALAR(_peerdb_data, '$.created_at') AS TIMESTAMP) AS _peerdb_new",
+			"CAST(JSON_EXTRACT_SCALAR(_peerdb_match_data, '$.created_at') AS TIMESTAMP) AS _peerdb_old",
+			// updates and deletes without the old value, when the column isn't in the replica identity,
+			// can't be pruned.
+			"_peerdb_record_type != 0 AND JSON_EXTRACT(_peerdb_match_data, '$.created_at') IS NULL",
+			"FROM dataset._peerdb_raw_orders WHERE _peerdb_batch_id > 3 AND _peerdb_batch_id <= 5 AND" +
+				" _peerdb_destination_table_name = 'orders'",
+		} {
+			if !strings.Contains(boundsStmt, removeSpacesTabsNewlines(expected)) {
+				t.Errorf("Partition bounds statement %s does not contain %s, history mode: %t",
+					stmts[4], expected, historyMode)
+			}
+		}
+
+		mergeStmt := removeSpacesTabsNewlines(stmts[5])
+		predicate := "(NOT _peerdb_partition_prune OR %[1]s.`created_at` IS NULL" +
+			" OR %[1]s.`created_at` BETWEEN _peerdb_partition_min AND _peerdb_partition_max)"
+		aliases := []string{"_peerdb_target"}
+		if historyMode {
+			aliases = append(aliases, "_peerdb_current")
+		}
+		for _, alias := range aliases {
+			if !strings.Contains(mergeStmt, removeSpacesTabsNewlines(fmt.Sprintf(predicate, alias))) {
+				t.Errorf("Merge statement %s does not prune partitions of %s", stmts[5], alias)
+			}
+		}
+	}
+}
+
 func removeSpacesTabsNewlines(s string) string {
 	s = strings.ReplaceAll(s, " ", "")
 	s = strings.ReplaceAll(s, "\t", "")
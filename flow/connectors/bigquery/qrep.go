@@ -13,18 +13,6 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-func (c *BigQueryConnector) GetQRepPartitions(config *protos.QRepConfig,
-	last *protos.QRepPartition,
-) ([]*protos.QRepPartition, error) {
-	panic("not implemented")
-}
-
-func (c *BigQueryConnector) PullQRepRecords(config *protos.QRepConfig,
-	partition *protos.QRepPartition,
-) (*model.QRecordBatch, error) {
-	panic("not implemented")
-}
-
 func (c *BigQueryConnector) SyncQRepRecords(
 	config *protos.QRepConfig,
 	partition *protos.QRepPartition,
@@ -64,6 +52,9 @@ func (c *BigQueryConnector) SyncQRepRecords(
 	case protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO:
 		avroSync := &QRepAvroSyncMethod{connector: c, gcsBucket: config.StagingPath}
 		return avroSync.SyncQRepRecords(config.FlowJobName, destTable, partition, tblMetadata, stream)
+	case protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_WRITE:
+		storageWriteSync := NewQRepStorageWriteSyncMethod(c)
+		return storageWriteSync.SyncQRepRecords(config.FlowJobName, destTable, partition, tblMetadata, stream)
 	default:
 		return 0, fmt.Errorf("unsupported sync mode: %s", syncMode)
 	}
@@ -53,7 +53,7 @@ func (s *QRepAvroSyncMethod) SyncRecords(
 	stagingTable := fmt.Sprintf("%s_%s_staging", dstTableName, fmt.Sprint(syncBatchID))
 	numRecords, err := s.writeToStage(fmt.Sprint(syncBatchID), dstTableName, avroSchema, stagingTable, stream, nullable)
 	if err != nil {
-		return -1, fmt.Errorf("failed to push to avro stage: %v", err)
+		return -1, fmt.Errorf("failed to push to avro stage: %w", err)
 	}
 
 	bqClient := s.connector.client
@@ -79,7 +79,7 @@ func (s *QRepAvroSyncMethod) SyncRecords(
 	stmts = append(stmts, "COMMIT TRANSACTION;")
 	_, err = bqClient.Query(strings.Join(stmts, "\n")).Read(s.connector.ctx)
 	if err != nil {
-		return -1, fmt.Errorf("failed to execute statements in a transaction: %v", err)
+		return -1, fmt.Errorf("failed to execute statements in a transaction: %w", err)
 	}
 
 	// drop the staging table
@@ -121,7 +121,7 @@ func (s *QRepAvroSyncMethod) SyncQRepRecords(
 	stagingTable := fmt.Sprintf("%s_%s_staging", dstTableName, strings.ReplaceAll(partition.PartitionId, "-", "_"))
 	numRecords, err := s.writeToStage(partition.PartitionId, flowJobName, avroSchema, stagingTable, stream, nullable)
 	if err != nil {
-		return -1, fmt.Errorf("failed to push to avro stage: %v", err)
+		return -1, fmt.Errorf("failed to push to avro stage: %w", err)
 	}
 	activity.RecordHeartbeat(s.connector.ctx, fmt.Sprintf(
 		"Flow job %s: running insert-into-select transaction for"+
@@ -153,7 +153,7 @@ func (s *QRepAvroSyncMethod) SyncQRepRecords(
 	syncRecordsStartTime := time.Now()
 	_, err = bqClient.Query(strings.Join(stmts, "\n")).Read(s.connector.ctx)
 	if err != nil {
-		return -1, fmt.Errorf("failed to execute statements in a transaction: %v", err)
+		return -1, fmt.Errorf("failed to execute statements in a transaction: %w", err)
 	}
 	metrics.LogQRepSyncMetrics(s.connector.ctx, flowJobName,
 		int64(numRecords), time.Since(syncRecordsStartTime))
@@ -0,0 +1,454 @@
+package connbigquery
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	utils "github.com/PeerDB-io/peer-flow/connectors/utils/partition"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"go.temporal.io/sdk/activity"
+	"google.golang.org/api/iterator"
+)
+
+func (c *BigQueryConnector) GetQRepPartitions(config *protos.QRepConfig,
+	last *protos.QRepPartition,
+) ([]*protos.QRepPartition, error) {
+	if config.WatermarkColumn == "" {
+		log.Infof("watermark column is empty, doing full table refresh")
+		return []*protos.QRepPartition{
+			{
+				PartitionId:        uuid.New().String(),
+				FullTablePartition: true,
+			},
+		}, nil
+	}
+
+	// only rows after the end of the last partition are replicated.
+	quotedWatermarkColumn := fmt.Sprintf("`%s`", config.WatermarkColumn)
+	whereClause := ""
+	var params []bigquery.QueryParameter
+	if last != nil && last.Range != nil {
+		lastEnd, err := utils.PartitionRangeEnd(last)
+		if err != nil {
+			return nil, err
+		}
+		minVal, err := c.watermarkParamValue(config, lastEnd)
+		if err != nil {
+			return nil, err
+		}
+		whereClause = fmt.Sprintf("WHERE %s > @minVal", quotedWatermarkColumn)
+		params = []bigquery.QueryParameter{{Name: "minVal", Value: minVal}}
+	}
+
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s %s", config.WatermarkTable, whereClause)
+	it, err := c.readQuery(countQuery, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query for total rows: %w", err)
+	}
+	var countRow []bigquery.Value
+	if err := it.Next(&countRow); err != nil {
+		return nil, fmt.Errorf("failed to read total rows: %w", err)
+	}
+	totalRows := countRow[0].(int64)
+
+	if totalRows == 0 {
+		log.Warnf("no records to replicate for flow job %s, returning", config.FlowJobName)
+		return make([]*protos.QRepPartition, 0), nil
+	}
+
+	numPartitions := int64(1)
+	if config.NumRowsPerPartition > 0 {
+		numRowsPerPartition := int64(config.NumRowsPerPartition)
+		numPartitions = (totalRows + numRowsPerPartition - 1) / numRowsPerPartition
+	}
+	log.Infof("total rows: %d, num partitions: %d, num rows per partition: %d",
+		totalRows, numPartitions, config.NumRowsPerPartition)
+
+	partitionsQuery := utils.PartitionsQuery(quotedWatermarkColumn, config.WatermarkTable, whereClause, numPartitions)
+	log.Infof("partitions query: %s", partitionsQuery)
+	it, err = c.readQuery(partitionsQuery, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query for partitions: %w", err)
+	}
+
+	partitionHelper := utils.NewPartitionHelper()
+	for {
+		var row []bigquery.Value
+		err := it.Next(&row)
+		if err == iterator.Done {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to read partitions: %w", err)
+		}
+
+		start, err := bigQueryWatermarkValue(row[1])
+		if err != nil {
+			return nil, err
+		}
+		end, err := bigQueryWatermarkValue(row[2])
+		if err != nil {
+			return nil, err
+		}
+
+		err = partitionHelper.AddPartition(start, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add partition: %w", err)
+		}
+	}
+
+	return partitionHelper.GetPartitions(), nil
+}
+
+// bigQueryWatermarkValue converts a value of the watermark column to an int64 or a time.Time.
+// DATE and DATETIME values are taken as UTC, see bigQueryTimestampParam for the way back.
+func bigQueryWatermarkValue(value bigquery.Value) (interface{}, error) {
+	switch v := value.(type) {
+	case int64, time.Time:
+		return v, nil
+	case civil.DateTime:
+		return v.In(time.UTC), nil
+	case civil.Date:
+		return v.In(time.UTC), nil
+	default:
+		return nil, fmt.Errorf("unsupported type %T for watermark column, expected integer or timestamp", value)
+	}
+}
+
+// bigQueryTimestampParam converts the bound of a timestamp partition back to the type of the watermark column,
+// BigQuery doesn't compare DATE or DATETIME columns to TIMESTAMP parameters.
+func bigQueryTimestampParam(value time.Time, watermarkType bigquery.FieldType) interface{} {
+	switch watermarkType {
+	case bigquery.DateFieldType:
+		return civil.DateOf(value.UTC())
+	case bigquery.DateTimeFieldType:
+		return civil.DateTimeOf(value.UTC())
+	default:
+		return value
+	}
+}
+
+// watermarkParamValue converts the bound of a partition to a parameter comparable to the watermark column.
+func (c *BigQueryConnector) watermarkParamValue(config *protos.QRepConfig, value interface{}) (interface{}, error) {
+	ts, ok := value.(time.Time)
+	if !ok {
+		return value, nil
+	}
+	watermarkType, err := c.watermarkColumnType(config)
+	if err != nil {
+		return nil, err
+	}
+	return bigQueryTimestampParam(ts, watermarkType), nil
+}
+
+// watermarkColumnType returns the type of the watermark column, through a dry run that isn't billed.
+func (c *BigQueryConnector) watermarkColumnType(config *protos.QRepConfig) (bigquery.FieldType, error) {
+	q := c.client.Query(fmt.Sprintf("SELECT `%s` FROM %s", config.WatermarkColumn, config.WatermarkTable))
+	q.DefaultProjectID = c.bqConfig.ProjectId
+	q.DefaultDatasetID = c.datasetID
+	q.DryRun = true
+	job, err := q.Run(c.ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get type of watermark column: %w", err)
+	}
+	stats, ok := job.LastStatus().Statistics.Details.(*bigquery.QueryStatistics)
+	if !ok || len(stats.Schema) != 1 {
+		return "", fmt.Errorf("failed to get type of watermark column %s", config.WatermarkColumn)
+	}
+	return stats.Schema[0].Type, nil
+}
+
+// readQuery runs a query with the dataset of the peer as default dataset.
+func (c *BigQueryConnector) readQuery(query string, params []bigquery.QueryParameter) (*bigquery.RowIterator, error) {
+	q := c.client.Query(query)
+	q.DefaultProjectID = c.bqConfig.ProjectId
+	q.DefaultDatasetID = c.datasetID
+	q.Parameters = params
+	return q.Read(c.ctx)
+}
+
+// PullQRepRecords reads the rows of a partition into a batch, see PullQRepRecordStream.
+func (c *BigQueryConnector) PullQRepRecords(config *protos.QRepConfig,
+	partition *protos.QRepPartition,
+) (*model.QRecordBatch, error) {
+	return model.NewQRecordBatchFromStream(1024, func(stream *model.QRecordStream) error {
+		_, err := c.PullQRepRecordStream(config, partition, stream)
+		return err
+	})
+}
+
+// PullQRepRecordStream reads the rows of a partition into the stream.
+func (c *BigQueryConnector) PullQRepRecordStream(
+	config *protos.QRepConfig,
+	partition *protos.QRepPartition,
+	stream *model.QRecordStream,
+) (int, error) {
+	defer close(stream.Records)
+
+	numRecords, err := c.pullRows(config, partition, stream)
+	if err != nil {
+		if !stream.IsSchemaSet() {
+			stream.SchemaChan() <- &model.QRecordSchemaOrError{Err: err}
+		} else {
+			stream.Records <- &model.QRecordOrError{Err: err}
+		}
+		log.WithFields(log.Fields{
+			"flowName":    config.FlowJobName,
+			"partitionID": partition.PartitionId,
+		}).Errorf("failed to pull records: %v", err)
+		return 0, err
+	}
+
+	log.WithFields(log.Fields{
+		"flowName":    config.FlowJobName,
+		"partitionID": partition.PartitionId,
+	}).Infof("pulled %d records", numRecords)
+	return numRecords, nil
+}
+
+func (c *BigQueryConnector) pullRows(
+	config *protos.QRepConfig,
+	partition *protos.QRepPartition,
+	stream *model.QRecordStream,
+) (int, error) {
+	var watermarkType bigquery.FieldType
+	if partition.Range.GetTimestampRange() != nil {
+		var err error
+		watermarkType, err = c.watermarkColumnType(config)
+		if err != nil {
+			return 0, err
+		}
+	}
+	query, params, err := buildQRepPullQuery(config.Query, partition, watermarkType)
+	if err != nil {
+		return 0, err
+	}
+
+	it, err := c.readQuery(query, params)
+	if err != nil {
+		return 0, fmt.Errorf("failed to run query: %w", err)
+	}
+
+	const heartBeatNumRows = 25000
+	numRecords := 0
+	var fields []*model.QField
+	for {
+		var row []bigquery.Value
+		err := it.Next(&row)
+		if err != nil && err != iterator.Done {
+			return 0, fmt.Errorf("failed to read row: %w", err)
+		}
+
+		// the schema of the rows is known once the first page is fetched.
+		if fields == nil {
+			var schemaErr error
+			fields, schemaErr = bigQuerySchemaToQFields(it.Schema)
+			if schemaErr != nil {
+				return 0, schemaErr
+			}
+			if err := stream.SetSchema(model.NewQRecordSchema(fields)); err != nil {
+				return 0, fmt.Errorf("failed to set schema: %w", err)
+			}
+		}
+		if err == iterator.Done {
+			break
+		}
+
+		record := model.NewQRecord(len(fields))
+		for i, field := range fields {
+			value, err := bigQueryValueToQValue(field.Type, it.Schema[i], row[i])
+			if err != nil {
+				return 0, fmt.Errorf("failed to convert column %s: %w", field.Name, err)
+			}
+			record.Set(i, value)
+		}
+		stream.Records <- &model.QRecordOrError{Record: record}
+
+		numRecords++
+		if numRecords%heartBeatNumRows == 0 {
+			activity.RecordHeartbeat(c.ctx, fmt.Sprintf("pulled %d rows", numRecords))
+		}
+	}
+
+	return numRecords, nil
+}
+
+// buildQRepPullQuery templates {{.start}} and {{.end}} of the query with the bounds of the partition,
+// timestamp bounds are bound as the type of the watermark column. The returned params are nil for full
+// table partitions, whose query is run as is.
+func buildQRepPullQuery(query string,
+	partition *protos.QRepPartition,
+	watermarkType bigquery.FieldType,
+) (string, []bigquery.QueryParameter, error) {
+	templated, rangeStart, rangeEnd, err := utils.TemplatePullQuery(query, partition, "@startRange", "@endRange")
+	if err != nil {
+		return "", nil, err
+	}
+	log.Infof("templated query: %s", templated)
+
+	if partition.FullTablePartition {
+		return templated, nil, nil
+	}
+	if start, ok := rangeStart.(time.Time); ok {
+		rangeStart = bigQueryTimestampParam(start, watermarkType)
+		rangeEnd = bigQueryTimestampParam(rangeEnd.(time.Time), watermarkType)
+	}
+
+	return templated, []bigquery.QueryParameter{
+		{Name: "startRange", Value: rangeStart},
+		{Name: "endRange", Value: rangeEnd},
+	}, nil
+}
+
+// bigQuerySchemaToQFields converts the schema of a query result to QFields.
+// Repeated integers, floats and strings are read as arrays, other repeated fields and records as JSON.
+func bigQuerySchemaToQFields(schema bigquery.Schema) ([]*model.QField, error) {
+	fields := make([]*model.QField, 0, len(schema))
+	for _, fieldSchema := range schema {
+		var kind qvalue.QValueKind
+		switch {
+		case fieldSchema.Repeated:
+			switch fieldSchema.Type {
+			case bigquery.IntegerFieldType:
+				kind = qvalue.QValueKindArrayInt64
+			case bigquery.FloatFieldType:
+				kind = qvalue.QValueKindArrayFloat64
+			case bigquery.StringFieldType:
+				kind = qvalue.QValueKindArrayString
+			default:
+				kind = qvalue.QValueKindJSON
+			}
+		case fieldSchema.Type == bigquery.RecordFieldType:
+			kind = qvalue.QValueKindJSON
+		default:
+			var err error
+			kind, err = BigQueryTypeToQValueKind(fieldSchema.Type)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert type of column %s: %w", fieldSchema.Name, err)
+			}
+		}
+
+		fields = append(fields, &model.QField{
+			Name:     fieldSchema.Name,
+			Type:     kind,
+			Nullable: !fieldSchema.Required,
+		})
+	}
+	return fields, nil
+}
+
+// bigQueryValueToQValue converts a value read by the bigquery client to a QValue of the given kind.
+func bigQueryValueToQValue(kind qvalue.QValueKind,
+	fieldSchema *bigquery.FieldSchema,
+	value bigquery.Value,
+) (qvalue.QValue, error) {
+	if value == nil {
+		return qvalue.QValue{Kind: kind, Value: nil}, nil
+	}
+
+	switch kind {
+	case qvalue.QValueKindArrayInt64:
+		return bigQueryArrayToQValue[int64](kind, value)
+	case qvalue.QValueKindArrayFloat64:
+		return bigQueryArrayToQValue[float64](kind, value)
+	case qvalue.QValueKindArrayString:
+		return bigQueryArrayToQValue[string](kind, value)
+	case qvalue.QValueKindJSON:
+		if s, ok := value.(string); ok && !fieldSchema.Repeated {
+			return qvalue.QValue{Kind: kind, Value: s}, nil
+		}
+		jsonValue, err := json.Marshal(bigQueryValueToJSON(fieldSchema, value, fieldSchema.Repeated))
+		if err != nil {
+			return qvalue.QValue{}, fmt.Errorf("failed to convert value to JSON: %w", err)
+		}
+		return qvalue.QValue{Kind: kind, Value: string(jsonValue)}, nil
+	}
+
+	switch v := value.(type) {
+	case int64, float64, bool, string, []byte, time.Time, *big.Rat:
+		return qvalue.QValue{Kind: kind, Value: v}, nil
+	case civil.Date:
+		return qvalue.QValue{Kind: kind, Value: v.In(time.UTC)}, nil
+	case civil.DateTime:
+		return qvalue.QValue{Kind: kind, Value: v.In(time.UTC)}, nil
+	case civil.Time:
+		return qvalue.QValue{
+			Kind:  kind,
+			Value: time.Date(1970, 1, 1, v.Hour, v.Minute, v.Second, v.Nanosecond, time.UTC),
+		}, nil
+	default:
+		return qvalue.QValue{}, fmt.Errorf("unsupported bigquery value %T", value)
+	}
+}
+
+func bigQueryArrayToQValue[T int64 | float64 | string](
+	kind qvalue.QValueKind,
+	value bigquery.Value,
+) (qvalue.QValue, error) {
+	items, ok := value.([]bigquery.Value)
+	if !ok {
+		return qvalue.QValue{}, fmt.Errorf("expected array, got %T", value)
+	}
+	elements := make([]T, 0, len(items))
+	for _, item := range items {
+		element, ok := item.(T)
+		if !ok {
+			return qvalue.QValue{}, fmt.Errorf("unexpected array element %T", item)
+		}
+		elements = append(elements, element)
+	}
+	return qvalue.QValue{Kind: kind, Value: elements}, nil
+}
+
+// bigQueryValueToJSON converts a value to a value marshalled as JSON, records are converted to objects.
+func bigQueryValueToJSON(fieldSchema *bigquery.FieldSchema, value bigquery.Value, repeated bool) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	if repeated {
+		items, ok := value.([]bigquery.Value)
+		if !ok {
+			return value
+		}
+		result := make([]interface{}, 0, len(items))
+		for _, item := range items {
+			result = append(result, bigQueryValueToJSON(fieldSchema, item, false))
+		}
+		return result
+	}
+
+	switch v := value.(type) {
+	case []bigquery.Value:
+		if fieldSchema.Type != bigquery.RecordFieldType {
+			return v
+		}
+		object := make(map[string]interface{}, len(v))
+		for i, nested := range fieldSchema.Schema {
+			if i < len(v) {
+				object[nested.Name] = bigQueryValueToJSON(nested, v[i], nested.Repeated)
+			}
+		}
+		return object
+	case *big.Rat:
+		// unparameterized NUMERIC and BIGNUMERIC columns have no scale in the schema.
+		scale := int(fieldSchema.Scale)
+		if scale == 0 && fieldSchema.Type == bigquery.BigNumericFieldType {
+			scale = bigquery.BigNumericScaleDigits
+		} else if scale == 0 {
+			scale = bigquery.NumericScaleDigits
+		}
+		return json.Number(v.FloatString(scale))
+	case civil.Date, civil.DateTime, civil.Time:
+		return fmt.Sprint(v)
+	default:
+		return v
+	}
+}
@@ -0,0 +1,152 @@
+package connbigquery
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/civil"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestBuildQRepPullQuery(t *testing.T) {
+	query := "SELECT * FROM t WHERE id BETWEEN {{.start}} AND {{.end}}"
+	partition := &protos.QRepPartition{
+		Range: &protos.PartitionRange{
+			Range: &protos.PartitionRange_IntRange{
+				IntRange: &protos.IntPartitionRange{Start: 1, End: 10},
+			},
+		},
+	}
+
+	templated, params, err := buildQRepPullQuery(query, partition, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if templated != "SELECT * FROM t WHERE id BETWEEN @startRange AND @endRange" {
+		t.Errorf("unexpected query: %s", templated)
+	}
+	expected := []bigquery.QueryParameter{
+		{Name: "startRange", Value: int64(1)},
+		{Name: "endRange", Value: int64(10)},
+	}
+	if !reflect.DeepEqual(params, expected) {
+		t.Errorf("unexpected params: %v", params)
+	}
+
+	_, params, err = buildQRepPullQuery(query, &protos.QRepPartition{FullTablePartition: true}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params != nil {
+		t.Errorf("expected no params for full table partition, got %v", params)
+	}
+}
+
+func TestBuildQRepPullQueryCivilWatermark(t *testing.T) {
+	query := "SELECT * FROM t WHERE d BETWEEN {{.start}} AND {{.end}}"
+	// partitions of DATE and DATETIME columns are computed from their values taken as UTC.
+	start, err := bigQueryWatermarkValue(civil.Date{Year: 2023, Month: time.June, Day: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	end, err := bigQueryWatermarkValue(civil.DateTime{
+		Date: civil.Date{Year: 2023, Month: time.June, Day: 30},
+		Time: civil.Time{Hour: 23, Minute: 59},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	partition := &protos.QRepPartition{
+		Range: &protos.PartitionRange{
+			Range: &protos.PartitionRange_TimestampRange{
+				TimestampRange: &protos.TimestampPartitionRange{
+					Start: timestamppb.New(start.(time.Time)),
+					End:   timestamppb.New(end.(time.Time)),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		watermarkType bigquery.FieldType
+		expected      []bigquery.QueryParameter
+	}{
+		{
+			watermarkType: bigquery.DateFieldType,
+			expected: []bigquery.QueryParameter{
+				{Name: "startRange", Value: civil.Date{Year: 2023, Month: time.June, Day: 1}},
+				{Name: "endRange", Value: civil.Date{Year: 2023, Month: time.June, Day: 30}},
+			},
+		},
+		{
+			watermarkType: bigquery.DateTimeFieldType,
+			expected: []bigquery.QueryParameter{
+				{Name: "startRange", Value: civil.DateTime{Date: civil.Date{Year: 2023, Month: time.June, Day: 1}}},
+				{Name: "endRange", Value: civil.DateTime{
+					Date: civil.Date{Year: 2023, Month: time.June, Day: 30},
+					Time: civil.Time{Hour: 23, Minute: 59},
+				}},
+			},
+		},
+		{
+			watermarkType: bigquery.TimestampFieldType,
+			expected: []bigquery.QueryParameter{
+				{Name: "startRange", Value: start},
+				{Name: "endRange", Value: end},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		_, params, err := buildQRepPullQuery(query, partition, tc.watermarkType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(params, tc.expected) {
+			t.Errorf("%s: unexpected params: %v", tc.watermarkType, params)
+		}
+	}
+}
+
+func TestBigQueryValueToQValue(t *testing.T) {
+	schema := bigquery.Schema{
+		{Name: "d", Type: bigquery.DateFieldType},
+		{Name: "tm", Type: bigquery.TimeFieldType},
+		{Name: "ids", Type: bigquery.IntegerFieldType, Repeated: true},
+		{Name: "rec", Type: bigquery.RecordFieldType, Schema: bigquery.Schema{
+			{Name: "a", Type: bigquery.IntegerFieldType},
+			{Name: "b", Type: bigquery.StringFieldType, Repeated: true},
+		}},
+	}
+	fields, err := bigQuerySchemaToQFields(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	row := []bigquery.Value{
+		civil.Date{Year: 2023, Month: time.June, Day: 1},
+		civil.Time{Hour: 12, Minute: 30, Second: 15},
+		[]bigquery.Value{int64(1), int64(2)},
+		[]bigquery.Value{int64(3), []bigquery.Value{"x", "y"}},
+	}
+	expected := []qvalue.QValue{
+		{Kind: qvalue.QValueKindDate, Value: time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)},
+		{Kind: qvalue.QValueKindTime, Value: time.Date(1970, 1, 1, 12, 30, 15, 0, time.UTC)},
+		{Kind: qvalue.QValueKindArrayInt64, Value: []int64{1, 2}},
+		{Kind: qvalue.QValueKindJSON, Value: `{"a":3,"b":["x","y"]}`},
+	}
+
+	for i, field := range fields {
+		value, err := bigQueryValueToQValue(field.Type, schema[i], row[i])
+		if err != nil {
+			t.Fatalf("failed to convert %s: %v", field.Name, err)
+		}
+		if !reflect.DeepEqual(value, expected[i]) {
+			t.Errorf("unexpected value for %s: %v, expected %v", field.Name, value, expected[i])
+		}
+	}
+}
@@ -0,0 +1,537 @@
+package connbigquery
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/bigquery/storage/apiv1/storagepb"
+	"cloud.google.com/go/bigquery/storage/managedwriter"
+	"cloud.google.com/go/bigquery/storage/managedwriter/adapt"
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/connectors/utils/metrics"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"go.temporal.io/sdk/activity"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	// an append request can be at most 10MB, rows are sent in requests of at most this size.
+	storageWriteMaxRequestBytes = 8 * 1024 * 1024
+	// scales of the NUMERIC and BIGNUMERIC types, the Storage Write API expects them scaled by these.
+	bigQueryNumericScale    = 9
+	bigQueryBigNumericScale = 38
+)
+
+type QRepStorageWriteSyncMethod struct {
+	connector *BigQueryConnector
+}
+
+func NewQRepStorageWriteSyncMethod(connector *BigQueryConnector) *QRepStorageWriteSyncMethod {
+	return &QRepStorageWriteSyncMethod{
+		connector: connector,
+	}
+}
+
+// SyncRecords streams a batch of raw records to the raw table and updates the metadata of the mirror.
+// It returns the number of rows synced.
+func (s *QRepStorageWriteSyncMethod) SyncRecords(
+	rawTableName string,
+	flowJobName string,
+	lastCP int64,
+	rawTableMetadata *bigquery.TableMetadata,
+	syncBatchID int64,
+	stream *model.QRecordStream,
+) (int, error) {
+	// the metadata is updated after the rows are written, the rows a previous attempt of this batch wrote
+	// before failing are deleted rather than kept: a committed stream may have written only part of them,
+	// and the batch pulled again doesn't always end where it did.
+	numSyncedRows, err := s.connector.getRawRowsInBatch(rawTableName, syncBatchID)
+	if err != nil {
+		return -1, fmt.Errorf("failed to check rows of batch %d: %w", syncBatchID, err)
+	}
+	if numSyncedRows > 0 {
+		log.WithFields(log.Fields{
+			"flowName":    flowJobName,
+			"syncBatchID": syncBatchID,
+		}).Infof("deleting %d rows of batch %d written to %s by a previous attempt",
+			numSyncedRows, syncBatchID, rawTableName)
+		err = s.connector.deleteRawRowsInBatch(rawTableName, syncBatchID)
+		if err != nil {
+			return -1, fmt.Errorf("failed to delete rows of batch %d: %w", syncBatchID, err)
+		}
+	}
+
+	activity.RecordHeartbeat(s.connector.ctx, fmt.Sprintf(
+		"Flow job %s: streaming records to raw table %s for sync batch ID %d",
+		flowJobName, rawTableName, syncBatchID),
+	)
+	writeMode := s.connector.bqConfig.GetStorageWriteMode()
+	numRecords, err := s.writeRows(fmt.Sprint(syncBatchID), rawTableName, rawTableMetadata, writeMode, stream)
+	if err != nil {
+		return -1, fmt.Errorf("failed to write records to raw table: %w", err)
+	}
+
+	updateMetadataStmt, err := s.connector.getUpdateMetadataStmt(flowJobName, lastCP, syncBatchID)
+	if err != nil {
+		return -1, fmt.Errorf("failed to update metadata: %v", err)
+	}
+	_, err = s.connector.client.Query(updateMetadataStmt).Read(s.connector.ctx)
+	if err != nil {
+		return -1, fmt.Errorf("failed to update metadata: %v", err)
+	}
+
+	return numRecords, nil
+}
+
+// SyncQRepRecords streams a partition to the destination table. The rows of a partition are always
+// written to a pending stream so that they are committed together, a retry of a partition that failed
+// before its commit doesn't duplicate rows. The commit and the metadata of the partition are not atomic
+// though, when inserting the metadata fails after the commit the retry writes the rows of the partition
+// again, so they are duplicated.
+func (s *QRepStorageWriteSyncMethod) SyncQRepRecords(
+	flowJobName string,
+	dstTableName string,
+	partition *protos.QRepPartition,
+	dstTableMetadata *bigquery.TableMetadata,
+	stream *model.QRecordStream,
+) (int, error) {
+	startTime := time.Now()
+
+	numRecords, err := s.writeRows(partition.PartitionId, dstTableName, dstTableMetadata,
+		protos.BigqueryStorageWriteMode_BIGQUERY_STORAGE_WRITE_MODE_PENDING, stream)
+	if err != nil {
+		return -1, fmt.Errorf("failed to write records to destination table: %w", err)
+	}
+	metrics.LogQRepSyncMetrics(s.connector.ctx, flowJobName, int64(numRecords), time.Since(startTime))
+
+	insertMetadataStmt, err := s.connector.createMetadataInsertStatement(partition, flowJobName, startTime)
+	if err != nil {
+		return -1, fmt.Errorf("failed to create metadata insert statement: %v", err)
+	}
+	_, err = s.connector.client.Query(insertMetadataStmt).Read(s.connector.ctx)
+	if err != nil {
+		return -1, fmt.Errorf("failed to insert metadata for partition %s: %v", partition.PartitionId, err)
+	}
+
+	log.WithFields(log.Fields{
+		"flowName":    flowJobName,
+		"partitionID": partition.PartitionId,
+	}).Infof("streamed %d records into %s.%s", numRecords, s.connector.datasetID, dstTableName)
+	return numRecords, nil
+}
+
+// writeRows writes the records of the stream to a new write stream of the table. Every append is sent
+// with its offset in the stream, so an append retried by the client is not written twice.
+func (s *QRepStorageWriteSyncMethod) writeRows(
+	syncID string,
+	tableName string,
+	tableMetadata *bigquery.TableMetadata,
+	writeMode protos.BigqueryStorageWriteMode,
+	stream *model.QRecordStream,
+) (int, error) {
+	numRecords := 0
+	shutdown := utils.HeartbeatRoutine(s.connector.ctx, time.Minute,
+		func() string {
+			return fmt.Sprintf("written %d records to table %s for partition/batch ID %s",
+				numRecords, tableName, syncID)
+		},
+	)
+	defer func() {
+		shutdown <- true
+	}()
+
+	storageSchema, err := adapt.BQSchemaToStorageTableSchema(tableMetadata.Schema)
+	if err != nil {
+		return 0, fmt.Errorf("failed to convert schema of table %s: %w", tableName, err)
+	}
+	descriptor, err := adapt.StorageSchemaToProto2Descriptor(storageSchema, "root")
+	if err != nil {
+		return 0, fmt.Errorf("failed to create descriptor for table %s: %w", tableName, err)
+	}
+	messageDescriptor, ok := descriptor.(protoreflect.MessageDescriptor)
+	if !ok {
+		return 0, fmt.Errorf("descriptor for table %s is not a message descriptor", tableName)
+	}
+	descriptorProto, err := adapt.NormalizeDescriptor(messageDescriptor)
+	if err != nil {
+		return 0, fmt.Errorf("failed to normalize descriptor for table %s: %w", tableName, err)
+	}
+
+	schema, err := stream.Schema()
+	if err != nil {
+		log.WithFields(log.Fields{
+			"partitonOrBatchID": syncID,
+		}).Errorf("failed to get schema from stream: %v", err)
+		return 0, fmt.Errorf("failed to get schema from stream: %w", err)
+	}
+	columnIndexes := storageColumnIndexes(tableMetadata.Schema, schema.GetColumnNames())
+
+	streamType := managedwriter.CommittedStream
+	if writeMode == protos.BigqueryStorageWriteMode_BIGQUERY_STORAGE_WRITE_MODE_PENDING {
+		streamType = managedwriter.PendingStream
+	}
+	writeClient, err := s.connector.getWriteClient()
+	if err != nil {
+		return 0, err
+	}
+	managedStream, err := writeClient.NewManagedStream(s.connector.ctx,
+		managedwriter.WithDestinationTable(managedwriter.TableParentFromParts(
+			s.connector.bqConfig.ProjectId, s.connector.datasetID, tableName)),
+		managedwriter.WithType(streamType),
+		managedwriter.WithSchemaDescriptor(descriptorProto),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create write stream for table %s: %w", tableName, err)
+	}
+	defer managedStream.Close()
+
+	results := make([]*managedwriter.AppendResult, 0)
+	var offset int64 = 0
+	rows := make([][]byte, 0)
+	rowsBytes := 0
+	appendRows := func() error {
+		result, err := managedStream.AppendRows(s.connector.ctx, rows, managedwriter.WithOffset(offset))
+		if err != nil {
+			return fmt.Errorf("failed to append rows at offset %d: %w", offset, err)
+		}
+		results = append(results, result)
+		offset += int64(len(rows))
+		rows = make([][]byte, 0)
+		rowsBytes = 0
+		return nil
+	}
+
+	for qRecordOrErr := range stream.Records {
+		if qRecordOrErr.Err != nil {
+			log.WithFields(log.Fields{
+				"batchOrPartitionID": syncID,
+			}).Errorf("[bq_storage_write] failed to get record from stream: %v", qRecordOrErr.Err)
+			return 0, fmt.Errorf("[bq_storage_write] failed to get record from stream: %w", qRecordOrErr.Err)
+		}
+
+		row, err := qRecordToStorageRow(qRecordOrErr.Record, tableMetadata.Schema, columnIndexes, messageDescriptor)
+		if err != nil {
+			return 0, fmt.Errorf("failed to convert QRecord to row: %w", err)
+		}
+		if len(rows) > 0 && rowsBytes+len(row) > storageWriteMaxRequestBytes {
+			if err := appendRows(); err != nil {
+				return 0, err
+			}
+		}
+		rows = append(rows, row)
+		rowsBytes += len(row)
+		numRecords++
+	}
+	if len(rows) > 0 {
+		if err := appendRows(); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, result := range results {
+		if _, err := result.GetResult(s.connector.ctx); err != nil {
+			return 0, fmt.Errorf("failed to append rows to table %s: %w", tableName, err)
+		}
+	}
+
+	if _, err := managedStream.Finalize(s.connector.ctx); err != nil {
+		return 0, fmt.Errorf("failed to finalize write stream for table %s: %w", tableName, err)
+	}
+
+	if streamType == managedwriter.PendingStream {
+		resp, err := writeClient.BatchCommitWriteStreams(s.connector.ctx, &storagepb.BatchCommitWriteStreamsRequest{
+			Parent:       managedwriter.TableParentFromStreamName(managedStream.StreamName()),
+			WriteStreams: []string{managedStream.StreamName()},
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to commit write stream for table %s: %w", tableName, err)
+		}
+		if len(resp.GetStreamErrors()) > 0 {
+			return 0, fmt.Errorf("failed to commit write stream for table %s: %v",
+				tableName, resp.GetStreamErrors())
+		}
+	}
+
+	log.Printf("streamed %d rows into %s for partition/batch ID %s", numRecords, tableName, syncID)
+	return numRecords, nil
+}
+
+// storageColumnIndexes returns the index in the stream of every column of the table, or -1 for the
+// columns that are not in the stream, which are left NULL.
+func storageColumnIndexes(tableSchema bigquery.Schema, columnNames []string) []int {
+	columnIndexes := make([]int, len(tableSchema))
+	for i, field := range tableSchema {
+		columnIndexes[i] = -1
+		for j, columnName := range columnNames {
+			if strings.EqualFold(field.Name, columnName) {
+				columnIndexes[i] = j
+				break
+			}
+		}
+	}
+	return columnIndexes
+}
+
+// qRecordToStorageRow serializes a QRecord to a row of the message described by the table schema.
+func qRecordToStorageRow(
+	record *model.QRecord,
+	tableSchema bigquery.Schema,
+	columnIndexes []int,
+	messageDescriptor protoreflect.MessageDescriptor,
+) ([]byte, error) {
+	message := dynamicpb.NewMessage(messageDescriptor)
+	fields := messageDescriptor.Fields()
+	for i, bqField := range tableSchema {
+		if columnIndexes[i] < 0 {
+			continue
+		}
+		v := record.Entries[columnIndexes[i]]
+		if v.Value == nil {
+			continue
+		}
+
+		fd := fields.Get(i)
+		if fd.IsList() {
+			list := message.Mutable(fd).List()
+			elems, err := arrayToStorageValues(v, bqField, fd)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert column %s: %w", bqField.Name, err)
+			}
+			for _, elem := range elems {
+				list.Append(elem)
+			}
+			continue
+		}
+
+		val, err := qValueToStorageValue(v.Value, bqField.Type, fd)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert column %s: %w", bqField.Name, err)
+		}
+		message.Set(fd, val)
+	}
+
+	return proto.Marshal(message)
+}
+
+func arrayToStorageValues(
+	v qvalue.QValue,
+	bqField *bigquery.FieldSchema,
+	fd protoreflect.FieldDescriptor,
+) ([]protoreflect.Value, error) {
+	var elems []interface{}
+	switch arr := v.Value.(type) {
+	case []int32:
+		for _, e := range arr {
+			elems = append(elems, e)
+		}
+	case []int64:
+		for _, e := range arr {
+			elems = append(elems, e)
+		}
+	case []float32:
+		for _, e := range arr {
+			elems = append(elems, e)
+		}
+	case []float64:
+		for _, e := range arr {
+			elems = append(elems, e)
+		}
+	case []string:
+		for _, e := range arr {
+			elems = append(elems, e)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported array value %v of kind %s", v.Value, v.Kind)
+	}
+
+	values := make([]protoreflect.Value, 0, len(elems))
+	for _, elem := range elems {
+		val, err := qValueToStorageValue(elem, bqField.Type, fd)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, val)
+	}
+	return values, nil
+}
+
+// qValueToStorageValue converts a value to the encoding the Storage Write API expects for the field type.
+func qValueToStorageValue(
+	value interface{},
+	fieldType bigquery.FieldType,
+	fd protoreflect.FieldDescriptor,
+) (protoreflect.Value, error) {
+	switch fieldType {
+	case bigquery.StringFieldType, bigquery.JSONFieldType, bigquery.GeographyFieldType:
+		switch val := value.(type) {
+		case string:
+			return protoreflect.ValueOfString(val), nil
+		case [16]byte:
+			return protoreflect.ValueOfString(uuid.UUID(val).String()), nil
+		default:
+			return protoreflect.ValueOfString(fmt.Sprint(val)), nil
+		}
+	case bigquery.IntegerFieldType:
+		switch val := value.(type) {
+		case int:
+			return protoreflect.ValueOfInt64(int64(val)), nil
+		case int16:
+			return protoreflect.ValueOfInt64(int64(val)), nil
+		case int32:
+			return protoreflect.ValueOfInt64(int64(val)), nil
+		case int64:
+			return protoreflect.ValueOfInt64(val), nil
+		}
+	case bigquery.FloatFieldType:
+		switch val := value.(type) {
+		case float32:
+			return protoreflect.ValueOfFloat64(float64(val)), nil
+		case float64:
+			return protoreflect.ValueOfFloat64(val), nil
+		}
+	case bigquery.BooleanFieldType:
+		if val, ok := value.(bool); ok {
+			return protoreflect.ValueOfBool(val), nil
+		}
+	case bigquery.BytesFieldType:
+		if val, ok := value.([]byte); ok {
+			return protoreflect.ValueOfBytes(val), nil
+		}
+	case bigquery.TimestampFieldType:
+		if val, ok := value.(time.Time); ok {
+			return protoreflect.ValueOfInt64(val.UnixMicro()), nil
+		}
+	case bigquery.DateFieldType:
+		if val, ok := value.(time.Time); ok {
+			days := time.Date(val.Year(), val.Month(), val.Day(), 0, 0, 0, 0, time.UTC).Unix() / 86400
+			return protoreflect.ValueOfInt32(int32(days)), nil
+		}
+	case bigquery.TimeFieldType:
+		if val, ok := value.(time.Time); ok {
+			if fd.Kind() == protoreflect.StringKind {
+				return protoreflect.ValueOfString(val.Format("15:04:05.999999")), nil
+			}
+			return protoreflect.ValueOfInt64(encodePackedTimeMicros(val)), nil
+		}
+	case bigquery.DateTimeFieldType:
+		if val, ok := value.(time.Time); ok {
+			if fd.Kind() == protoreflect.StringKind {
+				return protoreflect.ValueOfString(val.Format("2006-01-02 15:04:05.999999")), nil
+			}
+			return protoreflect.ValueOfInt64(encodePackedDateTimeMicros(val)), nil
+		}
+	case bigquery.NumericFieldType, bigquery.BigNumericFieldType:
+		if val, ok := value.(*big.Rat); ok {
+			scale := bigQueryNumericScale
+			if fieldType == bigquery.BigNumericFieldType {
+				scale = bigQueryBigNumericScale
+			}
+			if fd.Kind() == protoreflect.StringKind {
+				return protoreflect.ValueOfString(val.FloatString(scale)), nil
+			}
+			return protoreflect.ValueOfBytes(encodeNumeric(val, scale)), nil
+		}
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported BigQuery field type: %s", fieldType)
+	}
+
+	return protoreflect.Value{}, fmt.Errorf("failed to convert %v to %s", value, fieldType)
+}
+
+// encodePackedTimeMicros encodes the time of day the way the Storage Write API expects TIME values in
+// int64 fields, as hour, minute, second and microsecond bit fields.
+func encodePackedTimeMicros(t time.Time) int64 {
+	seconds := int64(t.Hour())<<12 | int64(t.Minute())<<6 | int64(t.Second())
+	return seconds<<20 | int64(t.Nanosecond()/1000)
+}
+
+// encodePackedDateTimeMicros encodes a civil datetime the way the Storage Write API expects DATETIME
+// values in int64 fields, as year, month, day, hour, minute, second and microsecond bit fields.
+func encodePackedDateTimeMicros(t time.Time) int64 {
+	seconds := int64(t.Year())<<26 | int64(t.Month())<<22 | int64(t.Day())<<17 |
+		int64(t.Hour())<<12 | int64(t.Minute())<<6 | int64(t.Second())
+	return seconds<<20 | int64(t.Nanosecond()/1000)
+}
+
+// encodeNumeric encodes a NUMERIC or BIGNUMERIC value as the little-endian two's complement
+// of the value scaled by 10^scale, rounded towards zero.
+func encodeNumeric(rat *big.Rat, scale int) []byte {
+	scaled := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	scaled.Mul(scaled, rat.Num())
+	scaled.Quo(scaled, rat.Denom())
+
+	// big-endian magnitude with room for the sign bit.
+	magnitude := new(big.Int).Abs(scaled)
+	be := make([]byte, magnitude.BitLen()/8+1)
+	if scaled.Sign() < 0 {
+		// two's complement of the magnitude in len(be) bytes.
+		twos := new(big.Int).Lsh(big.NewInt(1), uint(len(be)*8))
+		twos.Sub(twos, magnitude)
+		twos.FillBytes(be)
+	} else {
+		magnitude.FillBytes(be)
+	}
+
+	le := make([]byte, len(be))
+	for i, b := range be {
+		le[len(be)-1-i] = b
+	}
+	return le
+}
+
+// getRawRowsInBatch returns the number of rows of a sync batch in the raw table.
+func (c *BigQueryConnector) getRawRowsInBatch(rawTableName string, syncBatchID int64) (int64, error) {
+	queryString := fmt.Sprintf(
+		"SELECT COUNT(*) FROM %s.%s WHERE _peerdb_batch_id = %d;",
+		c.datasetID, rawTableName, syncBatchID,
+	)
+
+	it, err := c.client.Query(queryString).Read(c.ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	var values []bigquery.Value
+	err = it.Next(&values)
+	if err == iterator.Done {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to iterate query results: %w", err)
+	}
+
+	if len(values) != 1 {
+		return 0, fmt.Errorf("expected 1 value, got %d", len(values))
+	}
+
+	count, ok := values[0].(int64)
+	if !ok {
+		return 0, fmt.Errorf("failed to convert %v to int64", values[0])
+	}
+
+	return count, nil
+}
+
+// deleteRawRowsInBatch deletes the rows of a sync batch from the raw table.
+func (c *BigQueryConnector) deleteRawRowsInBatch(rawTableName string, syncBatchID int64) error {
+	queryString := fmt.Sprintf(
+		"DELETE FROM %s.%s WHERE _peerdb_batch_id = %d;",
+		c.datasetID, rawTableName, syncBatchID,
+	)
+
+	_, err := c.client.Query(queryString).Read(c.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to execute query: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package connbigquery
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"cloud.google.com/go/bigquery/storage/managedwriter/adapt"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+func TestEncodeNumeric(t *testing.T) {
+	tests := []struct {
+		value    *big.Rat
+		expected []byte
+	}{
+		{big.NewRat(0, 1), []byte{0x00}},
+		// 1 scaled by 10^9 is 0x3b9aca00
+		{big.NewRat(1, 1), []byte{0x00, 0xca, 0x9a, 0x3b}},
+		{big.NewRat(-1, 1), []byte{0x00, 0x36, 0x65, 0xc4}},
+		// 1/3 is truncated to 333333333, 0x13de4355
+		{big.NewRat(1, 3), []byte{0x55, 0x43, 0xde, 0x13}},
+	}
+
+	for _, tt := range tests {
+		actual := encodeNumeric(tt.value, bigQueryNumericScale)
+		if !bytes.Equal(actual, tt.expected) {
+			t.Errorf("encodeNumeric(%s) = %x, expected %x", tt.value.String(), actual, tt.expected)
+		}
+	}
+}
+
+func TestEncodePackedTime(t *testing.T) {
+	ts := time.Date(2023, time.September, 14, 13, 45, 30, 123456000, time.UTC)
+
+	expectedTime := (int64(13)<<12|int64(45)<<6|int64(30))<<20 | 123456
+	if actual := encodePackedTimeMicros(ts); actual != expectedTime {
+		t.Errorf("encodePackedTimeMicros = %d, expected %d", actual, expectedTime)
+	}
+
+	expectedDateTime := (int64(2023)<<26|int64(9)<<22|int64(14)<<17|
+		int64(13)<<12|int64(45)<<6|int64(30))<<20 | 123456
+	if actual := encodePackedDateTimeMicros(ts); actual != expectedDateTime {
+		t.Errorf("encodePackedDateTimeMicros = %d, expected %d", actual, expectedDateTime)
+	}
+}
+
+func TestStorageColumnIndexes(t *testing.T) {
+	tableSchema := bigquery.Schema{
+		{Name: "id", Type: bigquery.IntegerFieldType},
+		{Name: "Name", Type: bigquery.StringFieldType},
+		{Name: "_PEERDB_SYNCED_AT", Type: bigquery.TimestampFieldType},
+		{Name: "created_at", Type: bigquery.TimestampFieldType},
+	}
+	// columns are matched case insensitively, the columns missing from the stream are left NULL.
+	actual := storageColumnIndexes(tableSchema, []string{"created_at", "id", "name"})
+	expected := []int{1, 2, -1, 0}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("storageColumnIndexes = %v, expected %v", actual, expected)
+	}
+}
+
+func TestQRecordToStorageRow(t *testing.T) {
+	tableSchema := bigquery.Schema{
+		{Name: "id", Type: bigquery.IntegerFieldType},
+		{Name: "name", Type: bigquery.StringFieldType},
+		{Name: "missing", Type: bigquery.StringFieldType},
+		{Name: "created_at", Type: bigquery.TimestampFieldType},
+		{Name: "tags", Type: bigquery.StringFieldType, Repeated: true},
+		{Name: "price", Type: bigquery.NumericFieldType},
+		{Name: "deleted", Type: bigquery.BooleanFieldType},
+	}
+	storageSchema, err := adapt.BQSchemaToStorageTableSchema(tableSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	descriptor, err := adapt.StorageSchemaToProto2Descriptor(storageSchema, "root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	messageDescriptor := descriptor.(protoreflect.MessageDescriptor)
+
+	createdAt := time.Date(2023, time.September, 14, 13, 45, 30, 123456000, time.UTC)
+	record := &model.QRecord{
+		NumEntries: 6,
+		Entries: []qvalue.QValue{
+			{Kind: qvalue.QValueKindTimestamp, Value: createdAt},
+			{Kind: qvalue.QValueKindString, Value: "quick"},
+			{Kind: qvalue.QValueKindInt32, Value: int32(7)},
+			{Kind: qvalue.QValueKindArrayString, Value: []string{"a", "b"}},
+			{Kind: qvalue.QValueKindNumeric, Value: big.NewRat(1, 1)},
+			// a NULL value is left unset
+			{Kind: qvalue.QValueKindBoolean, Value: nil},
+		},
+	}
+	columnIndexes := storageColumnIndexes(tableSchema,
+		[]string{"created_at", "name", "id", "tags", "price", "deleted"})
+	row, err := qRecordToStorageRow(record, tableSchema, columnIndexes, messageDescriptor)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	message := dynamicpb.NewMessage(messageDescriptor)
+	if err := proto.Unmarshal(row, message); err != nil {
+		t.Fatal(err)
+	}
+	fields := messageDescriptor.Fields()
+	if id := message.Get(fields.ByName("id")).Int(); id != 7 {
+		t.Errorf("expected id 7, got %d", id)
+	}
+	if name := message.Get(fields.ByName("name")).String(); name != "quick" {
+		t.Errorf("expected name quick, got %s", name)
+	}
+	if createdAtMicros := message.Get(fields.ByName("created_at")).Int(); createdAtMicros != createdAt.UnixMicro() {
+		t.Errorf("expected created_at %d, got %d", createdAt.UnixMicro(), createdAtMicros)
+	}
+	tags := message.Get(fields.ByName("tags")).List()
+	if tags.Len() != 2 || tags.Get(0).String() != "a" || tags.Get(1).String() != "b" {
+		t.Errorf("expected tags [a b], got %v", tags)
+	}
+	expectedPrice := encodeNumeric(big.NewRat(1, 1), bigQueryNumericScale)
+	if price := message.Get(fields.ByName("price")).Bytes(); !bytes.Equal(price, expectedPrice) {
+		t.Errorf("expected price %x, got %x", expectedPrice, price)
+	}
+	for _, columnName := range []protoreflect.Name{"missing", "deleted"} {
+		if message.Has(fields.ByName(columnName)) {
+			t.Errorf("expected %s to be NULL", columnName)
+		}
+	}
+}
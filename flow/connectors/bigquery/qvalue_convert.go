@@ -65,7 +65,7 @@ func BigQueryTypeToQValueKind(fieldType bigquery.FieldType) (qvalue.QValueKind,
 		return qvalue.QValueKindFloat64, nil
 	case bigquery.BooleanFieldType:
 		return qvalue.QValueKindBoolean, nil
-	case bigquery.TimestampFieldType:
+	case bigquery.TimestampFieldType, bigquery.DateTimeFieldType:
 		return qvalue.QValueKindTimestamp, nil
 	case bigquery.DateFieldType:
 		return qvalue.QValueKindDate, nil
@@ -73,10 +73,12 @@ func BigQueryTypeToQValueKind(fieldType bigquery.FieldType) (qvalue.QValueKind,
 		return qvalue.QValueKindTime, nil
 	case bigquery.RecordFieldType:
 		return qvalue.QValueKindStruct, nil
-	case bigquery.NumericFieldType:
+	case bigquery.NumericFieldType, bigquery.BigNumericFieldType:
 		return qvalue.QValueKindNumeric, nil
 	case bigquery.GeographyFieldType:
 		return qvalue.QValueKindString, nil
+	case bigquery.JSONFieldType:
+		return qvalue.QValueKindJSON, nil
 	default:
 		return "", fmt.Errorf("unsupported bigquery field type: %v", fieldType)
 	}
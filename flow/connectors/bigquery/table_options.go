@@ -0,0 +1,185 @@
+package connbigquery
+
+import (
+	"fmt"
+	"strings"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+)
+
+// maxClusteringColumns is the most clustering columns BigQuery allows on a table.
+const maxClusteringColumns = 4
+
+// applyTableOptions sets the partitioning and clustering of a normalized table to create.
+func applyTableOptions(
+	tableIdentifier string,
+	metadata *bigquery.TableMetadata,
+	options *protos.BigQueryTableOptions,
+) error {
+	columnTypes := make(map[string]bigquery.FieldType, len(metadata.Schema))
+	for _, field := range metadata.Schema {
+		columnTypes[field.Name] = field.Type
+	}
+
+	if options.PartitionColumn != "" {
+		switch columnTypes[options.PartitionColumn] {
+		case bigquery.TimestampFieldType, bigquery.DateFieldType:
+			granularity := bigquery.DayPartitioningType
+			if options.PartitionGranularity != "" {
+				granularity = bigquery.TimePartitioningType(strings.ToUpper(options.PartitionGranularity))
+			}
+			switch granularity {
+			case bigquery.HourPartitioningType, bigquery.DayPartitioningType, bigquery.MonthPartitioningType,
+				bigquery.YearPartitioningType:
+			default:
+				return fmt.Errorf("invalid partition granularity %s for table %s, expected HOUR, DAY, MONTH or YEAR",
+					options.PartitionGranularity, tableIdentifier)
+			}
+			metadata.TimePartitioning = &bigquery.TimePartitioning{
+				Type:  granularity,
+				Field: options.PartitionColumn,
+			}
+		case bigquery.IntegerFieldType:
+			if options.RangeInterval <= 0 || options.RangeEnd <= options.RangeStart {
+				return fmt.Errorf("integer partitioning of table %s needs range_start < range_end "+
+					"and range_interval > 0", tableIdentifier)
+			}
+			metadata.RangePartitioning = &bigquery.RangePartitioning{
+				Field: options.PartitionColumn,
+				Range: &bigquery.RangePartitioningRange{
+					Start:    options.RangeStart,
+					End:      options.RangeEnd,
+					Interval: options.RangeInterval,
+				},
+			}
+		case "":
+			return fmt.Errorf("partition column %s is not a column of table %s",
+				options.PartitionColumn, tableIdentifier)
+		default:
+			return fmt.Errorf("partition column %s of table %s must be a TIMESTAMP, DATE or integer column",
+				options.PartitionColumn, tableIdentifier)
+		}
+	}
+
+	if len(options.ClusterBy) > maxClusteringColumns {
+		return fmt.Errorf("table %s has %d clustering columns, at most %d are allowed",
+			tableIdentifier, len(options.ClusterBy), maxClusteringColumns)
+	}
+	for _, columnName := range options.ClusterBy {
+		if _, ok := columnTypes[columnName]; !ok {
+			return fmt.Errorf("clustering column %s is not a column of table %s", columnName, tableIdentifier)
+		}
+	}
+	if len(options.ClusterBy) > 0 {
+		metadata.Clustering = &bigquery.Clustering{Fields: options.ClusterBy}
+	}
+	return nil
+}
+
+// reconcileTableOptions updates the clustering of an existing normalized table to its options,
+// the partitioning of a table can't be changed once it is created.
+func (c *BigQueryConnector) reconcileTableOptions(
+	table *bigquery.Table,
+	existing *bigquery.TableMetadata,
+	options *protos.BigQueryTableOptions,
+) error {
+	wanted := &bigquery.TableMetadata{Schema: existing.Schema}
+	err := applyTableOptions(table.TableID, wanted, options)
+	if err != nil {
+		return err
+	}
+
+	partitionColumn := ""
+	if existing.TimePartitioning != nil {
+		partitionColumn = existing.TimePartitioning.Field
+	} else if existing.RangePartitioning != nil {
+		partitionColumn = existing.RangePartitioning.Field
+	}
+	if partitionColumn != options.PartitionColumn {
+		log.WithFields(log.Fields{
+			"table": table.TableID,
+		}).Warnf("table is partitioned on %q but its options partition it on %q, recreate the table to change it",
+			partitionColumn, options.PartitionColumn)
+	}
+
+	var existingClustering []string
+	if existing.Clustering != nil {
+		existingClustering = existing.Clustering.Fields
+	}
+	if slices.Equal(existingClustering, options.ClusterBy) {
+		return nil
+	}
+	// an empty clustering removes the clustering of the table.
+	clustering := &bigquery.Clustering{Fields: options.ClusterBy}
+	_, err = table.Update(c.ctx, bigquery.TableMetadataToUpdate{Clustering: clustering}, existing.ETag)
+	if err != nil {
+		return fmt.Errorf("failed to update clustering of table %s: %w", table.TableID, err)
+	}
+	return nil
+}
+
+// generatePartitionBoundsStmts returns the statements that compute the range of the partition column
+// in a batch, so that the MERGE only scans the partitions of the table it touches. The range covers the
+// old values of updates and deletes as well, from _peerdb_match_data, since the rows they change are
+// still in the partitions of their old values, and every change of the batch is read rather than the
+// latest change of each row. Old values are only there when the partition column is in the replica
+// identity of the table, the table is fully scanned for a batch with updates or deletes that lack them.
+func (m *MergeStmtGenerator) generatePartitionBoundsStmts() []string {
+	columnType := qValueKindToBigQueryType(m.NormalizedTableSchema.Columns[m.PartitionColumn])
+	if columnType == bigquery.IntegerFieldType {
+		columnType = "INT64"
+	}
+	newValue := fmt.Sprintf("CAST(JSON_EXTRACT_SCALAR(_peerdb_data, '$.%s') AS %s)", m.PartitionColumn, columnType)
+	oldValue := fmt.Sprintf("CAST(JSON_EXTRACT_SCALAR(_peerdb_match_data, '$.%s') AS %s)",
+		m.PartitionColumn, columnType)
+	// JSON_EXTRACT is NULL for a missing value, and the JSON null literal for a NULL value.
+	oldValueMissing := fmt.Sprintf("JSON_EXTRACT(_peerdb_match_data, '$.%s') IS NULL", m.PartitionColumn)
+
+	return []string{
+		fmt.Sprintf("DECLARE _peerdb_partition_min, _peerdb_partition_max %s;", columnType),
+		"DECLARE _peerdb_partition_prune BOOL;",
+		fmt.Sprintf(`SET (_peerdb_partition_min, _peerdb_partition_max, _peerdb_partition_prune) = (
+		SELECT AS STRUCT
+			LEAST(COALESCE(MIN(_peerdb_new), MIN(_peerdb_old)), COALESCE(MIN(_peerdb_old), MIN(_peerdb_new))),
+			GREATEST(COALESCE(MAX(_peerdb_new), MAX(_peerdb_old)), COALESCE(MAX(_peerdb_old), MAX(_peerdb_new))),
+			COALESCE(NOT LOGICAL_OR(_peerdb_old_missing), TRUE)
+		FROM (
+			SELECT %s AS _peerdb_new, %s AS _peerdb_old,
+				_peerdb_record_type != 0 AND %s AS _peerdb_old_missing
+			FROM %s.%s WHERE _peerdb_batch_id > %d AND _peerdb_batch_id <= %d AND
+			_peerdb_destination_table_name = '%s'
+		));`, newValue, oldValue, oldValueMissing, m.Dataset, m.RawTable, m.NormalizeBatchID, m.SyncBatchID,
+			m.NormalizedTable),
+	}
+}
+
+// partitionPruned returns whether the MERGE prunes the partitions of the table to merge into,
+// which needs the partition column to be a column of the source table.
+func (m *MergeStmtGenerator) partitionPruned() bool {
+	if m.PartitionColumn == "" {
+		return false
+	}
+	_, ok := m.NormalizedTableSchema.Columns[m.PartitionColumn]
+	return ok
+}
+
+// generatePartitionPredicate returns the condition on the partition column of the table to merge into,
+// under the given alias, that limits the MERGE to the partitions of the batch.
+func (m *MergeStmtGenerator) generatePartitionPredicate(alias string) string {
+	column := fmt.Sprintf("%s.`%s`", alias, m.PartitionColumn)
+	return fmt.Sprintf("(NOT _peerdb_partition_prune OR %s IS NULL OR "+
+		"%s BETWEEN _peerdb_partition_min AND _peerdb_partition_max)", column, column)
+}
+
+// wrapPartitionPruning runs a MERGE in a block with the partition bounds of the batch it merges.
+func (m *MergeStmtGenerator) wrapPartitionPruning(mergeStmt string) []string {
+	if !m.partitionPruned() {
+		return []string{mergeStmt}
+	}
+	stmts := []string{"BEGIN"}
+	stmts = append(stmts, m.generatePartitionBoundsStmts()...)
+	return append(stmts, mergeStmt, "END;")
+}
@@ -0,0 +1,60 @@
+package connbigquery
+
+import (
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+func TestApplyTableOptions(t *testing.T) {
+	schema := bigquery.Schema{
+		{Name: "id", Type: bigquery.IntegerFieldType},
+		{Name: "created_at", Type: bigquery.TimestampFieldType},
+		{Name: "name", Type: bigquery.StringFieldType},
+	}
+
+	metadata := &bigquery.TableMetadata{Schema: schema}
+	err := applyTableOptions("orders", metadata, &protos.BigQueryTableOptions{
+		PartitionColumn:      "created_at",
+		PartitionGranularity: "month",
+		ClusterBy:            []string{"name", "id"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metadata.TimePartitioning == nil || metadata.TimePartitioning.Type != bigquery.MonthPartitioningType ||
+		metadata.TimePartitioning.Field != "created_at" {
+		t.Errorf("unexpected time partitioning %+v", metadata.TimePartitioning)
+	}
+	if metadata.Clustering == nil || len(metadata.Clustering.Fields) != 2 {
+		t.Errorf("unexpected clustering %+v", metadata.Clustering)
+	}
+
+	metadata = &bigquery.TableMetadata{Schema: schema}
+	err = applyTableOptions("orders", metadata, &protos.BigQueryTableOptions{
+		PartitionColumn: "id",
+		RangeStart:      0,
+		RangeEnd:        1000000,
+		RangeInterval:   1000,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metadata.RangePartitioning == nil || metadata.RangePartitioning.Range.Interval != 1000 {
+		t.Errorf("unexpected range partitioning %+v", metadata.RangePartitioning)
+	}
+
+	for _, options := range []*protos.BigQueryTableOptions{
+		{PartitionColumn: "name"},
+		{PartitionColumn: "missing"},
+		{PartitionColumn: "created_at", PartitionGranularity: "WEEK"},
+		{PartitionColumn: "id"},
+		{ClusterBy: []string{"id", "name", "created_at", "id", "name"}},
+		{ClusterBy: []string{"missing"}},
+	} {
+		if err := applyTableOptions("orders", &bigquery.TableMetadata{Schema: schema}, options); err == nil {
+			t.Errorf("expected an error for options %v", options)
+		}
+	}
+}
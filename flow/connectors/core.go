@@ -82,6 +82,26 @@ type Connector interface {
 	SyncFlowCleanup(jobName string) error
 }
 
+// QRepPullStreamConnector is implemented by QRep sources that stream the records of a partition
+// instead of pulling them as a batch.
+type QRepPullStreamConnector interface {
+	PullQRepRecordStream(
+		config *protos.QRepConfig,
+		partition *protos.QRepPartition,
+		stream *model.QRecordStream,
+	) (int, error)
+}
+
+// QRepReconcileConnector is implemented by QRep destinations that can remove the rows
+// that were deleted at the source, by comparing the upsert keys of both tables.
+type QRepReconcileConnector interface {
+	// PullQRepKeys streams the upsert key columns of the rows of the destination table.
+	PullQRepKeys(config *protos.QRepConfig, stream *model.QRecordStream) (int, error)
+
+	// DeleteQRepKeys deletes, or soft deletes, the rows of the destination table with the given keys.
+	DeleteQRepKeys(config *protos.QRepConfig, keys []*model.QRecord) (int64, error)
+}
+
 func GetConnector(ctx context.Context, config *protos.Peer) (Connector, error) {
 	inner := config.Config
 	switch inner.(type) {
@@ -0,0 +1,25 @@
+package conneventhub
+
+import (
+	"fmt"
+
+	"github.com/jackc/pglogrepl"
+)
+
+// eventPosition is the position of an event in the change stream: the LSN of its record and its index
+// among the events of the records with the same LSN. It is the id of the event, so that consumers can
+// drop the events that are sent again after a failure, and what the per hub checkpoints are made of.
+type eventPosition struct {
+	lsn   int64
+	index int64
+}
+
+// String returns the id of the event at the position.
+func (p eventPosition) String() string {
+	return fmt.Sprintf("%s-%d", pglogrepl.LSN(p.lsn), p.index)
+}
+
+// after returns true when the position comes after another one.
+func (p eventPosition) after(other eventPosition) bool {
+	return p.lsn > other.lsn || (p.lsn == other.lsn && p.index > other.index)
+}
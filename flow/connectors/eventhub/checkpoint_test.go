@@ -0,0 +1,18 @@
+package conneventhub
+
+import "testing"
+
+func TestEventPosition(t *testing.T) {
+	position := eventPosition{lsn: 0x16B3748, index: 1}
+	if id := position.String(); id != "0/16B3748-1" {
+		t.Errorf("unexpected event id %s", id)
+	}
+
+	if !position.after(eventPosition{lsn: 0x16B3748, index: 0}) ||
+		!position.after(eventPosition{lsn: 0x16B3747, index: 5}) {
+		t.Errorf("expected %s to come after the earlier positions", position)
+	}
+	if position.after(position) || position.after(eventPosition{lsn: 0x16B3749}) {
+		t.Errorf("expected %s not to come after itself or later positions", position)
+	}
+}
@@ -14,6 +14,8 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/eventhub/armeventhub"
 	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/connectors/utils/envelope"
+	"github.com/PeerDB-io/peer-flow/connectors/utils/schemaregistry"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"github.com/PeerDB-io/peer-flow/model"
 	log "github.com/sirupsen/logrus"
@@ -27,7 +29,12 @@ type EventHubConnector struct {
 	tableSchemas  map[string]*protos.TableSchema
 	creds         *azidentity.DefaultAzureCredential
 	tokenProvider auth.TokenProvider
-	hubs          map[string]*eventhub.Hub
+	// connections to hubs and to their partitions, shared by the goroutines sending to them.
+	hubsLock        sync.Mutex
+	hubs            map[string]*eventhub.Hub
+	hubPartitionIDs map[string][]string
+	encoder         *envelope.Encoder
+	routes          []hubRoute
 }
 
 // NewEventHubConnector creates a new EventHubConnector.
@@ -47,6 +54,23 @@ func NewEventHubConnector(
 		return nil, err
 	}
 
+	routes, err := parseHubRoutes(config.GetHubRoutes())
+	if err != nil {
+		log.Errorf("failed to parse hub routes: %v", err)
+		return nil, err
+	}
+
+	var registry *schemaregistry.Client
+	if config.GetSchemaRegistryUrl() != "" {
+		registry = schemaregistry.NewClient(config.GetSchemaRegistryUrl(),
+			config.GetSchemaRegistryUsername(), config.GetSchemaRegistryPassword())
+	}
+	encoder, err := envelope.NewEncoder(config.GetEnvelope(), config.GetEncoding(), registry)
+	if err != nil {
+		log.Errorf("failed to create event encoder: %v", err)
+		return nil, err
+	}
+
 	pgMetadata, err := NewPostgresMetadataStore(ctx, config.GetMetadataDb())
 	if err != nil {
 		log.Errorf("failed to create postgres metadata store: %v", err)
@@ -54,12 +78,15 @@ func NewEventHubConnector(
 	}
 
 	return &EventHubConnector{
-		ctx:           ctx,
-		config:        config,
-		pgMetadata:    pgMetadata,
-		creds:         defaultAzureCreds,
-		tokenProvider: jwtTokenProvider,
-		hubs:          make(map[string]*eventhub.Hub),
+		ctx:             ctx,
+		config:          config,
+		pgMetadata:      pgMetadata,
+		creds:           defaultAzureCreds,
+		tokenProvider:   jwtTokenProvider,
+		hubs:            make(map[string]*eventhub.Hub),
+		hubPartitionIDs: make(map[string][]string),
+		encoder:         encoder,
+		routes:          routes,
 	}, nil
 }
 
@@ -109,48 +136,95 @@ func (c *EventHubConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 	eventsPerHeartBeat := 1000
 	eventsPerBatch := 100000
 
+	keyColumns := make(map[string][]string)
+	keyColumnsPerTable := func(tableName string) []string {
+		columns, ok := keyColumns[tableName]
+		if !ok {
+			columns = partitionKeyColumns(c.tableSchemas[tableName], c.config.GetPartitionKeyColumn())
+			keyColumns[tableName] = columns
+		}
+		return columns
+	}
+
+	// hubs that got part of the batch before a failure only get the events they are missing.
+	hubCheckpoints, err := c.getHubCheckpoints(req.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+
+	var position eventPosition
+	numSkippedEvents := 0
 	batchPerTopic := make(map[string][]*eventhub.Event)
+	lastPositionPerTopic := make(map[string]eventPosition)
 	for i, record := range batch.Records {
-		json, err := record.GetItems().ToJSON()
-		if err != nil {
-			log.Errorf("failed to convert record to json: %v", err)
-			return nil, err
+		tableName := destinationTableName(record)
+		topicName := hubForTable(c.routes, tableName)
+
+		if record.GetCheckPointID() == position.lsn {
+			position.index++
+		} else {
+			position = eventPosition{lsn: record.GetCheckPointID()}
+		}
+		if checkpoint, ok := hubCheckpoints[topicName]; ok && !position.after(checkpoint) {
+			numSkippedEvents++
+			continue
 		}
 
-		// TODO (kaushik): this is a hack to get the table name.
-		topicName := record.GetTableName()
+		// the schemas of the tables sharing a hub are registered under subjects of their own.
+		payload, err := c.encoder.Encode(c.ctx, record, tableName, c.tableSchemas[tableName], req.FlowJobName)
+		if err != nil {
+			log.Errorf("failed to encode record: %v", err)
+			err = req.SkipFailedRecord(record, fmt.Errorf("failed to encode record: %w", err))
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
 
 		if _, ok := batchPerTopic[topicName]; !ok {
 			batchPerTopic[topicName] = make([]*eventhub.Event, 0)
 		}
 
-		batchPerTopic[topicName] = append(batchPerTopic[topicName], eventhub.NewEventFromString(json))
+		// events with the same partition key are sent in order, see eventsPerPartition.
+		event := eventhub.NewEvent(payload)
+		event.ID = position.String()
+		event.Set(tableProperty, tableName)
+		if key, ok := partitionKey(record.GetItems(), keyColumnsPerTable(tableName)); ok {
+			event.Set(partitionKeyProperty, key)
+		}
+		batchPerTopic[topicName] = append(batchPerTopic[topicName], event)
+		lastPositionPerTopic[topicName] = position
 
 		if i%eventsPerHeartBeat == 0 {
 			activity.RecordHeartbeat(c.ctx, fmt.Sprintf("sent %d records to hub: %s", i, topicName))
 		}
 
 		if (i+1)%eventsPerBatch == 0 {
-			err := c.sendEventBatch(batchPerTopic)
+			err := c.sendEventBatch(req.FlowJobName, batchPerTopic, lastPositionPerTopic)
 			if err != nil {
 				return nil, err
 			}
 
 			batchPerTopic = make(map[string][]*eventhub.Event)
+			lastPositionPerTopic = make(map[string]eventPosition)
 		}
 	}
 
 	// send the remaining events.
 	if len(batchPerTopic) > 0 {
-		err := c.sendEventBatch(batchPerTopic)
+		err := c.sendEventBatch(req.FlowJobName, batchPerTopic, lastPositionPerTopic)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	if numSkippedEvents > 0 {
+		log.Infof("skipped %d events already sent to their hubs", numSkippedEvents)
+	}
+
 	log.Infof("[total] successfully sent %d records to event hub", len(batch.Records))
 
-	err := c.UpdateLastOffset(req.FlowJobName, batch.LastCheckPointID)
+	err = c.UpdateLastOffset(req.FlowJobName, batch.LastCheckPointID)
 	if err != nil {
 		log.Errorf("failed to update last offset: %v", err)
 		return nil, err
@@ -163,7 +237,13 @@ func (c *EventHubConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 	}, nil
 }
 
-func (c *EventHubConnector) sendEventBatch(events map[string][]*eventhub.Event) error {
+// sendEventBatch sends the events of each hub, the checkpoint of a hub is updated to the position
+// of its last event as soon as they are sent, even when sending to the other hubs fails.
+func (c *EventHubConnector) sendEventBatch(
+	jobName string,
+	events map[string][]*eventhub.Event,
+	lastPositions map[string]eventPosition,
+) error {
 	if len(events) == 0 {
 		log.Info("no events to send")
 		return nil
@@ -182,13 +262,13 @@ func (c *EventHubConnector) sendEventBatch(events map[string][]*eventhub.Event)
 		go func(tblName string, eventBatch []*eventhub.Event) {
 			defer wg.Done()
 
-			hub, err := c.getOrCreateHubConnection(tblName)
+			err := c.sendHubEvents(subCtx, tblName, eventBatch)
 			if err != nil {
 				once.Do(func() { firstErr = err })
 				return
 			}
 
-			err = hub.SendBatch(subCtx, eventhub.NewEventBatchIterator(eventBatch...))
+			err = c.updateHubCheckpoint(jobName, tblName, lastPositions[tblName])
 			if err != nil {
 				once.Do(func() { firstErr = err })
 				return
@@ -209,15 +289,83 @@ func (c *EventHubConnector) sendEventBatch(events map[string][]*eventhub.Event)
 	return nil
 }
 
-func (c *EventHubConnector) getOrCreateHubConnection(name string) (*eventhub.Hub, error) {
-	hub, ok := c.hubs[name]
+// sendHubEvents sends the events of a hub as a batch per partition, see eventsPerPartition.
+func (c *EventHubConnector) sendHubEvents(ctx context.Context, name string, events []*eventhub.Event) error {
+	partitionIDs, err := c.getHubPartitionIDs(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	perPartition, unkeyed := eventsPerPartition(events, partitionIDs)
+	for partitionID, partitionEvents := range perPartition {
+		hub, err := c.getOrCreateHubConnection(name, partitionID)
+		if err != nil {
+			return err
+		}
+		err = hub.SendBatch(ctx, eventhub.NewEventBatchIterator(partitionEvents...))
+		if err != nil {
+			return fmt.Errorf("failed to send events to partition %s of hub %s: %w", partitionID, name, err)
+		}
+	}
+
+	if len(unkeyed) > 0 {
+		hub, err := c.getOrCreateHubConnection(name, "")
+		if err != nil {
+			return err
+		}
+		err = hub.SendBatch(ctx, eventhub.NewEventBatchIterator(unkeyed...))
+		if err != nil {
+			return fmt.Errorf("failed to send events to hub %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// getHubPartitionIDs returns the ids of the partitions of a hub, they are read once per connector.
+func (c *EventHubConnector) getHubPartitionIDs(ctx context.Context, name string) ([]string, error) {
+	c.hubsLock.Lock()
+	partitionIDs, ok := c.hubPartitionIDs[name]
+	c.hubsLock.Unlock()
+	if ok {
+		return partitionIDs, nil
+	}
+
+	hub, err := c.getOrCreateHubConnection(name, "")
+	if err != nil {
+		return nil, err
+	}
+	info, err := hub.GetRuntimeInformation(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get partitions of hub %s: %w", name, err)
+	}
+
+	c.hubsLock.Lock()
+	c.hubPartitionIDs[name] = info.PartitionIDs
+	c.hubsLock.Unlock()
+	return info.PartitionIDs, nil
+}
+
+// getOrCreateHubConnection returns the connection to a hub, sending to a partition of the hub
+// unless partitionID is empty.
+func (c *EventHubConnector) getOrCreateHubConnection(name string, partitionID string) (*eventhub.Hub, error) {
+	c.hubsLock.Lock()
+	defer c.hubsLock.Unlock()
+
+	connName := name
+	var opts []eventhub.HubOption
+	if partitionID != "" {
+		connName = name + "/" + partitionID
+		opts = append(opts, eventhub.HubWithPartitionedSender(partitionID))
+	}
+
+	hub, ok := c.hubs[connName]
 	if !ok {
-		hub, err := eventhub.NewHub(c.config.GetNamespace(), name, c.tokenProvider)
+		hub, err := eventhub.NewHub(c.config.GetNamespace(), name, c.tokenProvider, opts...)
 		if err != nil {
 			log.Errorf("failed to create event hub connection: %v", err)
 			return nil, err
 		}
-		c.hubs[name] = hub
+		c.hubs[connName] = hub
 		return hub, nil
 	}
 
@@ -225,19 +373,27 @@ func (c *EventHubConnector) getOrCreateHubConnection(name string) (*eventhub.Hub
 }
 
 func (c *EventHubConnector) CreateRawTable(req *protos.CreateRawTableInput) (*protos.CreateRawTableOutput, error) {
-	// create topics for each table
-	// key is the source table and value is the destination topic name.
+	// create the hubs the tables are routed to.
+	// key is the source table and value is the destination table name.
 	tableMap := req.GetTableNameMapping()
 
+	ensuredHubs := make(map[string]bool)
 	for _, table := range tableMap {
-		err := c.ensureEventHub(c.ctx, table)
+		hubName := hubForTable(c.routes, table)
+		if ensuredHubs[hubName] {
+			continue
+		}
+
+		err := c.ensureEventHub(c.ctx, hubName)
 		if err != nil {
 			log.WithFields(log.Fields{
 				"flowName": req.FlowJobName,
 				"table":    table,
+				"hub":      hubName,
 			}).Errorf("failed to get event hub properties: %v", err)
 			return nil, err
 		}
+		ensuredHubs[hubName] = true
 	}
 
 	return nil, nil
@@ -249,6 +405,12 @@ func (c *EventHubConnector) GetTableSchema(
 }
 
 func (c *EventHubConnector) ensureEventHub(ctx context.Context, name string) error {
+	// the namespace may not grant access to the management API at all, so hubs aren't even looked up.
+	if c.config.GetDisableHubCreation() {
+		log.Infof("hub creation is disabled, event hub %s has to exist", name)
+		return nil
+	}
+
 	hubClient, err := c.getEventHubMgmtClient()
 	if err != nil {
 		return err
@@ -258,9 +420,14 @@ func (c *EventHubConnector) ensureEventHub(ctx context.Context, name string) err
 	resourceGroup := c.config.GetResourceGroup()
 	_, err = hubClient.Get(ctx, resourceGroup, namespace, name, nil)
 
-	// TODO (kaushik): make these configurable.
 	partitionCount := int64(3)
+	if c.config.GetPartitionCount() > 0 {
+		partitionCount = int64(c.config.GetPartitionCount())
+	}
 	retention := int64(1)
+	if c.config.GetMessageRetentionDays() > 0 {
+		retention = int64(c.config.GetMessageRetentionDays())
+	}
 	if err != nil {
 		opts := armeventhub.Eventhub{
 			Properties: &armeventhub.Properties{
@@ -319,7 +486,7 @@ func (c *EventHubConnector) PullFlowCleanup(jobName string) error {
 	panic("pull flow cleanup not implemented for event hub")
 }
 
+// SyncFlowCleanup removes the metadata of the job, the hubs and the events sent to them are kept.
 func (c *EventHubConnector) SyncFlowCleanup(jobName string) error {
-	// TODO (kaushik): this has to be implemented for DROP PEER support.
-	panic("sync flow cleanup not implemented for event hub")
+	return c.deleteJobMetadata(jobName)
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	log "github.com/sirupsen/logrus"
 )
@@ -15,6 +16,8 @@ const (
 	metadataSchema = "peerdb_eventhub_metadata"
 	// The name of the table that stores the last sync state.
 	lastSyncStateTableName = "last_sync_state"
+	// The name of the table that stores the position of the last event sent to each hub.
+	hubCheckpointsTableName = "hub_checkpoints"
 )
 
 type PostgresMetadataStore struct {
@@ -96,6 +99,22 @@ func (c *EventHubConnector) SetupMetadataTables() error {
 		return err
 	}
 
+	// create the hub checkpoints table
+	_, err = tx.Exec(c.ctx, `
+		CREATE TABLE IF NOT EXISTS `+metadataSchema+`.`+hubCheckpointsTableName+` (
+			job_name TEXT NOT NULL,
+			hub_name TEXT NOT NULL,
+			last_offset BIGINT NOT NULL,
+			last_index BIGINT NOT NULL,
+			updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			PRIMARY KEY (job_name, hub_name)
+		)
+	`)
+	if err != nil {
+		log.Errorf("failed to create hub checkpoints table: %v", err)
+		return err
+	}
+
 	// commit the transaction
 	err = tx.Commit(c.ctx)
 	if err != nil {
@@ -143,7 +162,8 @@ func (c *EventHubConnector) GetLastSyncBatchID(jobName string) (int64, error) {
 	return 0, fmt.Errorf("GetLastSyncBatchID not supported for EventHub connector")
 }
 
-// update offset for a job
+// update offset for a job, the checkpoints of its hubs are cleared along with it
+// as they only matter to retries of the batch that is now synced.
 func (c *EventHubConnector) UpdateLastOffset(jobName string, offset int64) error {
 	ms := c.pgMetadata
 
@@ -153,6 +173,14 @@ func (c *EventHubConnector) UpdateLastOffset(jobName string, offset int64) error
 		log.Errorf("failed to start transaction: %v", err)
 		return err
 	}
+	defer func() {
+		deferErr := tx.Rollback(c.ctx)
+		if deferErr != pgx.ErrTxClosed && deferErr != nil {
+			log.WithFields(log.Fields{
+				"flowName": jobName,
+			}).Errorf("unexpected error while rolling back transaction for last offset: %v", deferErr)
+		}
+	}()
 
 	// update the last offset
 	log.Infof("updating last offset for job `%s` to `%d`", jobName, offset)
@@ -170,6 +198,17 @@ func (c *EventHubConnector) UpdateLastOffset(jobName string, offset int64) error
 		return err
 	}
 
+	_, err = tx.Exec(c.ctx, `
+		DELETE FROM `+metadataSchema+`.`+hubCheckpointsTableName+`
+		WHERE job_name = $1
+	`, jobName)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"flowName": jobName,
+		}).Errorf("failed to clear hub checkpoints: %v", err)
+		return err
+	}
+
 	// commit the transaction
 	err = tx.Commit(c.ctx)
 	if err != nil {
@@ -179,3 +218,97 @@ func (c *EventHubConnector) UpdateLastOffset(jobName string, offset int64) error
 
 	return nil
 }
+
+// getHubCheckpoints returns the position of the last event sent to each hub by a job.
+func (c *EventHubConnector) getHubCheckpoints(jobName string) (map[string]eventPosition, error) {
+	ms := c.pgMetadata
+
+	rows, err := ms.pool.Query(c.ctx, `
+		SELECT hub_name, last_offset, last_index
+		FROM `+metadataSchema+`.`+hubCheckpointsTableName+`
+		WHERE job_name = $1
+	`, jobName)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"flowName": jobName,
+		}).Errorf("failed to get hub checkpoints: %v", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	checkpoints := make(map[string]eventPosition)
+	for rows.Next() {
+		var hubName string
+		var position eventPosition
+		if err := rows.Scan(&hubName, &position.lsn, &position.index); err != nil {
+			return nil, fmt.Errorf("failed to scan hub checkpoint: %w", err)
+		}
+		checkpoints[hubName] = position
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read hub checkpoints: %w", err)
+	}
+
+	return checkpoints, nil
+}
+
+// updateHubCheckpoint records the position of the last event sent to a hub by a job.
+func (c *EventHubConnector) updateHubCheckpoint(jobName string, hubName string, position eventPosition) error {
+	ms := c.pgMetadata
+
+	_, err := ms.pool.Exec(c.ctx, `
+		INSERT INTO `+metadataSchema+`.`+hubCheckpointsTableName+` (job_name, hub_name, last_offset, last_index)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (job_name, hub_name)
+		DO UPDATE SET last_offset = $3, last_index = $4, updated_at = NOW()
+	`, jobName, hubName, position.lsn, position.index)
+	if err != nil {
+		log.WithFields(log.Fields{
+			"flowName": jobName,
+			"hub":      hubName,
+		}).Errorf("failed to update hub checkpoint: %v", err)
+		return err
+	}
+
+	return nil
+}
+
+// deleteJobMetadata removes the last sync state and the hub checkpoints of a job.
+func (c *EventHubConnector) deleteJobMetadata(jobName string) error {
+	ms := c.pgMetadata
+
+	tx, err := ms.pool.Begin(c.ctx)
+	if err != nil {
+		return fmt.Errorf("unable to begin transaction for sync flow cleanup: %w", err)
+	}
+	defer func() {
+		deferErr := tx.Rollback(c.ctx)
+		if deferErr != pgx.ErrTxClosed && deferErr != nil {
+			log.WithFields(log.Fields{
+				"flowName": jobName,
+			}).Errorf("unexpected error while rolling back transaction for flow cleanup: %v", deferErr)
+		}
+	}()
+
+	_, err = tx.Exec(c.ctx, `
+		DELETE FROM `+metadataSchema+`.`+hubCheckpointsTableName+`
+		WHERE job_name = $1
+	`, jobName)
+	if err != nil {
+		return fmt.Errorf("unable to delete hub checkpoints: %w", err)
+	}
+
+	_, err = tx.Exec(c.ctx, `
+		DELETE FROM `+metadataSchema+`.`+lastSyncStateTableName+`
+		WHERE job_name = $1
+	`, jobName)
+	if err != nil {
+		return fmt.Errorf("unable to delete last sync state: %w", err)
+	}
+
+	err = tx.Commit(c.ctx)
+	if err != nil {
+		return fmt.Errorf("unable to commit transaction for sync flow cleanup: %w", err)
+	}
+	return nil
+}
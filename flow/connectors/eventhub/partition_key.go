@@ -0,0 +1,93 @@
+package conneventhub
+
+import (
+	"encoding/hex"
+	"fmt"
+	"hash/fnv"
+	"math/big"
+	"strings"
+	"time"
+
+	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+)
+
+// partitionKeyColumns returns the columns whose values make up the partition key of the events of a table,
+// the configured partition key column when the table has it, otherwise the primary key of the table.
+func partitionKeyColumns(schema *protos.TableSchema, partitionKeyColumn string) []string {
+	if schema == nil {
+		return nil
+	}
+
+	if partitionKeyColumn != "" {
+		if _, ok := schema.Columns[partitionKeyColumn]; ok {
+			return []string{partitionKeyColumn}
+		}
+	}
+
+	if schema.PrimaryKeyColumn == "" {
+		return nil
+	}
+	// fan-in tables are keyed on the source table along with the primary key.
+	if schema.SourceIdentifierColumn != "" {
+		return []string{schema.SourceIdentifierColumn, schema.PrimaryKeyColumn}
+	}
+	return []string{schema.PrimaryKeyColumn}
+}
+
+// partitionKeyProperty is the property of events that has their partition key, see eventsPerPartition.
+const partitionKeyProperty = "peerdb_partition_key"
+
+// partitionKey returns the partition key of the event of a record, from the values of the key columns.
+// Events with the same partition key are delivered to the same partition, in the order they were sent.
+// It returns false when the record doesn't have a value for every key column.
+func partitionKey(items model.RecordItems, columns []string) (string, bool) {
+	if len(columns) == 0 {
+		return "", false
+	}
+
+	parts := make([]string, 0, len(columns))
+	for _, column := range columns {
+		value, ok := items[column]
+		if !ok || value.Value == nil {
+			return "", false
+		}
+
+		switch v := value.Value.(type) {
+		case time.Time:
+			parts = append(parts, v.UTC().Format(time.RFC3339Nano))
+		case *big.Rat:
+			parts = append(parts, v.RatString())
+		case []byte:
+			parts = append(parts, hex.EncodeToString(v))
+		default:
+			parts = append(parts, fmt.Sprint(v))
+		}
+	}
+	return strings.Join(parts, ":"), true
+}
+
+// eventsPerPartition assigns the events with a partition key to the partitions of their hub by the hash of the key,
+// so that a batch is sent per partition rather than per key, and events with the same key keep their order.
+// Events without a partition key are returned apart, they can go to any partition.
+func eventsPerPartition(
+	events []*eventhub.Event,
+	partitionIDs []string,
+) (map[string][]*eventhub.Event, []*eventhub.Event) {
+	perPartition := make(map[string][]*eventhub.Event)
+	unkeyed := make([]*eventhub.Event, 0)
+	for _, event := range events {
+		key, ok := event.Properties[partitionKeyProperty].(string)
+		if !ok || len(partitionIDs) == 0 {
+			unkeyed = append(unkeyed, event)
+			continue
+		}
+
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(key))
+		partitionID := partitionIDs[h.Sum32()%uint32(len(partitionIDs))]
+		perPartition[partitionID] = append(perPartition[partitionID], event)
+	}
+	return perPartition, unkeyed
+}
@@ -0,0 +1,102 @@
+package conneventhub
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	eventhub "github.com/Azure/azure-event-hubs-go/v3"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+)
+
+func TestPartitionKeyColumns(t *testing.T) {
+	schema := &protos.TableSchema{
+		Columns:          map[string]string{"id": "int", "tenant_id": "int"},
+		PrimaryKeyColumn: "id",
+	}
+
+	if columns := partitionKeyColumns(schema, ""); len(columns) != 1 || columns[0] != "id" {
+		t.Errorf("expected the primary key, got %v", columns)
+	}
+	if columns := partitionKeyColumns(schema, "tenant_id"); len(columns) != 1 || columns[0] != "tenant_id" {
+		t.Errorf("expected the configured column, got %v", columns)
+	}
+	// tables without the configured column fall back to the primary key
+	if columns := partitionKeyColumns(schema, "missing"); len(columns) != 1 || columns[0] != "id" {
+		t.Errorf("expected the primary key, got %v", columns)
+	}
+	if columns := partitionKeyColumns(nil, "tenant_id"); columns != nil {
+		t.Errorf("expected no columns for a table without a schema, got %v", columns)
+	}
+}
+
+func TestPartitionKey(t *testing.T) {
+	items := model.RecordItems{
+		"id":      qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(42)},
+		"amount":  qvalue.QValue{Kind: qvalue.QValueKindNumeric, Value: big.NewRat(5, 2)},
+		"deleted": qvalue.QValue{Kind: qvalue.QValueKindString, Value: nil},
+	}
+
+	key, ok := partitionKey(items, []string{"id", "amount"})
+	if !ok || key != "42:5/2" {
+		t.Errorf("unexpected partition key %q", key)
+	}
+	if _, ok := partitionKey(items, []string{"deleted"}); ok {
+		t.Errorf("expected no partition key for a null value")
+	}
+	if _, ok := partitionKey(items, nil); ok {
+		t.Errorf("expected no partition key without key columns")
+	}
+}
+
+func TestEventsPerPartition(t *testing.T) {
+	partitionIDs := []string{"0", "1", "2", "3"}
+	events := make([]*eventhub.Event, 0)
+	for i := 0; i < 100; i++ {
+		event := eventhub.NewEventFromString(fmt.Sprint(i))
+		if i%10 != 0 {
+			event.Set(partitionKeyProperty, fmt.Sprintf("key-%d", i%7))
+		}
+		events = append(events, event)
+	}
+
+	perPartition, unkeyed := eventsPerPartition(events, partitionIDs)
+	if len(unkeyed) != 10 {
+		t.Errorf("expected 10 events without a key, got %d", len(unkeyed))
+	}
+	if len(perPartition) > len(partitionIDs) {
+		t.Errorf("expected at most a batch per partition, got %d", len(perPartition))
+	}
+
+	// the events of a key all go to the same partition, in the order they were sent.
+	partitionOfKey := make(map[string]string)
+	lastEventOfKey := make(map[string]int)
+	numEvents := 0
+	for partitionID, partitionEvents := range perPartition {
+		for _, event := range partitionEvents {
+			key := event.Properties[partitionKeyProperty].(string)
+			if previous, ok := partitionOfKey[key]; ok && previous != partitionID {
+				t.Errorf("events of key %s went to partitions %s and %s", key, previous, partitionID)
+			}
+			partitionOfKey[key] = partitionID
+
+			var i int
+			fmt.Sscan(string(event.Data), &i)
+			if last, ok := lastEventOfKey[key]; ok && last > i {
+				t.Errorf("event %d of key %s sent after event %d", i, key, last)
+			}
+			lastEventOfKey[key] = i
+			numEvents++
+		}
+	}
+	if numEvents != 90 {
+		t.Errorf("expected 90 events with a key, got %d", numEvents)
+	}
+
+	_, unkeyed = eventsPerPartition(events, nil)
+	if len(unkeyed) != len(events) {
+		t.Errorf("expected all events to be sent without a partition when the partitions are unknown")
+	}
+}
@@ -0,0 +1,65 @@
+package conneventhub
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/PeerDB-io/peer-flow/model"
+)
+
+// tableProperty is the property of events that has the name of their table,
+// so that the tables sharing a hub can be told apart.
+const tableProperty = "peerdb_table"
+
+// hubRoute sends the tables whose name matches a pattern to a hub.
+type hubRoute struct {
+	pattern string
+	hub     string
+}
+
+// parseHubRoutes parses comma separated routes like "public.orders_*=orders,public.users=users",
+// the patterns are shell patterns as understood by path.Match.
+func parseHubRoutes(spec string) ([]hubRoute, error) {
+	var routes []hubRoute
+	for _, rule := range strings.Split(spec, ",") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		pattern, hub, found := strings.Cut(rule, "=")
+		pattern, hub = strings.TrimSpace(pattern), strings.TrimSpace(hub)
+		if !found || pattern == "" || hub == "" {
+			return nil, fmt.Errorf("invalid hub route %s, expected <table pattern>=<hub>", rule)
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid table pattern in hub route %s: %w", rule, err)
+		}
+		routes = append(routes, hubRoute{pattern: pattern, hub: hub})
+	}
+	return routes, nil
+}
+
+// hubForTable returns the hub of the first route matching a table, or the table name when no route matches.
+func hubForTable(routes []hubRoute, tableName string) string {
+	for _, route := range routes {
+		if matched, _ := path.Match(route.pattern, tableName); matched {
+			return route.hub
+		}
+	}
+	return tableName
+}
+
+// destinationTableName returns the name of the table a record is replicated to.
+func destinationTableName(record model.Record) string {
+	switch r := record.(type) {
+	case *model.InsertRecord:
+		return r.DestinationTableName
+	case *model.UpdateRecord:
+		return r.DestinationTableName
+	case *model.DeleteRecord:
+		return r.DestinationTableName
+	default:
+		return record.GetTableName()
+	}
+}
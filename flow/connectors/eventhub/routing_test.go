@@ -0,0 +1,38 @@
+package conneventhub
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/model"
+)
+
+func TestHubRoutes(t *testing.T) {
+	routes, err := parseHubRoutes("public.orders_*=orders, public.users=users,*.audit=audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for table, expected := range map[string]string{
+		"public.orders_2023": "orders",
+		"public.users":       "users",
+		"billing.audit":      "audit",
+		"public.accounts":    "public.accounts",
+	} {
+		if hub := hubForTable(routes, table); hub != expected {
+			t.Errorf("expected table %s to be sent to hub %s, got %s", table, expected, hub)
+		}
+	}
+
+	for _, spec := range []string{"public.users", "=users", "public.[users=users"} {
+		if _, err := parseHubRoutes(spec); err == nil {
+			t.Errorf("expected an error for hub routes %q", spec)
+		}
+	}
+}
+
+func TestDestinationTableName(t *testing.T) {
+	record := &model.DeleteRecord{SourceTableName: "public.users", DestinationTableName: "users"}
+	if name := destinationTableName(record); name != "users" {
+		t.Errorf("expected the destination table of the delete, got %s", name)
+	}
+}
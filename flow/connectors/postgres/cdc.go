@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"github.com/PeerDB-io/peer-flow/model"
 	"github.com/PeerDB-io/peer-flow/model/qvalue"
 	"github.com/jackc/pglogrepl"
@@ -27,6 +28,10 @@ type PostgresCDCSource struct {
 	relations             map[uint32]*pglogrepl.RelationMessage
 	typeMap               *pgtype.Map
 	startLSN              pglogrepl.LSN
+	committedLSN          pglogrepl.LSN
+	// commit time and id of the transaction currently being decoded
+	commitTime    time.Time
+	transactionID uint32
 }
 
 type PostgresCDCConfig struct {
@@ -36,6 +41,8 @@ type PostgresCDCConfig struct {
 	Publication           string
 	SrcTableIDNameMapping map[uint32]string
 	TableNameMapping      map[string]string
+	// CommittedLSN is the position synced to every destination, the slot is never acked past it.
+	CommittedLSN pglogrepl.LSN
 }
 
 // Create a new PostgresCDCSource
@@ -49,6 +56,7 @@ func NewPostgresCDCSource(cdcConfig *PostgresCDCConfig) (*PostgresCDCSource, err
 		publication:           cdcConfig.Publication,
 		relations:             make(map[uint32]*pglogrepl.RelationMessage),
 		typeMap:               pgtype.NewMap(),
+		committedLSN:          cdcConfig.CommittedLSN,
 	}, nil
 }
 
@@ -132,7 +140,11 @@ func (p *PostgresCDCSource) consumeStream(
 	for {
 		if time.Now().After(nextStandbyMessageDeadline) {
 			err := pglogrepl.SendStandbyStatusUpdate(p.ctx, conn,
-				pglogrepl.StandbyStatusUpdate{WALWritePosition: clientXLogPos})
+				pglogrepl.StandbyStatusUpdate{
+					WALWritePosition: clientXLogPos,
+					WALFlushPosition: p.committedLSN,
+					WALApplyPosition: p.committedLSN,
+				})
 			if err != nil {
 				return nil, fmt.Errorf("SendStandbyStatusUpdate failed: %w", err)
 			}
@@ -197,6 +209,7 @@ func (p *PostgresCDCSource) consumeStream(
 			}
 			if rec != nil {
 				tableName := rec.GetTableName()
+				addSourceIdentifier(rec, req.TableNameSchemaMapping)
 				switch r := rec.(type) {
 				case *model.UpdateRecord:
 					// tableName here is destination tableName.
@@ -205,7 +218,7 @@ func (p *PostgresCDCSource) consumeStream(
 					pkeyCol := req.TableNameSchemaMapping[tableName].PrimaryKeyColumn
 					pkeyColVal := rec.GetItems()[pkeyCol]
 					tablePkeyVal := model.TableWithPkey{
-						TableName:  tableName,
+						TableName:  r.SourceTableName,
 						PkeyColVal: pkeyColVal,
 					}
 					_, ok := result.TablePKeyLastSeen[tablePkeyVal]
@@ -228,7 +241,7 @@ func (p *PostgresCDCSource) consumeStream(
 					pkeyCol := req.TableNameSchemaMapping[tableName].PrimaryKeyColumn
 					pkeyColVal := rec.GetItems()[pkeyCol]
 					tablePkeyVal := model.TableWithPkey{
-						TableName:  tableName,
+						TableName:  r.SourceTableName,
 						PkeyColVal: pkeyColVal,
 					}
 					result.Records = append(result.Records, rec)
@@ -257,7 +270,9 @@ func (p *PostgresCDCSource) processMessage(batch *model.RecordBatch, xld pglogre
 
 	switch msg := logicalMsg.(type) {
 	case *pglogrepl.BeginMessage:
-		log.Debugf("Ignoring BeginMessage")
+		// records carry the commit time and id of their transaction
+		p.commitTime = msg.CommitTime
+		p.transactionID = msg.Xid
 	case *pglogrepl.InsertMessage:
 		return p.processInsertMessage(xld.WALStart, msg)
 	case *pglogrepl.UpdateMessage:
@@ -309,6 +324,8 @@ func (p *PostgresCDCSource) processInsertMessage(
 
 	return &model.InsertRecord{
 		CheckPointID:          int64(lsn),
+		CommitTime:            p.commitTime,
+		TransactionID:         p.transactionID,
 		Items:                 items,
 		DestinationTableName:  p.TableNameMapping[tableName],
 		SourceTableName:       tableName,
@@ -347,6 +364,8 @@ func (p *PostgresCDCSource) processUpdateMessage(
 
 	return &model.UpdateRecord{
 		CheckPointID:          int64(lsn),
+		CommitTime:            p.commitTime,
+		TransactionID:         p.transactionID,
 		OldItems:              oldItems,
 		NewItems:              newItems,
 		DestinationTableName:  p.TableNameMapping[tableName],
@@ -381,6 +400,8 @@ func (p *PostgresCDCSource) processDeleteMessage(
 
 	return &model.DeleteRecord{
 		CheckPointID:          int64(lsn),
+		CommitTime:            p.commitTime,
+		TransactionID:         p.transactionID,
 		Items:                 items,
 		DestinationTableName:  p.TableNameMapping[tableName],
 		SourceTableName:       tableName,
@@ -388,6 +409,30 @@ func (p *PostgresCDCSource) processDeleteMessage(
 	}, nil
 }
 
+// addSourceIdentifier tags a record of a fan-in table with its source table, the source identifier
+// column is part of the primary key of the destination table so it is needed for matching too.
+// The schema mapping is keyed by destination table, which GetTableName doesn't return for deletes.
+func addSourceIdentifier(rec model.Record, tableNameSchemaMapping map[string]*protos.TableSchema) {
+	switch r := rec.(type) {
+	case *model.InsertRecord:
+		sourceIdentifierColumn := tableNameSchemaMapping[r.DestinationTableName].GetSourceIdentifierColumn()
+		if sourceIdentifierColumn != "" {
+			r.Items[sourceIdentifierColumn] = qvalue.QValue{Kind: qvalue.QValueKindString, Value: r.SourceTableName}
+		}
+	case *model.UpdateRecord:
+		sourceIdentifierColumn := tableNameSchemaMapping[r.DestinationTableName].GetSourceIdentifierColumn()
+		if sourceIdentifierColumn != "" {
+			r.NewItems[sourceIdentifierColumn] = qvalue.QValue{Kind: qvalue.QValueKindString, Value: r.SourceTableName}
+			r.OldItems[sourceIdentifierColumn] = qvalue.QValue{Kind: qvalue.QValueKindString, Value: r.SourceTableName}
+		}
+	case *model.DeleteRecord:
+		sourceIdentifierColumn := tableNameSchemaMapping[r.DestinationTableName].GetSourceIdentifierColumn()
+		if sourceIdentifierColumn != "" {
+			r.Items[sourceIdentifierColumn] = qvalue.QValue{Kind: qvalue.QValueKindString, Value: r.SourceTableName}
+		}
+	}
+}
+
 /*
 convertTupleToMap converts a PostgreSQL logical replication
 tuple to a map representation.
@@ -8,10 +8,13 @@ import (
 
 	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
 	"github.com/jackc/pglogrepl"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
 //nolint:stylecheck
@@ -25,7 +28,15 @@ const (
 	createRawTableSQL       = `CREATE TABLE IF NOT EXISTS %s.%s(_peerdb_uid TEXT NOT NULL,
 		_peerdb_timestamp BIGINT NOT NULL,_peerdb_destination_table_name TEXT NOT NULL,_peerdb_data JSONB NOT NULL,
 		_peerdb_record_type INTEGER NOT NULL, _peerdb_match_data JSONB,_peerdb_batch_id INTEGER,
-		_peerdb_unchanged_toast_columns TEXT)`
+		_peerdb_unchanged_toast_columns TEXT,_peerdb_source_lsn BIGINT,_peerdb_commit_ts BIGINT)`
+	// raw tables of mirrors created before the source LSN and commit timestamp were recorded lack these columns.
+	countRawTableSystemColumnsSQL = `SELECT COUNT(*) FROM information_schema.columns
+		WHERE table_schema=$1 AND table_name=$2 AND column_name IN ('_peerdb_source_lsn','_peerdb_commit_ts')`
+	addRawTableSystemColumnsSQL = `ALTER TABLE %s.%s ADD COLUMN IF NOT EXISTS _peerdb_source_lsn BIGINT,
+		ADD COLUMN IF NOT EXISTS _peerdb_commit_ts BIGINT`
+	// direct apply stages a batch in a temporary copy of the raw table that is dropped on commit.
+	applyTableIdentifier = "_peerdb_apply"
+	createApplyTableSQL  = "CREATE TEMP TABLE %s(LIKE %s.%s) ON COMMIT DROP"
 
 	getLastOffsetSQL            = "SELECT lsn_offset FROM %s.%s WHERE mirror_job_name=$1"
 	getLastSyncBatchID_SQL      = "SELECT sync_batch_id FROM %s.%s WHERE mirror_job_name=$1"
@@ -42,36 +53,77 @@ const (
 	_peerdb_batch_id>$1 AND _peerdb_batch_id<=$2 GROUP BY _peerdb_destination_table_name`
 	srcTableName      = "src"
 	mergeStatementSQL = `WITH src_rank AS (
-		SELECT _peerdb_data,_peerdb_record_type,_peerdb_unchanged_toast_columns,
+		SELECT _peerdb_data,_peerdb_record_type,_peerdb_unchanged_toast_columns,_peerdb_source_lsn,_peerdb_commit_ts,
 		RANK() OVER (PARTITION BY %s ORDER BY _peerdb_timestamp DESC) AS _peerdb_rank
-		FROM %s.%s WHERE _peerdb_batch_id>$1 AND _peerdb_batch_id<=$2 AND _peerdb_destination_table_name=$3
+		FROM %s WHERE _peerdb_batch_id>$1 AND _peerdb_batch_id<=$2 AND _peerdb_destination_table_name=$3
 	)
 	MERGE INTO %s dst
-	USING (SELECT %s,_peerdb_record_type,_peerdb_unchanged_toast_columns FROM src_rank WHERE _peerdb_rank=1) src
-	ON dst.%s=src.%s
+	USING (SELECT %s,_peerdb_record_type,_peerdb_unchanged_toast_columns,_peerdb_source_lsn,_peerdb_commit_ts
+		FROM src_rank WHERE _peerdb_rank=1) src
+	ON %s
 	WHEN NOT MATCHED AND src._peerdb_record_type!=2 THEN
 	INSERT (%s) VALUES (%s)
 	%s
 	WHEN MATCHED AND src._peerdb_record_type=2 THEN
-	DELETE`
+	%s`
 	fallbackUpsertStatementSQL = `WITH src_rank AS (
-		SELECT _peerdb_data,_peerdb_record_type,_peerdb_unchanged_toast_columns,
+		SELECT _peerdb_data,_peerdb_record_type,_peerdb_unchanged_toast_columns,_peerdb_source_lsn,_peerdb_commit_ts,
 		RANK() OVER (PARTITION BY %s ORDER BY _peerdb_timestamp DESC) AS _peerdb_rank
-		FROM %s.%s WHERE _peerdb_batch_id>$1 AND _peerdb_batch_id<=$2 AND _peerdb_destination_table_name=$3
+		FROM %s WHERE _peerdb_batch_id>$1 AND _peerdb_batch_id<=$2 AND _peerdb_destination_table_name=$3
 	)
 	INSERT INTO %s (%s) SELECT %s FROM src_rank WHERE _peerdb_rank=1 AND _peerdb_record_type!=2
 	ON CONFLICT (%s) DO UPDATE SET %s`
 	fallbackDeleteStatementSQL = `WITH src_rank AS (
 		SELECT _peerdb_data,_peerdb_record_type,_peerdb_unchanged_toast_columns,
 		RANK() OVER (PARTITION BY %s ORDER BY _peerdb_timestamp DESC) AS _peerdb_rank
-		FROM %s.%s WHERE _peerdb_batch_id>$1 AND _peerdb_batch_id<=$2 AND _peerdb_destination_table_name=$3
+		FROM %s WHERE _peerdb_batch_id>$1 AND _peerdb_batch_id<=$2 AND _peerdb_destination_table_name=$3
+	)
+	DELETE FROM %s USING src_rank WHERE %s AND src_rank._peerdb_rank=1 AND src_rank._peerdb_record_type=2`
+	fallbackSoftDeleteStatementSQL = `WITH src_rank AS (
+		SELECT _peerdb_data,_peerdb_record_type,_peerdb_unchanged_toast_columns,_peerdb_source_lsn,_peerdb_commit_ts,
+		RANK() OVER (PARTITION BY %s ORDER BY _peerdb_timestamp DESC) AS _peerdb_rank
+		FROM %s WHERE _peerdb_batch_id>$1 AND _peerdb_batch_id<=$2 AND _peerdb_destination_table_name=$3
 	)
-	DELETE FROM %s USING src_rank WHERE %s.%s=%s AND src_rank._peerdb_rank=1 AND src_rank._peerdb_record_type=2`
+	UPDATE %s SET %s FROM src_rank WHERE %s AND src_rank._peerdb_rank=1 AND src_rank._peerdb_record_type=2`
+	// every change in the batch becomes a version, the first change of a row closes its current version.
+	// data-modifying CTEs see the same snapshot, so closed holds the versions that were current before.
+	// an unchanged TOAST column takes the value of the last version in the batch that changed it, the
+	// versions since that change share a toast group; group 0 means no version did and closed has it.
+	historyStatementSQL = `WITH src_versions AS (
+		SELECT %s,_peerdb_record_type,_peerdb_unchanged_toast_columns,_peerdb_source_lsn,_peerdb_commit_ts,
+		_peerdb_timestamp,to_timestamp(_peerdb_commit_ts/1000000.0) AS _peerdb_valid_from,
+		LEAD(to_timestamp(_peerdb_commit_ts/1000000.0)) OVER (PARTITION BY %s ORDER BY _peerdb_timestamp)
+		AS _peerdb_valid_to,
+		ROW_NUMBER() OVER (PARTITION BY %s ORDER BY _peerdb_timestamp) AS _peerdb_version%s
+		FROM %s WHERE _peerdb_batch_id>$1 AND _peerdb_batch_id<=$2 AND _peerdb_destination_table_name=$3
+	), src AS (
+		SELECT *%s FROM src_versions
+	), closed AS (
+		UPDATE %s dst SET "_peerdb_valid_to"=src._peerdb_valid_from,"_peerdb_is_current"=FALSE FROM src
+		WHERE %s AND src._peerdb_version=1 AND dst."_peerdb_is_current" RETURNING dst.*
+	)
+	INSERT INTO %s (%s) SELECT %s FROM src LEFT JOIN closed ON %s WHERE %s`
 
 	dropTableIfExistsSQL = "DROP TABLE IF EXISTS %s.%s"
 	deleteJobMetadataSQL = "DELETE FROM %s.%s WHERE MIRROR_JOB_NAME=$1"
 )
 
+// columns of the raw table, in the order records are copied into it.
+var rawTableColumns = []string{"_peerdb_uid", "_peerdb_timestamp", "_peerdb_destination_table_name", "_peerdb_data",
+	"_peerdb_record_type", "_peerdb_match_data", "_peerdb_batch_id", "_peerdb_unchanged_toast_columns",
+	"_peerdb_source_lsn", "_peerdb_commit_ts"}
+
+// Postgres types of the system columns of normalized tables.
+var systemColumnTypes = map[string]string{
+	utils.SyncedAtColumnName:        "TIMESTAMPTZ",
+	utils.IsDeletedColumnName:       "BOOLEAN DEFAULT FALSE",
+	utils.SourceLSNColumnName:       "BIGINT",
+	utils.CommitTimestampColumnName: "TIMESTAMPTZ",
+	utils.ValidFromColumnName:       "TIMESTAMPTZ",
+	utils.ValidToColumnName:         "TIMESTAMPTZ",
+	utils.IsCurrentColumnName:       "BOOLEAN DEFAULT TRUE",
+}
+
 // getRelIDForTable returns the relation ID for a table.
 func (c *PostgresConnector) getRelIDForTable(schemaTable *SchemaTable) (uint32, error) {
 	var relID uint32
@@ -121,6 +173,37 @@ func (c *PostgresConnector) getPrimaryKeyColumn(schemaTable *SchemaTable) (strin
 	return pkCol, nil
 }
 
+// getColumnComments returns the comments of the columns of a table, from pg_description.
+func (c *PostgresConnector) getColumnComments(schemaTable *SchemaTable) (map[string]string, error) {
+	relID, err := c.getRelIDForTable(schemaTable)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relation id for table %s: %w", schemaTable, err)
+	}
+
+	rows, err := c.pool.Query(c.ctx,
+		`SELECT a.attname, d.description FROM pg_description d
+		 JOIN pg_attribute a ON a.attrelid = d.objoid AND a.attnum = d.objsubid
+		 WHERE d.objoid = $1 AND d.classoid = 'pg_class'::regclass AND d.objsubid > 0 AND NOT a.attisdropped`,
+		relID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting column comments for table %s: %w", schemaTable, err)
+	}
+	defer rows.Close()
+
+	comments := make(map[string]string)
+	for rows.Next() {
+		var columnName, comment string
+		if err := rows.Scan(&columnName, &comment); err != nil {
+			return nil, fmt.Errorf("error scanning column comment for table %s: %w", schemaTable, err)
+		}
+		comments[columnName] = comment
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over column comments for table %s: %w", schemaTable, err)
+	}
+	return comments, nil
+}
+
 func (c *PostgresConnector) tableExists(schemaTable *SchemaTable) (bool, error) {
 	var exists bool
 	err := c.pool.QueryRow(c.ctx,
@@ -275,16 +358,38 @@ func (c *PostgresConnector) createInternalSchema(createSchemaTx pgx.Tx) error {
 	return nil
 }
 
+// addRawTableSystemColumns adds the source LSN and commit timestamp columns to a raw table created
+// before they existed. The table is only altered when a column is missing, to not lock it on every sync.
+func (c *PostgresConnector) addRawTableSystemColumns(tx pgx.Tx, rawTableIdentifier string) error {
+	var numColumns int
+	err := tx.QueryRow(c.ctx, countRawTableSystemColumnsSQL, internalSchema, rawTableIdentifier).Scan(&numColumns)
+	if err != nil {
+		return fmt.Errorf("error checking columns of raw table %s: %w", rawTableIdentifier, err)
+	}
+	if numColumns == 2 {
+		return nil
+	}
+
+	_, err = tx.Exec(c.ctx, fmt.Sprintf(addRawTableSystemColumnsSQL, internalSchema, rawTableIdentifier))
+	if err != nil {
+		return fmt.Errorf("error adding system columns to raw table %s: %w", rawTableIdentifier, err)
+	}
+	return nil
+}
+
 func getRawTableIdentifier(jobName string) string {
 	jobName = regexp.MustCompile("[^a-zA-Z0-9]+").ReplaceAllString(jobName, "_")
 	return fmt.Sprintf("%s_%s", rawTablePrefix, strings.ToLower(jobName))
 }
 
 func generateCreateTableSQLForNormalizedTable(sourceTableIdentifier string,
-	sourceTableSchema *protos.TableSchema) string {
-	createTableSQLArray := make([]string, 0, len(sourceTableSchema.Columns))
+	sourceTableSchema *protos.TableSchema, softDelete bool, systemColumns *protos.SystemColumns,
+	historyMode bool) string {
+	createTableSQLArray := make([]string, 0, len(sourceTableSchema.Columns)+1)
+	fanIn := sourceTableSchema.SourceIdentifierColumn != ""
 	for columnName, genericColumnType := range sourceTableSchema.Columns {
-		if sourceTableSchema.PrimaryKeyColumn == strings.ToLower(columnName) {
+		// in history mode a row has many versions, so the primary key is not unique
+		if !fanIn && !historyMode && sourceTableSchema.PrimaryKeyColumn == strings.ToLower(columnName) {
 			createTableSQLArray = append(createTableSQLArray, fmt.Sprintf("\"%s\" %s PRIMARY KEY,",
 				columnName, qValueKindToPostgresType(genericColumnType)))
 		} else {
@@ -292,6 +397,25 @@ func generateCreateTableSQLForNormalizedTable(sourceTableIdentifier string,
 				qValueKindToPostgresType(genericColumnType)))
 		}
 	}
+	for _, columnName := range utils.GetSystemColumnNames(softDelete, systemColumns) {
+		createTableSQLArray = append(createTableSQLArray, fmt.Sprintf("\"%s\" %s,", columnName,
+			systemColumnTypes[columnName]))
+	}
+	if historyMode {
+		for _, columnName := range utils.GetHistoryColumnNames() {
+			createTableSQLArray = append(createTableSQLArray, fmt.Sprintf("\"%s\" %s,", columnName,
+				systemColumnTypes[columnName]))
+		}
+	}
+	// fan-in tables have a composite primary key that includes the source identifier column
+	if fanIn && !historyMode {
+		primaryKeyColumns := utils.GetPrimaryKeyColumns(sourceTableSchema)
+		for i, columnName := range primaryKeyColumns {
+			primaryKeyColumns[i] = utils.QuoteIdentifier(columnName)
+		}
+		createTableSQLArray = append(createTableSQLArray, fmt.Sprintf("PRIMARY KEY(%s),",
+			strings.Join(primaryKeyColumns, ",")))
+	}
 	return fmt.Sprintf(createNormalizedTableSQL, sourceTableIdentifier,
 		strings.TrimSuffix(strings.Join(createTableSQLArray, ""), ","))
 }
@@ -441,60 +565,190 @@ func (c *PostgresConnector) getTableNametoUnchangedCols(flowJobName string, sync
 	return resultMap, nil
 }
 
+// generateNormalizeStatements generates the statements that normalize the records of a batch range
+// from rawTableIdentifier, which is schema qualified, into the destination table.
 func (c *PostgresConnector) generateNormalizeStatements(destinationTableIdentifier string,
-	unchangedToastColumns []string, rawTableIdentifier string, supportsMerge bool) []string {
+	unchangedToastColumns []string, rawTableIdentifier string, supportsMerge bool,
+	softDelete bool, systemColumns *protos.SystemColumns, historyMode bool) []string {
+	if historyMode {
+		return []string{c.generateHistoryStatement(destinationTableIdentifier, unchangedToastColumns,
+			rawTableIdentifier, softDelete, systemColumns)}
+	}
 	if supportsMerge {
-		return []string{c.generateMergeStatement(destinationTableIdentifier, unchangedToastColumns, rawTableIdentifier)}
+		return []string{c.generateMergeStatement(destinationTableIdentifier, unchangedToastColumns, rawTableIdentifier,
+			softDelete, systemColumns)}
 	} else {
 		log.Warnf("Postgres version is not high enough to support MERGE, falling back to UPSERT + DELETE")
 		log.Warnf("TOAST columns will not be updated properly, use REPLICA IDENTITY FULL or upgrade Postgres")
-		return c.generateFallbackStatements(destinationTableIdentifier, rawTableIdentifier)
+		return c.generateFallbackStatements(destinationTableIdentifier, rawTableIdentifier, softDelete, systemColumns)
+	}
+}
+
+// checkDirectApply rejects applying the batch syncBatchID directly while earlier batches in the raw
+// table are not normalized yet, direct apply moves the normalize checkpoint past them.
+func checkDirectApply(normalizeBatchID int64, syncBatchID int64) error {
+	if normalizeBatchID != syncBatchID-1 {
+		return fmt.Errorf("batches %d to %d are not normalized yet, cannot apply records directly",
+			normalizeBatchID+1, syncBatchID-1)
+	}
+	return nil
+}
+
+// unchangedToastColsByTable collects the unchanged toast column sets of every destination table
+// of records, which follow rawTableColumns.
+func unchangedToastColsByTable(records [][]interface{}) map[string][]string {
+	destinationTableNameIdx := slices.Index(rawTableColumns, "_peerdb_destination_table_name")
+	unchangedToastColsIdx := slices.Index(rawTableColumns, "_peerdb_unchanged_toast_columns")
+	unchangedToastColsMap := make(map[string][]string)
+	for _, record := range records {
+		destinationTableName := record[destinationTableNameIdx].(string)
+		unchangedToastCols := record[unchangedToastColsIdx].(string)
+		if !slices.Contains(unchangedToastColsMap[destinationTableName], unchangedToastCols) {
+			unchangedToastColsMap[destinationTableName] = append(unchangedToastColsMap[destinationTableName],
+				unchangedToastCols)
+		}
+	}
+	return unchangedToastColsMap
+}
+
+// generateApplyStatements generates the statements that normalize a batch staged in the apply table
+// into every destination table of unchangedToastColsMap.
+func (c *PostgresConnector) generateApplyStatements(req *model.SyncRecordsRequest,
+	unchangedToastColsMap map[string][]string, supportsMerge bool) map[string][]string {
+	applyStatements := make(map[string][]string, len(unchangedToastColsMap))
+	for destinationTableName, unchangedToastCols := range unchangedToastColsMap {
+		applyStatements[destinationTableName] = c.generateNormalizeStatements(destinationTableName,
+			unchangedToastCols, fmt.Sprintf("pg_temp.%s", applyTableIdentifier), supportsMerge, req.SoftDelete,
+			req.SystemColumns, req.HistoryMode)
+	}
+	return applyStatements
+}
+
+// applyStagedRecords normalizes a batch staged in the apply table into the destination tables and
+// moves the normalize checkpoint to the batch, all within the sync transaction.
+func (c *PostgresConnector) applyStagedRecords(req *model.SyncRecordsRequest, records [][]interface{},
+	syncBatchID int64, syncRecordsTx pgx.Tx) error {
+	supportsMerge, err := c.majorVersionCheck(150000)
+	if err != nil {
+		return err
+	}
+	applyStatementsBatch := &pgx.Batch{}
+	totalRowsAffected := 0
+	applyStatements := c.generateApplyStatements(req, unchangedToastColsByTable(records), supportsMerge)
+	for destinationTableName, normalizeStatements := range applyStatements {
+		for _, normalizeStatement := range normalizeStatements {
+			applyStatementsBatch.Queue(normalizeStatement, syncBatchID-1, syncBatchID, destinationTableName).Exec(
+				func(ct pgconn.CommandTag) error {
+					totalRowsAffected += int(ct.RowsAffected())
+					return nil
+				})
+		}
+	}
+	err = syncRecordsTx.SendBatch(c.ctx, applyStatementsBatch).Close()
+	if err != nil {
+		return fmt.Errorf("error applying records: %w", err)
+	}
+	log.WithFields(log.Fields{
+		"flowName": req.FlowJobName,
+	}).Infof("applied %d records directly", totalRowsAffected)
+
+	_, err = syncRecordsTx.Exec(c.ctx,
+		fmt.Sprintf(updateMetadataForNormalizeRecordsSQL, internalSchema, mirrorJobsTableIdentifier),
+		syncBatchID, req.FlowJobName)
+	if err != nil {
+		return fmt.Errorf("failed to update metadata for NormalizeTables: %w", err)
+	}
+	return nil
+}
+
+// systemColumnDialect returns how a normalize computes the system columns from the raw rows
+// selected with srcPrefix.
+func systemColumnDialect(srcPrefix string) *utils.SystemColumnDialect {
+	return &utils.SystemColumnDialect{
+		QuoteIdentifier:  utils.QuoteIdentifier,
+		CurrentTimestamp: "CURRENT_TIMESTAMP",
+		SourceLSN:        srcPrefix + "_peerdb_source_lsn",
+		CommitTimestamp:  fmt.Sprintf("to_timestamp(%s_peerdb_commit_ts/1000000.0)", srcPrefix),
+		SetSeparator:     "=",
 	}
 }
 
+func generateSystemColumnValues(softDelete bool, systemColumns *protos.SystemColumns,
+	srcPrefix string, deleted bool) ([]string, []string) {
+	return utils.GenerateSystemColumnValues(softDelete, systemColumns, systemColumnDialect(srcPrefix), deleted)
+}
+
+func generateSystemColumnSets(softDelete bool, systemColumns *protos.SystemColumns,
+	srcPrefix string, deleted bool) []string {
+	return utils.GenerateSystemColumnSets(softDelete, systemColumns, systemColumnDialect(srcPrefix), deleted)
+}
+
 func (c *PostgresConnector) generateFallbackStatements(destinationTableIdentifier string,
-	rawTableIdentifier string) []string {
+	rawTableIdentifier string, softDelete bool, systemColumns *protos.SystemColumns) []string {
 	normalizedTableSchema := c.tableSchemaMapping[destinationTableIdentifier]
+	primaryKeyColumns := utils.GetPrimaryKeyColumns(normalizedTableSchema)
 	columnNames := make([]string, 0, len(normalizedTableSchema.Columns))
 	flattenedCastsSQLArray := make([]string, 0, len(normalizedTableSchema.Columns))
-	var primaryKeyColumnCast string
+	primaryKeyColumnCasts := make(map[string]string, len(primaryKeyColumns))
 	for columnName, genericColumnType := range normalizedTableSchema.Columns {
 		columnNames = append(columnNames, fmt.Sprintf("\"%s\"", columnName))
 		pgType := qValueKindToPostgresType(genericColumnType)
 		flattenedCastsSQLArray = append(flattenedCastsSQLArray, fmt.Sprintf("(_peerdb_data->>'%s')::%s AS \"%s\"",
 			columnName, pgType, columnName))
-		if normalizedTableSchema.PrimaryKeyColumn == columnName {
-			primaryKeyColumnCast = fmt.Sprintf("(_peerdb_data->>'%s')::%s", columnName, pgType)
+		if slices.Contains(primaryKeyColumns, columnName) {
+			primaryKeyColumnCasts[columnName] = fmt.Sprintf("(_peerdb_data->>'%s')::%s", columnName, pgType)
 		}
 	}
+	systemColumnNames, systemColumnValues := generateSystemColumnValues(softDelete, systemColumns, "", false)
+	flattenedCastsSQLArray = append(flattenedCastsSQLArray, systemColumnValues...)
 	flattenedCastsSQL := strings.TrimSuffix(strings.Join(flattenedCastsSQLArray, ","), ",")
 
+	columnNames = append(columnNames, systemColumnNames...)
 	insertColumnsSQL := strings.TrimSuffix(strings.Join(columnNames, ","), ",")
 	updateColumnsSQLArray := make([]string, 0, len(normalizedTableSchema.Columns))
 	for columnName := range normalizedTableSchema.Columns {
 		updateColumnsSQLArray = append(updateColumnsSQLArray, fmt.Sprintf("%s=EXCLUDED.%s", columnName, columnName))
 	}
+	for _, columnName := range systemColumnNames {
+		updateColumnsSQLArray = append(updateColumnsSQLArray, fmt.Sprintf("%s=EXCLUDED.%s", columnName, columnName))
+	}
 	updateColumnsSQL := strings.TrimSuffix(strings.Join(updateColumnsSQLArray, ","), ",")
-	fallbackUpsertStatement := fmt.Sprintf(fallbackUpsertStatementSQL, primaryKeyColumnCast, internalSchema,
+	partitionBySQLArray := make([]string, 0, len(primaryKeyColumns))
+	quotedPrimaryKeyColumns := make([]string, 0, len(primaryKeyColumns))
+	deleteWhereSQLArray := make([]string, 0, len(primaryKeyColumns))
+	for _, primaryKeyColumn := range primaryKeyColumns {
+		partitionBySQLArray = append(partitionBySQLArray, primaryKeyColumnCasts[primaryKeyColumn])
+		quotedPrimaryKeyColumns = append(quotedPrimaryKeyColumns, utils.QuoteIdentifier(primaryKeyColumn))
+		deleteWhereSQLArray = append(deleteWhereSQLArray, fmt.Sprintf("%s.\"%s\"=%s", destinationTableIdentifier,
+			primaryKeyColumn, primaryKeyColumnCasts[primaryKeyColumn]))
+	}
+	fallbackUpsertStatement := fmt.Sprintf(fallbackUpsertStatementSQL, strings.Join(partitionBySQLArray, ","),
 		rawTableIdentifier, destinationTableIdentifier, insertColumnsSQL, flattenedCastsSQL,
-		normalizedTableSchema.PrimaryKeyColumn, updateColumnsSQL)
-	fallbackDeleteStatement := fmt.Sprintf(fallbackDeleteStatementSQL, primaryKeyColumnCast, internalSchema,
-		rawTableIdentifier, destinationTableIdentifier, destinationTableIdentifier,
-		normalizedTableSchema.PrimaryKeyColumn, primaryKeyColumnCast)
+		strings.Join(quotedPrimaryKeyColumns, ","), updateColumnsSQL)
+	if softDelete {
+		fallbackSoftDeleteStatement := fmt.Sprintf(fallbackSoftDeleteStatementSQL,
+			strings.Join(partitionBySQLArray, ","), rawTableIdentifier, destinationTableIdentifier,
+			strings.Join(generateSystemColumnSets(softDelete, systemColumns, "src_rank.", true), ","),
+			strings.Join(deleteWhereSQLArray, " AND "))
+		return []string{fallbackUpsertStatement, fallbackSoftDeleteStatement}
+	}
+	fallbackDeleteStatement := fmt.Sprintf(fallbackDeleteStatementSQL, strings.Join(partitionBySQLArray, ","),
+		rawTableIdentifier, destinationTableIdentifier, strings.Join(deleteWhereSQLArray, " AND "))
 
 	return []string{fallbackUpsertStatement, fallbackDeleteStatement}
 }
 
 func (c *PostgresConnector) generateMergeStatement(destinationTableIdentifier string, unchangedToastColumns []string,
-	rawTableIdentifier string) string {
+	rawTableIdentifier string, softDelete bool, systemColumns *protos.SystemColumns) string {
 	normalizedTableSchema := c.tableSchemaMapping[destinationTableIdentifier]
 	columnNames := maps.Keys(normalizedTableSchema.Columns)
 	for i, columnName := range columnNames {
 		columnNames[i] = fmt.Sprintf("\"%s\"", columnName)
 	}
 
+	primaryKeyColumns := utils.GetPrimaryKeyColumns(normalizedTableSchema)
 	flattenedCastsSQLArray := make([]string, 0, len(normalizedTableSchema.Columns))
-	var primaryKeyColumnCast string
+	primaryKeyColumnCasts := make(map[string]string, len(primaryKeyColumns))
 	for columnName, genericColumnType := range normalizedTableSchema.Columns {
 		pgType := qValueKindToPostgresType(genericColumnType)
 		if strings.Contains(genericColumnType, "array") {
@@ -505,26 +759,145 @@ func (c *PostgresConnector) generateMergeStatement(destinationTableIdentifier st
 			flattenedCastsSQLArray = append(flattenedCastsSQLArray, fmt.Sprintf("(_peerdb_data->>'%s')::%s AS %s",
 				strings.Trim(columnName, "\""), pgType, columnName))
 		}
-		if normalizedTableSchema.PrimaryKeyColumn == columnName {
-			primaryKeyColumnCast = fmt.Sprintf("(_peerdb_data->>'%s')::%s", strings.Trim(columnName, "\""), pgType)
+		if slices.Contains(primaryKeyColumns, columnName) {
+			primaryKeyColumnCasts[columnName] = fmt.Sprintf("(_peerdb_data->>'%s')::%s",
+				strings.Trim(columnName, "\""), pgType)
 		}
 	}
+	partitionBySQLArray := make([]string, 0, len(primaryKeyColumns))
+	mergeOnSQLArray := make([]string, 0, len(primaryKeyColumns))
+	for _, primaryKeyColumn := range primaryKeyColumns {
+		partitionBySQLArray = append(partitionBySQLArray, primaryKeyColumnCasts[primaryKeyColumn])
+		mergeOnSQLArray = append(mergeOnSQLArray, fmt.Sprintf("dst.%s=src.%s", primaryKeyColumn, primaryKeyColumn))
+	}
 	flattenedCastsSQL := strings.TrimSuffix(strings.Join(flattenedCastsSQLArray, ","), ",")
 
-	insertColumnsSQL := strings.TrimSuffix(strings.Join(columnNames, ","), ",")
-	insertValuesSQLArray := make([]string, 0, len(columnNames))
+	systemColumnNames, systemColumnValues := generateSystemColumnValues(softDelete, systemColumns, "src.", false)
+	insertColumnNames := append(slices.Clone(columnNames), systemColumnNames...)
+	insertColumnsSQL := strings.TrimSuffix(strings.Join(insertColumnNames, ","), ",")
+	insertValuesSQLArray := make([]string, 0, len(columnNames)+len(systemColumnValues))
 	for _, columnName := range columnNames {
 		insertValuesSQLArray = append(insertValuesSQLArray, fmt.Sprintf("src.%s", columnName))
 	}
+	insertValuesSQLArray = append(insertValuesSQLArray, systemColumnValues...)
 	insertValuesSQL := strings.TrimSuffix(strings.Join(insertValuesSQLArray, ","), ",")
-	updateStatements := c.generateUpdateStatement(columnNames, unchangedToastColumns)
+	updateStatements := c.generateUpdateStatement(columnNames, unchangedToastColumns,
+		generateSystemColumnSets(softDelete, systemColumns, "src.", false))
+
+	deletePart := "DELETE"
+	if softDelete {
+		deletePart = fmt.Sprintf("UPDATE SET %s",
+			strings.Join(generateSystemColumnSets(softDelete, systemColumns, "src.", true), ","))
+	}
+
+	return fmt.Sprintf(mergeStatementSQL, strings.Join(partitionBySQLArray, ","), rawTableIdentifier,
+		destinationTableIdentifier, flattenedCastsSQL, strings.Join(mergeOnSQLArray, " AND "),
+		insertColumnsSQL, insertValuesSQL, updateStatements, deletePart)
+}
+
+// generateHistoryStatement generates the statement that normalizes a batch in history mode, unchanged
+// TOAST columns are taken from the latest earlier version of the row that has them.
+func (c *PostgresConnector) generateHistoryStatement(destinationTableIdentifier string,
+	unchangedToastColumns []string, rawTableIdentifier string, softDelete bool,
+	systemColumns *protos.SystemColumns) string {
+	normalizedTableSchema := c.tableSchemaMapping[destinationTableIdentifier]
+	primaryKeyColumns := utils.GetPrimaryKeyColumns(normalizedTableSchema)
+	toastColumns := make(map[string]struct{})
+	for _, cols := range unchangedToastColumns {
+		for _, col := range strings.Split(cols, ",") {
+			if col != "" {
+				toastColumns[col] = struct{}{}
+			}
+		}
+	}
+	quotedPrimaryKeyColumns := make([]string, 0, len(primaryKeyColumns))
+	for _, primaryKeyColumn := range primaryKeyColumns {
+		quotedPrimaryKeyColumns = append(quotedPrimaryKeyColumns, utils.QuoteIdentifier(primaryKeyColumn))
+	}
+
+	flattenedCastsSQLArray := make([]string, 0, len(normalizedTableSchema.Columns))
+	partitionBySQLArray := make([]string, 0, len(primaryKeyColumns))
+	schemaColumnNames := make([]string, 0, len(normalizedTableSchema.Columns))
+	for columnName, genericColumnType := range normalizedTableSchema.Columns {
+		pgType := qValueKindToPostgresType(genericColumnType)
+		var castSQL string
+		if strings.Contains(genericColumnType, "array") {
+			castSQL = fmt.Sprintf("ARRAY(SELECT * FROM JSON_ARRAY_ELEMENTS_TEXT((_peerdb_data->>'%s')::JSON))::%s",
+				columnName, pgType)
+		} else {
+			castSQL = fmt.Sprintf("(_peerdb_data->>'%s')::%s", columnName, pgType)
+		}
+		flattenedCastsSQLArray = append(flattenedCastsSQLArray, fmt.Sprintf("%s AS %s", castSQL,
+			utils.QuoteIdentifier(columnName)))
+		if slices.Contains(primaryKeyColumns, columnName) {
+			partitionBySQLArray = append(partitionBySQLArray, castSQL)
+		}
+		schemaColumnNames = append(schemaColumnNames, columnName)
+	}
+
+	partitionBySQL := strings.Join(partitionBySQLArray, ",")
+	toastSQL := utils.GenerateHistoryToastSQL(toastColumns, &utils.HistoryToastDialect{
+		QuoteIdentifier: utils.QuoteIdentifier,
+		UnchangedCondition: func(columnName string) string {
+			return fmt.Sprintf("'%s'=ANY(string_to_array(COALESCE(_peerdb_unchanged_toast_columns,''),','))",
+				columnName)
+		},
+		GroupPartitionBy: partitionBySQL,
+		ValuePartitionBy: strings.Join(quotedPrimaryKeyColumns, ","),
+		OrderBy:          "_peerdb_timestamp",
+		VersionPrefix:    "src.",
+		CurrentPrefix:    "closed.",
+	})
+
+	columnNames := make([]string, 0, len(normalizedTableSchema.Columns))
+	insertValuesSQLArray := make([]string, 0, len(normalizedTableSchema.Columns))
+	for _, columnName := range schemaColumnNames {
+		quotedColumnName := utils.QuoteIdentifier(columnName)
+		columnNames = append(columnNames, quotedColumnName)
+		if resolved, ok := toastSQL.Resolved[columnName]; ok {
+			insertValuesSQLArray = append(insertValuesSQLArray, resolved)
+		} else {
+			insertValuesSQLArray = append(insertValuesSQLArray, "src."+quotedColumnName)
+		}
+	}
 
-	return fmt.Sprintf(mergeStatementSQL, primaryKeyColumnCast, internalSchema, rawTableIdentifier,
-		destinationTableIdentifier, flattenedCastsSQL, normalizedTableSchema.PrimaryKeyColumn,
-		normalizedTableSchema.PrimaryKeyColumn, insertColumnsSQL, insertValuesSQL, updateStatements)
+	closeWhereSQLArray := make([]string, 0, len(primaryKeyColumns))
+	joinOnSQLArray := make([]string, 0, len(primaryKeyColumns))
+	for _, primaryKeyColumn := range primaryKeyColumns {
+		quotedPrimaryKeyColumn := utils.QuoteIdentifier(primaryKeyColumn)
+		closeWhereSQLArray = append(closeWhereSQLArray, fmt.Sprintf("dst.%s=src.%s",
+			quotedPrimaryKeyColumn, quotedPrimaryKeyColumn))
+		joinOnSQLArray = append(joinOnSQLArray, fmt.Sprintf("closed.%s=src.%s",
+			quotedPrimaryKeyColumn, quotedPrimaryKeyColumn))
+	}
+
+	// with soft delete, a delete inserts a version marked as deleted instead of only closing the row
+	systemColumnNames, systemColumnValues := generateSystemColumnValues(softDelete, systemColumns, "src.", false)
+	for i, columnName := range systemColumnNames {
+		if columnName == utils.QuoteIdentifier(utils.IsDeletedColumnName) {
+			systemColumnValues[i] = "src._peerdb_record_type=2"
+		}
+	}
+	columnNames = append(columnNames, systemColumnNames...)
+	insertValuesSQLArray = append(insertValuesSQLArray, systemColumnValues...)
+	for _, columnName := range utils.GetHistoryColumnNames() {
+		columnNames = append(columnNames, utils.QuoteIdentifier(columnName))
+	}
+	insertValuesSQLArray = append(insertValuesSQLArray, "src._peerdb_valid_from", "src._peerdb_valid_to",
+		"src._peerdb_valid_to IS NULL")
+	insertWhereSQL := "src._peerdb_record_type!=2"
+	if softDelete {
+		insertWhereSQL = "TRUE"
+	}
+
+	return fmt.Sprintf(historyStatementSQL, strings.Join(flattenedCastsSQLArray, ","), partitionBySQL,
+		partitionBySQL, toastSQL.GroupsSQL, rawTableIdentifier, toastSQL.ValuesSQL, destinationTableIdentifier,
+		strings.Join(closeWhereSQLArray, " AND "), destinationTableIdentifier, strings.Join(columnNames, ","),
+		strings.Join(insertValuesSQLArray, ","), strings.Join(joinOnSQLArray, " AND "), insertWhereSQL)
 }
 
-func (c *PostgresConnector) generateUpdateStatement(allCols []string, unchangedToastColsLists []string) string {
+func (c *PostgresConnector) generateUpdateStatement(allCols []string, unchangedToastColsLists []string,
+	systemColumnSetSQLArray []string) string {
 	updateStmts := make([]string, 0)
 
 	for _, cols := range unchangedToastColsLists {
@@ -537,11 +910,12 @@ func (c *PostgresConnector) generateUpdateStatement(allCols []string, unchangedT
 		for _, colName := range otherCols {
 			tmpArray = append(tmpArray, fmt.Sprintf("%s=src.%s", colName, colName))
 		}
+		tmpArray = append(tmpArray, systemColumnSetSQLArray...)
 		ssep := strings.Join(tmpArray, ",")
-		quotedCols := strings.Join(unchangedColsArray, ",")
+		// the raw table keeps the unchanged toast columns unquoted.
 		updateStmt := fmt.Sprintf(`WHEN MATCHED AND
 		src._peerdb_record_type=1 AND _peerdb_unchanged_toast_columns='%s'
-		THEN UPDATE SET %s `, quotedCols, ssep)
+		THEN UPDATE SET %s `, cols, ssep)
 		updateStmts = append(updateStmts, updateStmt)
 	}
 	return strings.Join(updateStmts, "\n")
@@ -589,3 +963,14 @@ func (c *PostgresConnector) getCurrentLSN() (pglogrepl.LSN, error) {
 	}
 	return pglogrepl.ParseLSN(result)
 }
+
+func (c *PostgresConnector) getSlotConfirmedFlushLSN(slot string) (pglogrepl.LSN, error) {
+	row := c.pool.QueryRow(c.ctx,
+		"SELECT confirmed_flush_lsn FROM pg_replication_slots WHERE slot_name = $1", slot)
+	var result string
+	err := row.Scan(&result)
+	if err != nil {
+		return 0, fmt.Errorf("error while getting confirmed flush lsn of slot %s: %w", slot, err)
+	}
+	return pglogrepl.ParseLSN(result)
+}
@@ -0,0 +1,120 @@
+package connpostgres
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func applyTestConnector() *PostgresConnector {
+	return &PostgresConnector{
+		tableSchemaMapping: map[string]*protos.TableSchema{
+			"public.dst": {
+				TableIdentifier: "public.dst",
+				Columns: map[string]string{
+					"id":   string(qvalue.QValueKindInt32),
+					"name": string(qvalue.QValueKindString),
+					"n_t":  string(qvalue.QValueKindString),
+				},
+				PrimaryKeyColumn: "id",
+			},
+		},
+	}
+}
+
+// stagedRecord returns a record in the order of rawTableColumns.
+func stagedRecord(destinationTableName string, recordType int, unchangedToastColumns string) []interface{} {
+	return []interface{}{"uid", int64(1), destinationTableName, "{}", recordType, "{}", int64(3),
+		unchangedToastColumns, int64(1), int64(1)}
+}
+
+// mergeClause returns the clause of the merge statement that starts with prefix.
+func mergeClause(t *testing.T, mergeStatement string, prefix string) string {
+	t.Helper()
+	start := strings.Index(mergeStatement, prefix)
+	require.GreaterOrEqual(t, start, 0, "%q not found in %s", prefix, mergeStatement)
+	clause := mergeStatement[start+len(prefix):]
+	if end := strings.Index(clause, "WHEN"); end >= 0 {
+		clause = clause[:end]
+	}
+	return clause
+}
+
+func TestUnchangedToastColsByTable(t *testing.T) {
+	records := [][]interface{}{
+		stagedRecord("public.dst", 0, ""),
+		stagedRecord("public.dst", 1, "n_t"),
+		stagedRecord("public.dst", 1, ""),
+		stagedRecord("public.dst", 2, ""),
+		stagedRecord("public.other", 0, ""),
+	}
+	require.Equal(t, map[string][]string{
+		"public.dst":   {"", "n_t"},
+		"public.other": {""},
+	}, unchangedToastColsByTable(records))
+}
+
+func TestGenerateApplyStatementsMerge(t *testing.T) {
+	c := applyTestConnector()
+	applyStatements := c.generateApplyStatements(&model.SyncRecordsRequest{},
+		map[string][]string{"public.dst": {"", "n_t"}}, true)
+	require.Len(t, applyStatements["public.dst"], 1)
+	mergeStatement := applyStatements["public.dst"][0]
+
+	// the batch is read from the apply table instead of the raw table.
+	require.Contains(t, mergeStatement, "FROM pg_temp._peerdb_apply WHERE _peerdb_batch_id>$1")
+	require.Contains(t, mergeStatement, "MERGE INTO public.dst dst")
+	require.Contains(t, mergeStatement, "ON dst.id=src.id")
+
+	// inserts
+	insertClause := mergeClause(t, mergeStatement, "WHEN NOT MATCHED AND src._peerdb_record_type!=2 THEN")
+	for _, columnName := range []string{`"id"`, `"name"`, `"n_t"`} {
+		require.Contains(t, insertClause, "src."+columnName)
+	}
+
+	// updates without unchanged TOAST columns set every column.
+	updateClause := mergeClause(t, mergeStatement,
+		"src._peerdb_record_type=1 AND _peerdb_unchanged_toast_columns=''")
+	for _, columnName := range []string{`"id"`, `"name"`, `"n_t"`} {
+		require.Contains(t, updateClause, columnName+"=src."+columnName)
+	}
+
+	// updates with an unchanged TOAST column keep its value.
+	toastUpdateClause := mergeClause(t, mergeStatement,
+		"src._peerdb_record_type=1 AND _peerdb_unchanged_toast_columns='n_t'")
+	require.Contains(t, toastUpdateClause, `"name"=src."name"`)
+	require.NotContains(t, toastUpdateClause, `"n_t"=src."n_t"`)
+
+	// deletes
+	deleteClause := mergeClause(t, mergeStatement, "WHEN MATCHED AND src._peerdb_record_type=2 THEN")
+	require.Equal(t, "DELETE", strings.TrimSpace(deleteClause))
+}
+
+func TestGenerateApplyStatementsFallback(t *testing.T) {
+	c := applyTestConnector()
+	applyStatements := c.generateApplyStatements(&model.SyncRecordsRequest{},
+		map[string][]string{"public.dst": {""}}, false)
+	require.Len(t, applyStatements["public.dst"], 2)
+	upsertStatement, deleteStatement := applyStatements["public.dst"][0], applyStatements["public.dst"][1]
+
+	require.Contains(t, upsertStatement, "FROM pg_temp._peerdb_apply WHERE _peerdb_batch_id>$1")
+	require.Contains(t, upsertStatement, "INSERT INTO public.dst")
+	require.Contains(t, upsertStatement, "_peerdb_record_type!=2")
+	require.Contains(t, upsertStatement, `ON CONFLICT ("id") DO UPDATE SET`)
+	require.Contains(t, upsertStatement, "name=EXCLUDED.name")
+
+	require.Contains(t, deleteStatement, "FROM pg_temp._peerdb_apply WHERE _peerdb_batch_id>$1")
+	require.Contains(t, deleteStatement, "DELETE FROM public.dst USING src_rank")
+	require.Contains(t, deleteStatement, "src_rank._peerdb_record_type=2")
+}
+
+func TestCheckDirectApply(t *testing.T) {
+	require.NoError(t, checkDirectApply(0, 1))
+	require.NoError(t, checkDirectApply(4, 5))
+	require.ErrorContains(t, checkDirectApply(2, 5), "batches 3 to 4 are not normalized yet")
+	require.ErrorContains(t, checkDirectApply(3, 5), "batches 4 to 4 are not normalized yet")
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/PeerDB-io/peer-flow/model/qvalue"
 	"github.com/PeerDB-io/peer-flow/shared"
 	"github.com/google/uuid"
+	"github.com/jackc/pglogrepl"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -210,8 +211,21 @@ func (c *PostgresConnector) PullRecords(req *model.PullRecordsRequest) (*model.R
 		"flowName": req.FlowJobName,
 	}).Infof("PullRecords: performed checks for slot and publication")
 
+	// the slot is only acked up to what every destination has synced, which is the last sync state.
+	// without one the slot stays where it was confirmed last.
+	var committedLSN pglogrepl.LSN
+	if req.LastSyncState != nil && req.LastSyncState.Checkpoint > 0 {
+		committedLSN = pglogrepl.LSN(req.LastSyncState.Checkpoint)
+	} else {
+		committedLSN, err = c.getSlotConfirmedFlushLSN(slotName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	cdc, err := NewPostgresCDCSource(&PostgresCDCConfig{
 		AppContext:            c.ctx,
+		CommittedLSN:          committedLSN,
 		Connection:            c.replPool,
 		SrcTableIDNameMapping: req.SrcTableIDNameMapping,
 		Slot:                  slotName,
@@ -272,7 +286,12 @@ func (c *PostgresConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 		case *model.InsertRecord:
 			itemsJSON, err := typedRecord.Items.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to serialize insert record items to JSON: %w", err)
+				err = req.SkipFailedRecord(record,
+					fmt.Errorf("failed to serialize insert record items to JSON: %w", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			records = append(records, []interface{}{
@@ -284,16 +303,28 @@ func (c *PostgresConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 				"{}",
 				syncBatchID,
 				utils.KeysToString(typedRecord.UnchangedToastColumns),
+				typedRecord.CheckPointID,
+				typedRecord.CommitTime.UnixMicro(),
 			})
 			tableNameRowsMapping[typedRecord.DestinationTableName] += 1
 		case *model.UpdateRecord:
 			newItemsJSON, err := typedRecord.NewItems.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to serialize update record new items to JSON: %w", err)
+				err = req.SkipFailedRecord(record,
+					fmt.Errorf("failed to serialize update record new items to JSON: %w", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 			oldItemsJSON, err := typedRecord.OldItems.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to serialize update record old items to JSON: %w", err)
+				err = req.SkipFailedRecord(record,
+					fmt.Errorf("failed to serialize update record old items to JSON: %w", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			records = append(records, []interface{}{
@@ -305,12 +336,19 @@ func (c *PostgresConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 				oldItemsJSON,
 				syncBatchID,
 				utils.KeysToString(typedRecord.UnchangedToastColumns),
+				typedRecord.CheckPointID,
+				typedRecord.CommitTime.UnixMicro(),
 			})
 			tableNameRowsMapping[typedRecord.DestinationTableName] += 1
 		case *model.DeleteRecord:
 			itemsJSON, err := typedRecord.Items.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to serialize delete record items to JSON: %w", err)
+				err = req.SkipFailedRecord(record,
+					fmt.Errorf("failed to serialize delete record items to JSON: %w", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			records = append(records, []interface{}{
@@ -322,6 +360,8 @@ func (c *PostgresConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 				itemsJSON,
 				syncBatchID,
 				utils.KeysToString(typedRecord.UnchangedToastColumns),
+				typedRecord.CheckPointID,
+				typedRecord.CommitTime.UnixMicro(),
 			})
 			tableNameRowsMapping[typedRecord.DestinationTableName] += 1
 		default:
@@ -342,6 +382,17 @@ func (c *PostgresConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 		}, nil
 	}
 
+	if req.DirectApply {
+		normalizeBatchID, err := c.getLastNormalizeBatchID(req.FlowJobName)
+		if err != nil {
+			return nil, err
+		}
+		err = checkDirectApply(normalizeBatchID, syncBatchID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	syncRecordsTx, err := c.pool.Begin(c.ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error starting transaction for syncing records: %w", err)
@@ -355,10 +406,23 @@ func (c *PostgresConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 		}
 	}()
 
+	err = c.addRawTableSystemColumns(syncRecordsTx, rawTableIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	copyTableIdentifier := pgx.Identifier{internalSchema, rawTableIdentifier}
+	if req.DirectApply {
+		_, err = syncRecordsTx.Exec(c.ctx, fmt.Sprintf(createApplyTableSQL, applyTableIdentifier,
+			internalSchema, rawTableIdentifier))
+		if err != nil {
+			return nil, fmt.Errorf("error creating apply table: %w", err)
+		}
+		copyTableIdentifier = pgx.Identifier{"pg_temp", applyTableIdentifier}
+	}
+
 	startTime := time.Now()
-	syncedRecordsCount, err := syncRecordsTx.CopyFrom(c.ctx, pgx.Identifier{internalSchema, rawTableIdentifier},
-		[]string{"_peerdb_uid", "_peerdb_timestamp", "_peerdb_destination_table_name", "_peerdb_data",
-			"_peerdb_record_type", "_peerdb_match_data", "_peerdb_batch_id", "_peerdb_unchanged_toast_columns"},
+	syncedRecordsCount, err := syncRecordsTx.CopyFrom(c.ctx, copyTableIdentifier, rawTableColumns,
 		pgx.CopyFromRows(records))
 	if err != nil {
 		return nil, fmt.Errorf("error syncing records: %w", err)
@@ -371,13 +435,19 @@ func (c *PostgresConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 
 	log.WithFields(log.Fields{
 		"flowName": req.FlowJobName,
-	}).Printf("synced %d records to Postgres table %s via COPY", syncedRecordsCount, rawTableIdentifier)
+	}).Printf("synced %d records to Postgres table %s via COPY", syncedRecordsCount, copyTableIdentifier.Sanitize())
 
 	// updating metadata with new offset and syncBatchID
 	err = c.updateSyncMetadata(req.FlowJobName, lastCP, syncBatchID, syncRecordsTx)
 	if err != nil {
 		return nil, err
 	}
+	if req.DirectApply {
+		err = c.applyStagedRecords(req, records, syncBatchID, syncRecordsTx)
+		if err != nil {
+			return nil, err
+		}
+	}
 	// transaction commits
 	err = syncRecordsTx.Commit(c.ctx)
 	if err != nil {
@@ -394,7 +464,7 @@ func (c *PostgresConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.S
 }
 
 func (c *PostgresConnector) NormalizeRecords(req *model.NormalizeRecordsRequest) (*model.NormalizeResponse, error) {
-	rawTableIdentifier := getRawTableIdentifier(req.FlowJobName)
+	rawTableIdentifier := fmt.Sprintf("%s.%s", internalSchema, getRawTableIdentifier(req.FlowJobName))
 	syncBatchID, err := c.GetLastSyncBatchID(req.FlowJobName)
 	if err != nil {
 		return nil, err
@@ -437,6 +507,11 @@ func (c *PostgresConnector) NormalizeRecords(req *model.NormalizeRecordsRequest)
 		}
 	}()
 
+	err = c.addRawTableSystemColumns(normalizeRecordsTx, getRawTableIdentifier(req.FlowJobName))
+	if err != nil {
+		return nil, err
+	}
+
 	supportsMerge, err := c.majorVersionCheck(150000)
 	if err != nil {
 		return nil, err
@@ -445,7 +520,7 @@ func (c *PostgresConnector) NormalizeRecords(req *model.NormalizeRecordsRequest)
 	totalRowsAffected := 0
 	for destinationTableName, unchangedToastCols := range unchangedToastColsMap {
 		normalizeStatements := c.generateNormalizeStatements(destinationTableName, unchangedToastCols,
-			rawTableIdentifier, supportsMerge)
+			rawTableIdentifier, supportsMerge, req.SoftDelete, req.SystemColumns, req.HistoryMode)
 		for _, normalizeStatement := range normalizeStatements {
 			mergeStatementsBatch.Queue(normalizeStatement, normalizeBatchID, syncBatchID, destinationTableName).Exec(
 				func(ct pgconn.CommandTag) error {
@@ -522,6 +597,10 @@ func (c *PostgresConnector) CreateRawTable(req *protos.CreateRawTableInput) (*pr
 	if err != nil {
 		return nil, fmt.Errorf("error creating raw table: %w", err)
 	}
+	err = c.addRawTableSystemColumns(createRawTableTx, rawTableIdentifier)
+	if err != nil {
+		return nil, err
+	}
 
 	err = createRawTableTx.Commit(c.ctx)
 	if err != nil {
@@ -570,10 +649,16 @@ func (c *PostgresConnector) getTableSchemaForTable(
 		return nil, fmt.Errorf("error getting primary key column for table %s: %w", schemaTable, err)
 	}
 
+	columnComments, err := c.getColumnComments(schemaTable)
+	if err != nil {
+		return nil, err
+	}
+
 	res := &protos.TableSchema{
 		TableIdentifier:  tableName,
 		Columns:          make(map[string]string),
 		PrimaryKeyColumn: pkey,
+		ColumnComments:   columnComments,
 	}
 
 	for _, fieldDescription := range rows.FieldDescriptions() {
@@ -627,7 +712,8 @@ func (c *PostgresConnector) SetupNormalizedTables(req *protos.SetupNormalizedTab
 		}
 
 		// convert the column names and types to Postgres types
-		normalizedTableCreateSQL := generateCreateTableSQLForNormalizedTable(tableIdentifier, tableSchema)
+		normalizedTableCreateSQL := generateCreateTableSQLForNormalizedTable(tableIdentifier, tableSchema,
+			req.SoftDelete, req.SystemColumns, req.HistoryMode)
 		_, err = createNormalizedTablesTx.Exec(c.ctx, normalizedTableCreateSQL)
 		if err != nil {
 			return nil, fmt.Errorf("error while creating normalized table: %w", err)
@@ -11,6 +11,7 @@ import (
 	"github.com/PeerDB-io/peer-flow/model"
 	"github.com/PeerDB-io/peer-flow/model/qvalue"
 	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -731,6 +732,160 @@ func (suite *PostgresCDCTestSuite) TestToastHappyFlow() {
 	suite.dropTable(toastHappyFlowSrcTableName)
 }
 
+func (suite *PostgresCDCTestSuite) TestFanInDeletes() {
+	fanInFlowName := "fan_in_deletes_testing_flow"
+	fanInSrcTableNames := []string{"pgpeer_test.fan_in_shard_1", "pgpeer_test.fan_in_shard_2"}
+	fanInDstTableName := "fan_in_dst"
+	sourceIdentifierColumn := "_peerdb_source_table"
+
+	for _, srcTableName := range fanInSrcTableNames {
+		_, err := suite.connector.pool.Exec(context.Background(),
+			fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s(id INT PRIMARY KEY, name TEXT)", srcTableName))
+		suite.failTestError(err)
+	}
+
+	ensurePullabilityOutput, err := suite.connector.EnsurePullability(&protos.EnsurePullabilityBatchInput{
+		FlowJobName:            fanInFlowName,
+		SourceTableIdentifiers: fanInSrcTableNames,
+		PeerConnectionConfig:   nil, // not used by the connector itself.
+	})
+	suite.failTestError(err)
+
+	relIDTableNameMapping := make(map[uint32]string)
+	tableNameMapping := make(map[string]string)
+	for _, srcTableName := range fanInSrcTableNames {
+		tableRelID := ensurePullabilityOutput.TableIdentifierMapping[srcTableName].GetPostgresTableIdentifier().RelId
+		relIDTableNameMapping[tableRelID] = srcTableName
+		tableNameMapping[srcTableName] = fanInDstTableName
+	}
+	err = suite.connector.SetupReplication(nil, &protos.SetupReplicationInput{
+		FlowJobName:          fanInFlowName,
+		TableNameMapping:     tableNameMapping,
+		PeerConnectionConfig: nil, // not used by the connector itself.
+	})
+	suite.failTestError(err)
+
+	// the schema mapping is keyed by the destination table, shared by both shards.
+	tableNameSchemaMapping := map[string]*protos.TableSchema{
+		fanInDstTableName: {
+			TableIdentifier: fanInDstTableName,
+			Columns: map[string]string{
+				"id":                   string(qvalue.QValueKindInt32),
+				"name":                 string(qvalue.QValueKindString),
+				sourceIdentifierColumn: string(qvalue.QValueKindString),
+			},
+			PrimaryKeyColumn:       "id",
+			SourceIdentifierColumn: sourceIdentifierColumn,
+		},
+	}
+
+	// both shards have a row with the same primary key value, deleted in each shard.
+	for _, srcTableName := range fanInSrcTableNames {
+		_, err = suite.connector.pool.Exec(context.Background(),
+			fmt.Sprintf("INSERT INTO %s(id, name) VALUES (1, 'quick')", srcTableName))
+		suite.failTestError(err)
+		_, err = suite.connector.pool.Exec(context.Background(),
+			fmt.Sprintf("DELETE FROM %s WHERE id = 1", srcTableName))
+		suite.failTestError(err)
+	}
+
+	records, err := suite.connector.PullRecords(&model.PullRecordsRequest{
+		FlowJobName:            fanInFlowName,
+		LastSyncState:          nil,
+		IdleTimeout:            5 * time.Second,
+		MaxBatchSize:           100,
+		SrcTableIDNameMapping:  relIDTableNameMapping,
+		TableNameMapping:       tableNameMapping,
+		TableNameSchemaMapping: tableNameSchemaMapping,
+	})
+	suite.failTestError(err)
+	suite.Equal(4, len(records.Records))
+
+	deletedSourceTables := make([]string, 0, len(fanInSrcTableNames))
+	for _, record := range records.Records {
+		if insertRecord, ok := record.(*model.InsertRecord); ok {
+			suite.Equal(insertRecord.SourceTableName, insertRecord.Items[sourceIdentifierColumn].Value)
+			continue
+		}
+		suite.IsType(&model.DeleteRecord{}, record)
+		deleteRecord := record.(*model.DeleteRecord)
+		suite.Equal(fanInDstTableName, deleteRecord.DestinationTableName)
+		suite.Equal(int32(1), deleteRecord.Items["id"].Value)
+		suite.Equal(qvalue.QValue{Kind: qvalue.QValueKindString, Value: deleteRecord.SourceTableName},
+			deleteRecord.Items[sourceIdentifierColumn])
+		deletedSourceTables = append(deletedSourceTables, deleteRecord.SourceTableName)
+	}
+	suite.Equal(fanInSrcTableNames, deletedSourceTables)
+
+	err = suite.connector.PullFlowCleanup(fanInFlowName)
+	suite.failTestError(err)
+
+	for _, srcTableName := range fanInSrcTableNames {
+		suite.dropTable(srcTableName)
+	}
+}
+
+func (suite *PostgresCDCTestSuite) rawTableSystemColumnCount(rawTableIdentifier string) int {
+	var numColumns int
+	err := suite.connector.pool.QueryRow(context.Background(), countRawTableSystemColumnsSQL,
+		internalSchema, rawTableIdentifier).Scan(&numColumns)
+	suite.failTestError(err)
+	return numColumns
+}
+
+func (suite *PostgresCDCTestSuite) TestRawTableUpgrade() {
+	upgradeFlowName := "raw_table_upgrade_testing"
+	rawTableIdentifier := getRawTableIdentifier(upgradeFlowName)
+
+	// the raw table as created before the source LSN and commit timestamp columns existed.
+	_, err := suite.connector.pool.Exec(context.Background(), fmt.Sprintf(`CREATE SCHEMA IF NOT EXISTS %s;
+		CREATE TABLE %s.%s(_peerdb_uid TEXT NOT NULL,
+		_peerdb_timestamp BIGINT NOT NULL,_peerdb_destination_table_name TEXT NOT NULL,_peerdb_data JSONB NOT NULL,
+		_peerdb_record_type INTEGER NOT NULL, _peerdb_match_data JSONB,_peerdb_batch_id INTEGER,
+		_peerdb_unchanged_toast_columns TEXT)`, internalSchema, internalSchema, rawTableIdentifier))
+	suite.failTestError(err)
+	defer suite.dropTable(fmt.Sprintf("%s.%s", internalSchema, rawTableIdentifier))
+	suite.Equal(0, suite.rawTableSystemColumnCount(rawTableIdentifier))
+
+	_, err = suite.connector.CreateRawTable(&protos.CreateRawTableInput{
+		FlowJobName: upgradeFlowName,
+	})
+	suite.failTestError(err)
+	suite.Equal(2, suite.rawTableSystemColumnCount(rawTableIdentifier))
+
+	// running it again on an upgraded table is a no-op.
+	tx, err := suite.connector.pool.Begin(context.Background())
+	suite.failTestError(err)
+	err = suite.connector.addRawTableSystemColumns(tx, rawTableIdentifier)
+	suite.failTestError(err)
+	err = tx.Commit(context.Background())
+	suite.failTestError(err)
+	suite.Equal(2, suite.rawTableSystemColumnCount(rawTableIdentifier))
+
+	_, err = suite.connector.pool.Exec(context.Background(), fmt.Sprintf(
+		"INSERT INTO %s.%s(_peerdb_uid,_peerdb_timestamp,_peerdb_destination_table_name,_peerdb_data,"+
+			"_peerdb_record_type,_peerdb_match_data,_peerdb_batch_id,_peerdb_unchanged_toast_columns,"+
+			"_peerdb_source_lsn,_peerdb_commit_ts) VALUES ('uid',1,'dst','{}',0,'{}',1,'',10,20)",
+		internalSchema, rawTableIdentifier))
+	suite.failTestError(err)
+}
+
 func TestPostgresTestSuite(t *testing.T) {
 	suite.Run(t, new(PostgresCDCTestSuite))
 }
+
+func TestAddSourceIdentifier(t *testing.T) {
+	tableNameSchemaMapping := map[string]*protos.TableSchema{
+		"fan_in_dst": {PrimaryKeyColumn: "id", SourceIdentifierColumn: "_peerdb_source_table"},
+	}
+	for _, srcTableName := range []string{"public.shard_1", "public.shard_2"} {
+		deleteRecord := &model.DeleteRecord{
+			SourceTableName:      srcTableName,
+			DestinationTableName: "fan_in_dst",
+			Items:                model.RecordItems{"id": qvalue.QValue{Kind: qvalue.QValueKindInt32, Value: int32(1)}},
+		}
+		addSourceIdentifier(deleteRecord, tableNameSchemaMapping)
+		require.Equal(t, qvalue.QValue{Kind: qvalue.QValueKindString, Value: srcTableName},
+			deleteRecord.Items["_peerdb_source_table"])
+	}
+}
@@ -3,6 +3,7 @@ package connpostgres
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/template"
 	"time"
 
@@ -19,6 +20,12 @@ import (
 
 const qRepMetadataTableName = "_peerdb_query_replication_metadata"
 
+// number of partitions of uuid and text watermark columns when num_key_partitions is not set.
+const defaultNumKeyPartitions = 16
+
+// number of rows sampled to get the bounds of the partitions of text watermark columns.
+const textPartitionSampleRows = 100000
+
 func (c *PostgresConnector) GetQRepPartitions(
 	config *protos.QRepConfig,
 	last *protos.QRepPartition,
@@ -63,6 +70,10 @@ func (c *PostgresConnector) GetQRepPartitions(
 	// 	log.Warnf("failed to lock table %s: %v", config.WatermarkTable, err)
 	// }
 
+	if columns := utils.WatermarkColumns(config.WatermarkColumn); len(columns) > 1 {
+		return c.getTuplePartitions(tx, config, utils.QuoteColumns(columns), last)
+	}
+
 	if config.NumRowsPerPartition > 0 {
 		return c.getNumRowsPartitions(tx, config, last)
 	}
@@ -72,6 +83,11 @@ func (c *PostgresConnector) GetQRepPartitions(
 		return nil, err
 	}
 
+	numKeyPartitions := int(config.NumKeyPartitions)
+	if numKeyPartitions == 0 {
+		numKeyPartitions = defaultNumKeyPartitions
+	}
+
 	var partitions []*protos.QRepPartition
 	switch v := minValue.(type) {
 	case int64:
@@ -80,6 +96,10 @@ func (c *PostgresConnector) GetQRepPartitions(
 	case time.Time:
 		maxValue := maxValue.(time.Time).Add(time.Microsecond)
 		partitions, err = c.getTimePartitions(v, maxValue, config.BatchDurationSeconds)
+	case uuid.UUID:
+		partitions, err = c.getUUIDPartitions(v, maxValue.(uuid.UUID), numKeyPartitions)
+	case string:
+		partitions, err = c.getTextPartitions(tx, config, v, maxValue.(string), numKeyPartitions)
 	// only hit when there is no data in the source table
 	case nil:
 		log.Warnf("no records to replicate for flow job %s, returning", config.FlowJobName)
@@ -92,6 +112,11 @@ func (c *PostgresConnector) GetQRepPartitions(
 		return nil, err
 	}
 
+	err = tx.Commit(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return partitions, nil
 }
 
@@ -130,6 +155,8 @@ func (c *PostgresConnector) getNumRowsPartitions(
 			minVal = lastRange.IntRange.End
 		case *protos.PartitionRange_TimestampRange:
 			minVal = lastRange.TimestampRange.End.AsTime()
+		case *protos.PartitionRange_StringRange:
+			minVal = lastRange.StringRange.End
 		}
 		row = tx.QueryRow(c.ctx, countQuery, minVal)
 	} else {
@@ -217,6 +244,82 @@ func (c *PostgresConnector) getNumRowsPartitions(
 	return partitionHelper.GetPartitions(), nil
 }
 
+// getTuplePartitions splits the rows of a composite watermark into partitions of num_rows_per_partition rows,
+// the rows after the end of the last partition are a single partition when it is not set.
+func (c *PostgresConnector) getTuplePartitions(
+	tx pgx.Tx,
+	config *protos.QRepConfig,
+	quotedColumns []string,
+	last *protos.QRepPartition,
+) ([]*protos.QRepPartition, error) {
+	columnList := strings.Join(quotedColumns, ", ")
+
+	whereClause := ""
+	var minVal []interface{}
+	if last != nil && last.Range != nil {
+		lastRange, ok := last.Range.Range.(*protos.PartitionRange_TupleRange)
+		if !ok || len(lastRange.TupleRange.End) != len(quotedColumns) {
+			return nil, fmt.Errorf("last partition doesn't have a range over the %d watermark columns",
+				len(quotedColumns))
+		}
+		minVal = utils.FromPartitionValues(lastRange.TupleRange.End)
+		params := make([]string, 0, len(minVal))
+		for i := range minVal {
+			params = append(params, fmt.Sprintf("$%d", i+1))
+		}
+		whereClause = fmt.Sprintf("WHERE (%s) > (%s)", columnList, strings.Join(params, ", "))
+	}
+
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s %s", config.WatermarkTable, whereClause)
+	var totalRows int64
+	if err := tx.QueryRow(c.ctx, countQuery, minVal...).Scan(&totalRows); err != nil {
+		return nil, fmt.Errorf("failed to query for total rows: %w", err)
+	}
+
+	if totalRows == 0 {
+		log.Warnf("no records to replicate for flow job %s, returning", config.FlowJobName)
+		return make([]*protos.QRepPartition, 0), nil
+	}
+
+	numPartitions := int64(1)
+	if config.NumRowsPerPartition > 0 {
+		numRowsPerPartition := int64(config.NumRowsPerPartition)
+		numPartitions = (totalRows + numRowsPerPartition - 1) / numRowsPerPartition
+	}
+	log.Infof("total rows: %d, num partitions: %d, num rows per partition: %d",
+		totalRows, numPartitions, config.NumRowsPerPartition)
+
+	partitionsQuery := utils.TuplePartitionsQuery(quotedColumns, config.WatermarkTable, whereClause, numPartitions)
+	log.Infof("[tuple_based] partitions query: %s", partitionsQuery)
+	rows, err := tx.Query(c.ctx, partitionsQuery, minVal...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query for partitions: %w", err)
+	}
+
+	partitionHelper := utils.NewPartitionHelper()
+	for rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		err = partitionHelper.AddTuplePartition(values[:len(quotedColumns)], values[len(quotedColumns):])
+		if err != nil {
+			return nil, fmt.Errorf("failed to add partition: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read partitions: %w", err)
+	}
+
+	err = tx.Commit(c.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return partitionHelper.GetPartitions(), nil
+}
+
 func (c *PostgresConnector) getMinMaxValues(
 	tx pgx.Tx,
 	config *protos.QRepConfig,
@@ -244,6 +347,14 @@ func (c *PostgresConnector) getMinMaxValues(
 			}
 		case *protos.PartitionRange_TimestampRange:
 			minValue = lastRange.TimestampRange.End.AsTime()
+		case *protos.PartitionRange_StringRange:
+			// strings can't be incremented, start from the first value after the end of the last partition
+			minQuery := fmt.Sprintf("SELECT MIN(%[1]s) FROM %[2]s WHERE %[1]s > $1",
+				quotedWatermarkColumn, config.WatermarkTable)
+			row := tx.QueryRow(c.ctx, minQuery, lastRange.StringRange.End)
+			if err := row.Scan(&minValue); err != nil {
+				return nil, nil, fmt.Errorf("failed to query for min value: %w", err)
+			}
 		}
 	} else {
 		// Otherwise get the minimum value from the database
@@ -266,9 +377,10 @@ func (c *PostgresConnector) getMinMaxValues(
 		}
 	}
 
-	err := tx.Commit(c.ctx)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	// uuids are scanned as bytes
+	if v, ok := minValue.([16]byte); ok {
+		minValue = uuid.UUID(v)
+		maxValue = uuid.UUID(maxValue.([16]byte))
 	}
 
 	return minValue, maxValue, nil
@@ -286,48 +398,19 @@ func (c *PostgresConnector) PullQRepRecords(
 		query := config.Query
 		return executor.ExecuteAndProcessQuery(query)
 	}
-
-	var rangeStart interface{}
-	var rangeEnd interface{}
-
-	// Depending on the type of the range, convert the range into the correct type
-	switch x := partition.Range.Range.(type) {
-	case *protos.PartitionRange_IntRange:
-		rangeStart = x.IntRange.Start
-		rangeEnd = x.IntRange.End
-	case *protos.PartitionRange_TimestampRange:
-		rangeStart = x.TimestampRange.Start.AsTime()
-		rangeEnd = x.TimestampRange.End.AsTime()
-	case *protos.PartitionRange_TidRange:
-		rangeStart = pgtype.TID{
-			BlockNumber:  x.TidRange.Start.BlockNumber,
-			OffsetNumber: uint16(x.TidRange.Start.OffsetNumber),
-			Valid:        true,
-		}
-		rangeEnd = pgtype.TID{
-			BlockNumber:  x.TidRange.End.BlockNumber,
-			OffsetNumber: uint16(x.TidRange.End.OffsetNumber),
-			Valid:        true,
-		}
-	default:
-		return nil, fmt.Errorf("unknown range type: %v", x)
-	}
 	log.WithFields(log.Fields{
 		"flowName":  config.FlowJobName,
 		"partition": partition.PartitionId,
 	}).Infof("Obtained ranges for partition for PullQRep")
 
-	// Build the query to pull records within the range from the source table
-	// Be sure to order the results by the watermark column to ensure consistency across pulls
-	query, err := BuildQuery(config.Query, config.FlowJobName)
+	query, rangeArgs, err := buildPartitionQuery(config, partition)
 	if err != nil {
 		return nil, err
 	}
 
 	executor := NewQRepQueryExecutorSnapshot(c.pool, c.ctx, c.config.TransactionSnapshot,
 		config.FlowJobName, partition.PartitionId)
-	records, err := executor.ExecuteAndProcessQuery(query,
-		rangeStart, rangeEnd)
+	records, err := executor.ExecuteAndProcessQuery(query, rangeArgs...)
 	if err != nil {
 		return nil, err
 	}
@@ -361,6 +444,34 @@ func (c *PostgresConnector) PullQRepRecordStream(
 		"partition": partition.PartitionId,
 	}).Infof("Obtained ranges for partition for PullQRepStream")
 
+	query, rangeArgs, err := buildPartitionQuery(config, partition)
+	if err != nil {
+		return 0, err
+	}
+
+	executor := NewQRepQueryExecutorSnapshot(c.pool, c.ctx, c.config.TransactionSnapshot,
+		config.FlowJobName, partition.PartitionId)
+	numRecords, err := executor.ExecuteAndProcessQueryStream(stream, query, rangeArgs...)
+	if err != nil {
+		return 0, err
+	}
+
+	totalRecordsAtSource, err := c.getApproxTableCounts([]string{config.WatermarkTable})
+	if err != nil {
+		return 0, err
+	}
+	metrics.LogQRepPullMetrics(c.ctx, config.FlowJobName, numRecords, totalRecordsAtSource)
+	log.WithFields(log.Fields{
+		"partition": partition.PartitionId,
+	}).Infof("pulled %d records for flow job %s", numRecords, config.FlowJobName)
+	return numRecords, nil
+}
+
+// buildPartitionQuery templates the query of the config with the range of the partition
+// and returns it along with the bounds of the range as args.
+func buildPartitionQuery(config *protos.QRepConfig,
+	partition *protos.QRepPartition,
+) (string, []interface{}, error) {
 	var rangeStart interface{}
 	var rangeEnd interface{}
 
@@ -372,6 +483,9 @@ func (c *PostgresConnector) PullQRepRecordStream(
 	case *protos.PartitionRange_TimestampRange:
 		rangeStart = x.TimestampRange.Start.AsTime()
 		rangeEnd = x.TimestampRange.End.AsTime()
+	case *protos.PartitionRange_StringRange:
+		rangeStart = x.StringRange.Start
+		rangeEnd = x.StringRange.End
 	case *protos.PartitionRange_TidRange:
 		rangeStart = pgtype.TID{
 			BlockNumber:  x.TidRange.Start.BlockNumber,
@@ -383,33 +497,29 @@ func (c *PostgresConnector) PullQRepRecordStream(
 			OffsetNumber: uint16(x.TidRange.End.OffsetNumber),
 			Valid:        true,
 		}
+	case *protos.PartitionRange_TupleRange:
+		columns := utils.QuoteColumns(utils.WatermarkColumns(config.WatermarkColumn))
+		if len(columns) != len(x.TupleRange.Start) || len(columns) != len(x.TupleRange.End) {
+			return "", nil, fmt.Errorf("range of partition doesn't match the %d watermark columns", len(columns))
+		}
+		query, err := BuildTupleQuery(config.Query, config.FlowJobName, columns)
+		if err != nil {
+			return "", nil, err
+		}
+		rangeArgs := utils.FromPartitionValues(x.TupleRange.Start)
+		rangeArgs = append(rangeArgs, utils.FromPartitionValues(x.TupleRange.End)...)
+		return query, rangeArgs, nil
 	default:
-		return 0, fmt.Errorf("unknown range type: %v", x)
+		return "", nil, fmt.Errorf("unknown range type: %v", x)
 	}
 
 	// Build the query to pull records within the range from the source table
 	// Be sure to order the results by the watermark column to ensure consistency across pulls
 	query, err := BuildQuery(config.Query, config.FlowJobName)
 	if err != nil {
-		return 0, err
-	}
-
-	executor := NewQRepQueryExecutorSnapshot(c.pool, c.ctx, c.config.TransactionSnapshot,
-		config.FlowJobName, partition.PartitionId)
-	numRecords, err := executor.ExecuteAndProcessQueryStream(stream, query, rangeStart, rangeEnd)
-	if err != nil {
-		return 0, err
-	}
-
-	totalRecordsAtSource, err := c.getApproxTableCounts([]string{config.WatermarkTable})
-	if err != nil {
-		return 0, err
+		return "", nil, err
 	}
-	metrics.LogQRepPullMetrics(c.ctx, config.FlowJobName, numRecords, totalRecordsAtSource)
-	log.WithFields(log.Fields{
-		"partition": partition.PartitionId,
-	}).Infof("pulled %d records for flow job %s", numRecords, config.FlowJobName)
-	return numRecords, nil
+	return query, []interface{}{rangeStart, rangeEnd}, nil
 }
 
 func (c *PostgresConnector) SyncQRepRecords(
@@ -509,6 +619,45 @@ func BuildQuery(query string, flowJobName string) (string, error) {
 	return res, nil
 }
 
+// BuildTupleQuery templates a query over a composite watermark, {{.start}} and {{.end}} are the bounds of
+// the range as row values and {{.range}} is the condition of the range, e.g. for (updated_at, id):
+//
+//	("updated_at", "id") BETWEEN ($1, $2) AND ($3, $4)
+func BuildTupleQuery(query string, flowJobName string, quotedColumns []string) (string, error) {
+	tmpl, err := template.New("query").Parse(query)
+	if err != nil {
+		return "", err
+	}
+
+	startParams := make([]string, 0, len(quotedColumns))
+	endParams := make([]string, 0, len(quotedColumns))
+	for i := range quotedColumns {
+		startParams = append(startParams, fmt.Sprintf("$%d", i+1))
+		endParams = append(endParams, fmt.Sprintf("$%d", len(quotedColumns)+i+1))
+	}
+	start := "(" + strings.Join(startParams, ", ") + ")"
+	end := "(" + strings.Join(endParams, ", ") + ")"
+
+	data := map[string]interface{}{
+		"start": start,
+		"end":   end,
+		"range": fmt.Sprintf("(%s) BETWEEN %s AND %s", strings.Join(quotedColumns, ", "), start, end),
+	}
+
+	buf := new(bytes.Buffer)
+
+	err = tmpl.Execute(buf, data)
+	if err != nil {
+		return "", err
+	}
+	res := buf.String()
+
+	log.WithFields(log.Fields{
+		"flowName": flowJobName,
+	}).Infof("templated query: %s", res)
+	return res, nil
+}
+
 func (c *PostgresConnector) ConsolidateQRepPartitions(config *protos.QRepConfig) error {
 	log.Infof("Consolidating partitions for flow job %s", config.FlowJobName)
 	log.Infof("This is a no-op for Postgres")
@@ -578,6 +727,82 @@ func (c *PostgresConnector) getTimePartitions(
 	return partitions, nil
 }
 
+// getUUIDPartitions splits the range of uuids evenly, uuids are assumed to be uniformly distributed.
+func (c *PostgresConnector) getUUIDPartitions(
+	start uuid.UUID, end uuid.UUID, numPartitions int) ([]*protos.QRepPartition, error) {
+	partitionHelper := utils.NewPartitionHelper()
+	for _, uuidRange := range utils.SplitUUIDRange(start, end, numPartitions) {
+		err := partitionHelper.AddPartition(uuidRange[0].String(), uuidRange[1].String())
+		if err != nil {
+			return nil, fmt.Errorf("failed to add partition: %w", err)
+		}
+	}
+	return partitionHelper.GetPartitions(), nil
+}
+
+// getTextPartitions splits the range of a text column at its quantiles, estimated from a sample of the rows.
+// Each partition starts at the first value after the end of the previous one, in the order of the column.
+func (c *PostgresConnector) getTextPartitions(
+	tx pgx.Tx,
+	config *protos.QRepConfig,
+	start string,
+	end string,
+	numPartitions int,
+) ([]*protos.QRepPartition, error) {
+	quotedWatermarkColumn := fmt.Sprintf("\"%s\"", config.WatermarkColumn)
+
+	var estimatedRows float64
+	err := tx.QueryRow(c.ctx, "SELECT reltuples FROM pg_class WHERE oid = $1::regclass",
+		config.WatermarkTable).Scan(&estimatedRows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get estimated row count: %w", err)
+	}
+	sampleClause := ""
+	if estimatedRows > textPartitionSampleRows {
+		sampleClause = fmt.Sprintf("TABLESAMPLE SYSTEM (%f)", 100*textPartitionSampleRows/estimatedRows)
+	}
+
+	fractions := make([]float64, 0, numPartitions)
+	for i := 1; i < numPartitions; i++ {
+		fractions = append(fractions, float64(i)/float64(numPartitions))
+	}
+	quantilesQuery := fmt.Sprintf(
+		"SELECT percentile_disc($1::float8[]) WITHIN GROUP (ORDER BY %[1]s) FROM %[2]s %[3]s "+
+			"WHERE %[1]s BETWEEN $2 AND $3",
+		quotedWatermarkColumn, config.WatermarkTable, sampleClause)
+	log.Infof("[text_partitions] quantiles query: %s", quantilesQuery)
+	var quantiles []string
+	if err := tx.QueryRow(c.ctx, quantilesQuery, fractions, start, end).Scan(&quantiles); err != nil {
+		return nil, fmt.Errorf("failed to query for quantiles: %w", err)
+	}
+
+	nextQuery := fmt.Sprintf("SELECT MIN(%[1]s) FROM %[2]s WHERE %[1]s > $1",
+		quotedWatermarkColumn, config.WatermarkTable)
+	partitionHelper := utils.NewPartitionHelper()
+	partitionStart := &start
+	for i, quantile := range quantiles {
+		if i > 0 && quantile == quantiles[i-1] {
+			continue
+		}
+		if err := partitionHelper.AddPartition(*partitionStart, quantile); err != nil {
+			return nil, fmt.Errorf("failed to add partition: %w", err)
+		}
+		if err := tx.QueryRow(c.ctx, nextQuery, quantile).Scan(&partitionStart); err != nil {
+			return nil, fmt.Errorf("failed to query for start of partition: %w", err)
+		}
+		if partitionStart == nil {
+			break
+		}
+	}
+	if partitionStart != nil {
+		if err := partitionHelper.AddPartition(*partitionStart, end); err != nil {
+			return nil, fmt.Errorf("failed to add partition: %w", err)
+		}
+	}
+
+	return partitionHelper.GetPartitions(), nil
+}
+
 func (c *PostgresConnector) getIntPartitions(
 	start int64, end int64, batchSizeInt uint32) ([]*protos.QRepPartition, error) {
 	var partitions []*protos.QRepPartition
@@ -45,3 +45,17 @@ func TestBuildQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildTupleQuery(t *testing.T) {
+	query := "SELECT * FROM table WHERE {{.range}} OR (updated_at, id) BETWEEN {{.start}} AND {{.end}}"
+	expected := "SELECT * FROM table WHERE (\"updated_at\", \"id\") BETWEEN ($1, $2) AND ($3, $4)" +
+		" OR (updated_at, id) BETWEEN ($1, $2) AND ($3, $4)"
+
+	actual, err := BuildTupleQuery(query, "test_flow", []string{"\"updated_at\"", "\"id\""})
+	if err != nil {
+		t.Fatalf("Error returned by BuildTupleQuery: %v", err)
+	}
+	if actual != expected {
+		t.Fatalf("Expected query %q, got %q", expected, actual)
+	}
+}
@@ -0,0 +1,101 @@
+package connpostgres
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	utils "github.com/PeerDB-io/peer-flow/connectors/utils/partition"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// postgres accepts up to 65535 params per statement.
+const maxParamsPerStatement = 65535
+
+// PullQRepKeys streams the upsert key columns of the rows of the destination table,
+// rows that are already soft deleted are skipped.
+func (c *PostgresConnector) PullQRepKeys(config *protos.QRepConfig, stream *model.QRecordStream) (int, error) {
+	query, err := buildPullKeysQuery(config)
+	if err != nil {
+		stream.SchemaChan() <- &model.QRecordSchemaOrError{Err: err}
+		close(stream.Records)
+		return 0, err
+	}
+
+	executor := NewQRepQueryExecutor(c.pool, c.ctx, config.FlowJobName, "reconcile")
+	return executor.ExecuteAndProcessQueryStream(stream, query)
+}
+
+func buildPullKeysQuery(config *protos.QRepConfig) (string, error) {
+	dstTable, err := parseSchemaTable(config.DestinationTableIdentifier)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse destination table: %w", err)
+	}
+	if config.WriteMode == nil || len(config.WriteMode.UpsertKeyColumns) == 0 {
+		return "", fmt.Errorf("upsert key columns are required to reconcile deletes")
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s",
+		strings.Join(utils.QuoteColumns(config.WriteMode.UpsertKeyColumns), ", "), dstTable.String())
+	if config.DeleteMode == protos.QRepDeleteMode_QREP_DELETE_MODE_SOFT {
+		query += fmt.Sprintf(" WHERE \"%s\" IS NOT TRUE", config.SoftDeleteColumn)
+	}
+	return query, nil
+}
+
+// DeleteQRepKeys deletes, or soft deletes, the rows of the destination table with the given keys.
+func (c *PostgresConnector) DeleteQRepKeys(config *protos.QRepConfig, keys []*model.QRecord) (int64, error) {
+	dstTable, err := parseSchemaTable(config.DestinationTableIdentifier)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse destination table: %w", err)
+	}
+	keyColumns := strings.Join(utils.QuoteColumns(config.WriteMode.UpsertKeyColumns), ", ")
+	numColumns := len(config.WriteMode.UpsertKeyColumns)
+
+	var totalRowsDeleted int64
+	keysPerStatement := maxParamsPerStatement / numColumns
+	for start := 0; start < len(keys); start += keysPerStatement {
+		end := start + keysPerStatement
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		tuples := make([]string, 0, end-start)
+		args := make([]interface{}, 0, (end-start)*numColumns)
+		for _, key := range keys[start:end] {
+			params := make([]string, 0, numColumns)
+			for _, value := range key.Entries {
+				arg := value.Value
+				// numerics are passed as text, a denominator of 10^n has at least n bits so no digits are lost.
+				if rat, ok := arg.(*big.Rat); ok {
+					arg = rat.FloatString(int(rat.Denom().BitLen()))
+				}
+				args = append(args, arg)
+				params = append(params, fmt.Sprintf("$%d", len(args)))
+			}
+			tuples = append(tuples, "("+strings.Join(params, ", ")+")")
+		}
+
+		var stmt string
+		if config.DeleteMode == protos.QRepDeleteMode_QREP_DELETE_MODE_SOFT {
+			stmt = fmt.Sprintf("UPDATE %s SET \"%s\" = true WHERE (%s) IN (%s)",
+				dstTable.String(), config.SoftDeleteColumn, keyColumns, strings.Join(tuples, ", "))
+		} else {
+			stmt = fmt.Sprintf("DELETE FROM %s WHERE (%s) IN (%s)",
+				dstTable.String(), keyColumns, strings.Join(tuples, ", "))
+		}
+
+		tag, err := c.pool.Exec(c.ctx, stmt, args...)
+		if err != nil {
+			return 0, fmt.Errorf("failed to delete keys from %s: %w", dstTable.String(), err)
+		}
+		totalRowsDeleted += tag.RowsAffected()
+	}
+
+	log.WithFields(log.Fields{
+		"flowName": config.FlowJobName,
+	}).Infof("deleted %d rows from %s", totalRowsDeleted, dstTable.String())
+	return totalRowsDeleted, nil
+}
@@ -0,0 +1,340 @@
+package conns3
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	avro "github.com/PeerDB-io/peer-flow/connectors/utils/avro"
+	parquet "github.com/PeerDB-io/peer-flow/connectors/utils/parquet"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+)
+
+// columns added to every change file, after the columns of the table.
+const (
+	opColumnName                    = "_peerdb_op"
+	unchangedToastColumnsColumnName = "_peerdb_unchanged_toast_columns"
+)
+
+// values of the op column.
+const (
+	opInsert = "insert"
+	opUpdate = "update"
+	opDelete = "delete"
+)
+
+// changeFile groups the records of a sync batch that go into the same file,
+// one file per destination table and commit date.
+type changeFile struct {
+	tableName string
+	date      string
+}
+
+func (c *S3Connector) InitializeTableSchema(req map[string]*protos.TableSchema) error {
+	c.tableSchemas = req
+	return nil
+}
+
+func (c *S3Connector) CreateRawTable(req *protos.CreateRawTableInput) (*protos.CreateRawTableOutput, error) {
+	bucket, prefix, err := c.mirrorPrefix(req.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithFields(log.Fields{
+		"flowName": req.FlowJobName,
+	}).Infof("change files are written under s3://%s/%s, no raw table needed", bucket, prefix)
+	return &protos.CreateRawTableOutput{
+		TableIdentifier: fmt.Sprintf("s3://%s/%s", bucket, prefix),
+	}, nil
+}
+
+func (c *S3Connector) SetupNormalizedTables(req *protos.SetupNormalizedTableBatchInput) (
+	*protos.SetupNormalizedTableBatchOutput, error) {
+	log.Infof("normalized tables are not needed for S3, changes are written as files")
+	tableExistsMapping := make(map[string]bool, len(req.TableNameSchemaMapping))
+	for tableName := range req.TableNameSchemaMapping {
+		tableExistsMapping[tableName] = false
+	}
+	return &protos.SetupNormalizedTableBatchOutput{
+		TableExistsMapping: tableExistsMapping,
+	}, nil
+}
+
+// SyncRecords writes the records of a batch as append-only change files, under
+// <mirror>/table=<table>/date=<commit date>/batch_<sync batch id>. The batch is committed
+// by advancing the mirror manifest once all files are written.
+func (c *S3Connector) SyncRecords(req *model.SyncRecordsRequest) (*model.SyncResponse, error) {
+	manifest, err := c.readManifest(req.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+	syncBatchID := manifest.LastSyncBatchID + 1
+
+	err = c.removePendingFiles(req.FlowJobName, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	// a retry of the batch writes changes without a commit time under the same date as before.
+	if manifest.PendingBatchID != syncBatchID || manifest.PendingSince.IsZero() {
+		manifest.PendingSince = time.Now().UTC()
+	}
+	changeFiles, tableNameRowsMapping, err := c.groupChangeRecords(req.Records.Records,
+		defaultCommitTime(req.Records.Records, manifest.PendingSince))
+	if err != nil {
+		return nil, err
+	}
+
+	bucket, prefix, err := c.mirrorPrefix(req.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+	extension := "avro"
+	if req.OutputFormat == protos.QRepOutputFormat_QREP_OUTPUT_FORMAT_PARQUET {
+		extension = "parquet"
+	}
+
+	// deterministic order, so retries of a batch write the same files in the same order.
+	sortedChangeFiles := maps.Keys(changeFiles)
+	sort.Slice(sortedChangeFiles, func(i, j int) bool {
+		if sortedChangeFiles[i].tableName != sortedChangeFiles[j].tableName {
+			return sortedChangeFiles[i].tableName < sortedChangeFiles[j].tableName
+		}
+		return sortedChangeFiles[i].date < sortedChangeFiles[j].date
+	})
+	keys := make([]string, 0, len(sortedChangeFiles))
+	for _, file := range sortedChangeFiles {
+		keys = append(keys, path.Join(prefix, "table="+file.tableName, "date="+file.date,
+			fmt.Sprintf("batch_%d.%s", syncBatchID, extension)))
+	}
+
+	manifest.PendingBatchID = syncBatchID
+	manifest.PendingFiles = keys
+	err = c.writeManifest(req.FlowJobName, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, file := range sortedChangeFiles {
+		numRecords, err := c.writeChangeFile(req.OutputFormat, file.tableName, changeFiles[file], bucket, keys[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to write change file %s: %w", keys[i], err)
+		}
+		log.WithFields(log.Fields{
+			"flowName": req.FlowJobName,
+		}).Infof("wrote %d records to %s", numRecords, keys[i])
+	}
+
+	manifest.LastSyncBatchID = syncBatchID
+	manifest.LastCheckpoint = req.Records.LastCheckPointID
+	manifest.PendingBatchID = 0
+	manifest.PendingFiles = nil
+	manifest.PendingSince = time.Time{}
+	err = c.writeManifest(req.FlowJobName, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.SyncResponse{
+		FirstSyncedCheckPointID: req.Records.FirstCheckPointID,
+		LastSyncedCheckPointID:  req.Records.LastCheckPointID,
+		NumRecordsSynced:        int64(len(req.Records.Records)),
+		CurrentSyncBatchID:      syncBatchID,
+		TableNameRowsMapping:    tableNameRowsMapping,
+	}, nil
+}
+
+// defaultCommitTime returns the commit time of changes that don't have one, the commit time of the
+// first change of the batch that has one, or pendingSince when none has.
+func defaultCommitTime(records []model.Record, pendingSince time.Time) time.Time {
+	for _, record := range records {
+		if commitTime := record.GetCommitTime(); !commitTime.IsZero() {
+			return commitTime.UTC()
+		}
+	}
+	return pendingSince.UTC()
+}
+
+// groupChangeRecords converts the records of a batch to rows of the change files they belong to,
+// changes without a commit time are dated defaultCommitTime.
+func (c *S3Connector) groupChangeRecords(records []model.Record, defaultCommitTime time.Time) (
+	map[changeFile][]*model.QRecord, map[string]uint32, error) {
+	changeFiles := make(map[changeFile][]*model.QRecord)
+	tableNameRowsMapping := make(map[string]uint32)
+
+	for _, record := range records {
+		var op, tableName string
+		var items model.RecordItems
+		var unchangedToastColumns map[string]bool
+		switch typedRecord := record.(type) {
+		case *model.InsertRecord:
+			op, tableName = opInsert, typedRecord.DestinationTableName
+			items, unchangedToastColumns = typedRecord.Items, typedRecord.UnchangedToastColumns
+		case *model.UpdateRecord:
+			op, tableName = opUpdate, typedRecord.DestinationTableName
+			items, unchangedToastColumns = typedRecord.NewItems, typedRecord.UnchangedToastColumns
+		case *model.DeleteRecord:
+			op, tableName = opDelete, typedRecord.DestinationTableName
+			items, unchangedToastColumns = typedRecord.Items, typedRecord.UnchangedToastColumns
+		default:
+			return nil, nil, fmt.Errorf("record type %T not supported in S3 flow connector", typedRecord)
+		}
+
+		tableSchema, ok := c.tableSchemas[tableName]
+		if !ok {
+			return nil, nil, fmt.Errorf("schema for table %s not found", tableName)
+		}
+
+		commitTime := record.GetCommitTime().UTC()
+		if commitTime.IsZero() {
+			commitTime = defaultCommitTime
+		}
+
+		columnNames := changeFileColumnNames(tableSchema)
+		entries := make([]qvalue.QValue, 0, len(columnNames)+4)
+		for _, columnName := range columnNames {
+			value, ok := items[columnName]
+			if !ok {
+				value = qvalue.QValue{Kind: qvalue.QValueKind(tableSchema.Columns[columnName]), Value: nil}
+				if columnName == tableSchema.SourceIdentifierColumn {
+					value = qvalue.QValue{Kind: qvalue.QValueKindString, Value: record.GetTableName()}
+				}
+			}
+			entries = append(entries, value)
+		}
+		entries = append(entries,
+			qvalue.QValue{Kind: qvalue.QValueKindString, Value: op},
+			qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: record.GetCheckPointID()},
+			qvalue.QValue{Kind: qvalue.QValueKindTimestampTZ, Value: commitTime},
+			qvalue.QValue{Kind: qvalue.QValueKindString, Value: utils.KeysToString(unchangedToastColumns)},
+		)
+
+		file := changeFile{tableName: tableName, date: commitTime.Format("2006-01-02")}
+		changeFiles[file] = append(changeFiles[file], &model.QRecord{
+			NumEntries: len(entries),
+			Entries:    entries,
+		})
+		tableNameRowsMapping[tableName] += 1
+	}
+
+	return changeFiles, tableNameRowsMapping, nil
+}
+
+// changeFileColumnNames returns the columns of a table in the order they are written.
+func changeFileColumnNames(tableSchema *protos.TableSchema) []string {
+	columnNames := maps.Keys(tableSchema.Columns)
+	if tableSchema.SourceIdentifierColumn != "" {
+		if _, ok := tableSchema.Columns[tableSchema.SourceIdentifierColumn]; !ok {
+			columnNames = append(columnNames, tableSchema.SourceIdentifierColumn)
+		}
+	}
+	sort.Strings(columnNames)
+	return columnNames
+}
+
+// changeFileSchema returns the schema of the change files of a table, every column is nullable
+// as deletes and unchanged toast columns don't carry all values.
+func changeFileSchema(tableSchema *protos.TableSchema) *model.QRecordSchema {
+	columnNames := changeFileColumnNames(tableSchema)
+	fields := make([]*model.QField, 0, len(columnNames)+4)
+	for _, columnName := range columnNames {
+		kind := qvalue.QValueKind(tableSchema.Columns[columnName])
+		if columnName == tableSchema.SourceIdentifierColumn {
+			kind = qvalue.QValueKindString
+		}
+		fields = append(fields, &model.QField{Name: columnName, Type: kind, Nullable: true})
+	}
+	fields = append(fields,
+		&model.QField{Name: opColumnName, Type: qvalue.QValueKindString, Nullable: false},
+		&model.QField{Name: utils.SourceLSNColumnName, Type: qvalue.QValueKindInt64, Nullable: false},
+		&model.QField{Name: utils.CommitTimestampColumnName, Type: qvalue.QValueKindTimestampTZ, Nullable: false},
+		&model.QField{Name: unchangedToastColumnsColumnName, Type: qvalue.QValueKindString, Nullable: false},
+	)
+	return model.NewQRecordSchema(fields)
+}
+
+func (c *S3Connector) writeChangeFile(
+	format protos.QRepOutputFormat,
+	tableName string,
+	records []*model.QRecord,
+	bucket string,
+	key string,
+) (int, error) {
+	qSchema := changeFileSchema(c.tableSchemas[tableName])
+	stream := model.NewQRecordStream(len(records))
+	err := stream.SetSchema(qSchema)
+	if err != nil {
+		return 0, fmt.Errorf("failed to set schema: %w", err)
+	}
+	for _, record := range records {
+		stream.Records <- &model.QRecordOrError{Record: record}
+	}
+	close(stream.Records)
+
+	if format == protos.QRepOutputFormat_QREP_OUTPUT_FORMAT_PARQUET {
+		compression, err := parquet.GetParquetCompression("")
+		if err != nil {
+			return 0, err
+		}
+		writer := parquet.NewPeerDBParquetWriter(c.ctx, stream, compression, parquet.DefaultRowGroupSize)
+		return writer.WriteRecordsToS3(bucket, key, c.connConfig)
+	}
+
+	avroSchema, err := getAvroSchema(tableName, qSchema)
+	if err != nil {
+		return 0, err
+	}
+	writer := avro.NewPeerDBOCFWriter(c.ctx, stream, avroSchema, avro.CompressNone)
+	return writer.WriteRecordsToS3(bucket, key, c.connConfig)
+}
+
+func (c *S3Connector) NormalizeRecords(req *model.NormalizeRecordsRequest) (*model.NormalizeResponse, error) {
+	// change files are the final output, nothing to normalize.
+	syncBatchID, err := c.GetLastSyncBatchID(req.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.NormalizeResponse{
+		Done:         true,
+		StartBatchID: syncBatchID,
+		EndBatchID:   syncBatchID,
+	}, nil
+}
+
+// SyncFlowCleanup removes the manifest so the mirror can be recreated, along with the files of a
+// batch that was never committed. Committed change files are the output of the mirror, like the
+// normalized tables of other destinations, and are kept.
+func (c *S3Connector) SyncFlowCleanup(jobName string) error {
+	bucket, prefix, err := c.mirrorPrefix(jobName)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := c.readManifest(jobName)
+	if err != nil {
+		return err
+	}
+	err = c.removePendingFiles(jobName, manifest)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.client.DeleteObjectWithContext(c.ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(path.Join(prefix, manifestFileName)),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete manifest: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package conns3
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupChangeRecords(t *testing.T) {
+	c := &S3Connector{
+		tableSchemas: map[string]*protos.TableSchema{
+			"public.orders": {
+				TableIdentifier: "public.orders",
+				Columns: map[string]string{
+					"id":     string(qvalue.QValueKindInt64),
+					"amount": string(qvalue.QValueKindFloat64),
+				},
+				PrimaryKeyColumn: "id",
+			},
+		},
+	}
+
+	day1 := time.Date(2023, 7, 1, 23, 59, 0, 0, time.UTC)
+	day2 := time.Date(2023, 7, 2, 0, 1, 0, 0, time.UTC)
+	records := []model.Record{
+		&model.InsertRecord{
+			DestinationTableName: "public.orders",
+			CheckPointID:         10,
+			CommitTime:           day1,
+			Items: model.RecordItems{
+				"id":     qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(1)},
+				"amount": qvalue.QValue{Kind: qvalue.QValueKindFloat64, Value: 1.5},
+			},
+		},
+		&model.UpdateRecord{
+			DestinationTableName: "public.orders",
+			CheckPointID:         11,
+			CommitTime:           day2,
+			NewItems: model.RecordItems{
+				"id": qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(1)},
+			},
+			UnchangedToastColumns: map[string]bool{"amount": true},
+		},
+		&model.DeleteRecord{
+			DestinationTableName: "public.orders",
+			CheckPointID:         12,
+			CommitTime:           day2,
+			Items: model.RecordItems{
+				"id": qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(1)},
+			},
+		},
+	}
+
+	changeFiles, tableNameRowsMapping, err := c.groupChangeRecords(records, day1)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint32{"public.orders": 3}, tableNameRowsMapping)
+	require.Len(t, changeFiles, 2)
+	require.Len(t, changeFiles[changeFile{tableName: "public.orders", date: "2023-07-01"}], 1)
+
+	day2Records := changeFiles[changeFile{tableName: "public.orders", date: "2023-07-02"}]
+	require.Len(t, day2Records, 2)
+
+	// amount, id, then _peerdb_op, _peerdb_source_lsn, _peerdb_commit_ts, _peerdb_unchanged_toast_columns
+	update := day2Records[0].Entries
+	require.Len(t, update, 6)
+	require.Nil(t, update[0].Value)
+	require.Equal(t, int64(1), update[1].Value)
+	require.Equal(t, opUpdate, update[2].Value)
+	require.Equal(t, int64(11), update[3].Value)
+	require.Equal(t, day2, update[4].Value)
+	require.Equal(t, "amount", update[5].Value)
+
+	require.Equal(t, opDelete, day2Records[1].Entries[2].Value)
+}
+
+func TestDefaultCommitTime(t *testing.T) {
+	pendingSince := time.Date(2023, 7, 3, 10, 0, 0, 0, time.UTC)
+	commitTime := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	// the first commit time of the batch, so a retry dates the changes the same way.
+	records := []model.Record{
+		&model.InsertRecord{DestinationTableName: "public.orders"},
+		&model.InsertRecord{DestinationTableName: "public.orders", CommitTime: commitTime},
+	}
+	require.Equal(t, commitTime, defaultCommitTime(records, pendingSince))
+
+	// no change has a commit time, the batch is dated when it was first attempted.
+	require.Equal(t, pendingSince, defaultCommitTime(records[:1], pendingSince))
+}
@@ -0,0 +1,67 @@
+package conns3
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+)
+
+// csvReader reads the rows of a CSV file with a header row as QRecords.
+// Every column is read as a nullable string, empty fields are read as nulls.
+type csvReader struct {
+	reader *csv.Reader
+	schema *model.QRecordSchema
+}
+
+func newCSVReader(r io.Reader) (*csvReader, error) {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read header of csv file: %w", err)
+	}
+
+	fields := make([]*model.QField, 0, len(header))
+	for _, name := range header {
+		fields = append(fields, &model.QField{
+			Name:     name,
+			Type:     qvalue.QValueKindString,
+			Nullable: true,
+		})
+	}
+
+	return &csvReader{
+		reader: reader,
+		schema: model.NewQRecordSchema(fields),
+	}, nil
+}
+
+func (r *csvReader) Schema() *model.QRecordSchema {
+	return r.schema
+}
+
+func (r *csvReader) Next() (*model.QRecord, error) {
+	row, err := r.reader.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read csv row: %w", err)
+	}
+
+	entries := make([]qvalue.QValue, 0, len(row))
+	for _, field := range row {
+		var value interface{}
+		if field != "" {
+			value = field
+		}
+		entries = append(entries, qvalue.QValue{Kind: qvalue.QValueKindString, Value: value})
+	}
+	return &model.QRecord{
+		NumEntries: len(entries),
+		Entries:    entries,
+	}, nil
+}
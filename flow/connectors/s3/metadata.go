@@ -0,0 +1,173 @@
+package conns3
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	log "github.com/sirupsen/logrus"
+)
+
+// name of the manifest object, stored next to the change files of a mirror.
+const manifestFileName = "_peerdb_manifest.json"
+
+// cdcManifest tracks the sync progress of a CDC mirror. Change files of a batch are committed
+// once LastSyncBatchID reaches the batch, readers should ignore files of later batches.
+// The files of the batch being written are listed in PendingFiles, so that a retry of a
+// failed batch can remove them before writing the batch again. PendingSince is when the batch
+// was first attempted, retries reuse it for changes without a commit time.
+type cdcManifest struct {
+	LastSyncBatchID int64     `json:"lastSyncBatchId"`
+	LastCheckpoint  int64     `json:"lastCheckpoint"`
+	PendingBatchID  int64     `json:"pendingBatchId,omitempty"`
+	PendingFiles    []string  `json:"pendingFiles,omitempty"`
+	PendingSince    time.Time `json:"pendingSince,omitempty"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+// mirrorPrefix returns the bucket and the prefix all files of a mirror are written under.
+func (c *S3Connector) mirrorPrefix(jobName string) (string, string, error) {
+	s3o, err := utils.NewS3BucketAndPrefix(c.url)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to parse bucket path: %w", err)
+	}
+	return s3o.Bucket, path.Join(s3o.Prefix, jobName), nil
+}
+
+// getJSONObject reads a JSON object of the bucket into v, it returns false if the object doesn't exist.
+func (c *S3Connector) getJSONObject(bucket string, key string, v interface{}) (bool, error) {
+	out, err := c.client.GetObjectWithContext(c.ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var awsErr awserr.Error
+		if errors.As(err, &awsErr) && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get %s: %w", key, err)
+	}
+	defer out.Body.Close()
+
+	content, err := io.ReadAll(out.Body)
+	if err != nil {
+		return false, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+
+	err = json.Unmarshal(content, v)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", key, err)
+	}
+	return true, nil
+}
+
+func (c *S3Connector) putJSONObject(bucket string, key string, v interface{}) error {
+	content, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to serialize %s: %w", key, err)
+	}
+
+	_, err = c.client.PutObjectWithContext(c.ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(content),
+		ContentType: aws.String("application/json"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put %s: %w", key, err)
+	}
+	return nil
+}
+
+// readManifest returns the manifest of a mirror, an empty manifest if the mirror hasn't synced yet.
+func (c *S3Connector) readManifest(jobName string) (*cdcManifest, error) {
+	bucket, prefix, err := c.mirrorPrefix(jobName)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest cdcManifest
+	_, err = c.getJSONObject(bucket, path.Join(prefix, manifestFileName), &manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get manifest: %w", err)
+	}
+	return &manifest, nil
+}
+
+func (c *S3Connector) writeManifest(jobName string, manifest *cdcManifest) error {
+	bucket, prefix, err := c.mirrorPrefix(jobName)
+	if err != nil {
+		return err
+	}
+
+	manifest.UpdatedAt = time.Now().UTC()
+	err = c.putJSONObject(bucket, path.Join(prefix, manifestFileName), manifest)
+	if err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
+
+// removePendingFiles deletes the files of a batch that failed before it was committed.
+func (c *S3Connector) removePendingFiles(jobName string, manifest *cdcManifest) error {
+	if len(manifest.PendingFiles) == 0 {
+		return nil
+	}
+
+	bucket, _, err := c.mirrorPrefix(jobName)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"flowName": jobName,
+	}).Infof("removing %d files of uncommitted batch %d", len(manifest.PendingFiles), manifest.PendingBatchID)
+	for _, key := range manifest.PendingFiles {
+		_, err := c.client.DeleteObjectWithContext(c.ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete %s: %w", key, err)
+		}
+	}
+	return nil
+}
+
+func (c *S3Connector) NeedsSetupMetadataTables() bool {
+	return false
+}
+
+func (c *S3Connector) SetupMetadataTables() error {
+	log.Infof("metadata tables are not needed for S3, sync state is kept in the mirror manifest")
+	return nil
+}
+
+func (c *S3Connector) GetLastOffset(jobName string) (*protos.LastSyncState, error) {
+	manifest, err := c.readManifest(jobName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &protos.LastSyncState{
+		Checkpoint: manifest.LastCheckpoint,
+	}, nil
+}
+
+func (c *S3Connector) GetLastSyncBatchID(jobName string) (int64, error) {
+	manifest, err := c.readManifest(jobName)
+	if err != nil {
+		return 0, err
+	}
+
+	return manifest.LastSyncBatchID, nil
+}
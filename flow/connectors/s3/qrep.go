@@ -1,27 +1,80 @@
 package conns3
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"path"
+	"sort"
+	"sync/atomic"
+	"time"
 
-	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	avro "github.com/PeerDB-io/peer-flow/connectors/utils/avro"
+	parquet "github.com/PeerDB-io/peer-flow/connectors/utils/parquet"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	log "github.com/sirupsen/logrus"
 )
 
-func (c *S3Connector) GetQRepPartitions(config *protos.QRepConfig,
-	last *protos.QRepPartition,
-) ([]*protos.QRepPartition, error) {
-	panic("not implemented for s3")
+const (
+	// manifest of the last QRep run, written once all its files are written.
+	qRepManifestFileName = "_manifest.json"
+	// files written for the partitions of the current run, until they are consolidated.
+	qRepPartitionsDir = "_peerdb_partitions"
+)
+
+// qRepFile is a file written for a partition.
+type qRepFile struct {
+	Key       string `json:"key"`
+	NumRows   int    `json:"numRows"`
+	SizeBytes int64  `json:"sizeBytes"`
+	SHA256    string `json:"sha256"`
 }
 
-func (c *S3Connector) PullQRepRecords(config *protos.QRepConfig,
-	partition *protos.QRepPartition,
-) (*model.QRecordBatch, error) {
-	panic("not implemented for s3")
+type qRepManifestColumn struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Nullable bool   `json:"nullable"`
+}
+
+// qRepPartitionFiles records the files written for a partition.
+type qRepPartitionFiles struct {
+	PartitionID string               `json:"partitionId"`
+	Schema      []qRepManifestColumn `json:"schema"`
+	Files       []qRepFile           `json:"files"`
+}
+
+type qRepManifest struct {
+	FlowJobName                string               `json:"flowJobName"`
+	DestinationTableIdentifier string               `json:"destinationTableIdentifier"`
+	Format                     string               `json:"format"`
+	Compression                string               `json:"compression,omitempty"`
+	Schema                     []qRepManifestColumn `json:"schema"`
+	Files                      []qRepFile           `json:"files"`
+	NumRows                    int64                `json:"numRows"`
+	CompletedAt                time.Time            `json:"completedAt"`
+}
+
+func getQRepManifestColumns(schema *model.QRecordSchema) []qRepManifestColumn {
+	columns := make([]qRepManifestColumn, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		columns = append(columns, qRepManifestColumn{
+			Name:     field.Name,
+			Type:     string(field.Type),
+			Nullable: field.Nullable,
+		})
+	}
+	return columns
 }
 
+// SyncQRepRecords writes a partition to <mirror>/<partition id>.<ext>, or to numbered
+// parts when a target file size is set. The written files are recorded next to them
+// until ConsolidateQRepPartitions lists them in the manifest of the run.
 func (c *S3Connector) SyncQRepRecords(
 	config *protos.QRepConfig,
 	partition *protos.QRepPartition,
@@ -36,17 +89,30 @@ func (c *S3Connector) SyncQRepRecords(
 		return 0, fmt.Errorf("failed to get schema from stream: %w", err)
 	}
 
-	dstTableName := config.DestinationTableIdentifier
-	avroSchema, err := getAvroSchema(dstTableName, schema)
+	bucket, prefix, err := c.mirrorPrefix(config.FlowJobName)
 	if err != nil {
 		return 0, err
 	}
 
-	numRecords, err := c.writeToAvroFile(stream, avroSchema, partition.PartitionId, config.FlowJobName)
+	files, err := c.writeQRepFiles(config, partition.PartitionId, stream, schema)
 	if err != nil {
 		return 0, err
 	}
 
+	partitionFiles := &qRepPartitionFiles{
+		PartitionID: partition.PartitionId,
+		Schema:      getQRepManifestColumns(schema),
+		Files:       files,
+	}
+	err = c.putJSONObject(bucket, path.Join(prefix, qRepPartitionsDir, partition.PartitionId+".json"), partitionFiles)
+	if err != nil {
+		return 0, fmt.Errorf("failed to record files of partition: %w", err)
+	}
+
+	numRecords := 0
+	for _, file := range files {
+		numRecords += file.NumRows
+	}
 	return numRecords, nil
 }
 
@@ -62,25 +128,169 @@ func getAvroSchema(
 	return avroSchema, nil
 }
 
-func (c *S3Connector) writeToAvroFile(
-	stream *model.QRecordStream,
-	avroSchema *model.QRecordAvroSchemaDefinition,
+// getQRepFileWriter returns the file extension and a function writing a stream as a file
+// in the output format of the mirror.
+func getQRepFileWriter(
+	ctx context.Context,
+	config *protos.QRepConfig,
+	schema *model.QRecordSchema,
+) (string, func(*model.QRecordStream, io.Writer) (int, error), error) {
+	if config.OutputFormat == protos.QRepOutputFormat_QREP_OUTPUT_FORMAT_PARQUET {
+		compression, err := parquet.GetParquetCompression(config.OutputCompression)
+		if err != nil {
+			return "", nil, err
+		}
+		return "parquet", func(stream *model.QRecordStream, w io.Writer) (int, error) {
+			writer := parquet.NewPeerDBParquetWriter(ctx, stream, compression, int(config.ParquetRowGroupSize))
+			return writer.WriteParquet(w)
+		}, nil
+	}
+
+	compression, err := avro.ParseAvroCompressionCodec(config.OutputCompression)
+	if err != nil {
+		return "", nil, err
+	}
+	avroSchema, err := getAvroSchema(config.DestinationTableIdentifier, schema)
+	if err != nil {
+		return "", nil, err
+	}
+	return compression.FileExtension(), func(stream *model.QRecordStream, w io.Writer) (int, error) {
+		writer := avro.NewPeerDBOCFWriter(ctx, stream, avroSchema, compression)
+		return writer.WriteOCF(w)
+	}, nil
+}
+
+// writeQRepFiles writes the records of a partition, rolling over to a new part once the
+// target file size is reached. Parts can exceed the target by an Avro block or a Parquet row group.
+func (c *S3Connector) writeQRepFiles(
+	config *protos.QRepConfig,
 	partitionID string,
-	jobName string,
-) (int, error) {
-	s3o, err := utils.NewS3BucketAndPrefix(c.url)
+	stream *model.QRecordStream,
+	schema *model.QRecordSchema,
+) ([]qRepFile, error) {
+	bucket, prefix, err := c.mirrorPrefix(config.FlowJobName)
+	if err != nil {
+		return nil, err
+	}
+	extension, writeFile, err := getQRepFileWriter(c.ctx, config, schema)
 	if err != nil {
-		return 0, fmt.Errorf("failed to parse bucket path: %w", err)
+		return nil, err
 	}
+	targetFileSize := int64(config.TargetFileSizeBytes)
 
-	s3Key := fmt.Sprintf("%s/%s/%s.avro", s3o.Prefix, jobName, partitionID)
-	writer := avro.NewPeerDBOCFWriter(c.ctx, stream, avroSchema)
-	numRecords, err := writer.WriteRecordsToS3(s3o.Bucket, s3Key)
+	files := make([]qRepFile, 0, 1)
+	// first record of the next part, read ahead to not write an empty part.
+	var next *model.QRecordOrError
+	for part := 0; ; part++ {
+		key := path.Join(prefix, fmt.Sprintf("%s.%s", partitionID, extension))
+		if targetFileSize > 0 {
+			key = path.Join(prefix, fmt.Sprintf("%s_%d.%s", partitionID, part, extension))
+		}
+
+		partStream := model.NewQRecordStream(16)
+		err = partStream.SetSchema(schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set schema: %w", err)
+		}
+
+		var size atomic.Int64
+		exhausted := make(chan bool, 1)
+		go func(first *model.QRecordOrError) {
+			defer close(partStream.Records)
+			if first != nil {
+				partStream.Records <- first
+			}
+			for record := range stream.Records {
+				partStream.Records <- record
+				if targetFileSize > 0 && size.Load() >= targetFileSize {
+					exhausted <- false
+					return
+				}
+			}
+			exhausted <- true
+		}(next)
+
+		file, err := c.uploadQRepFile(bucket, key, &size, func(w io.Writer) (int, error) {
+			return writeFile(partStream, w)
+		})
+		if err != nil {
+			// unblock the forwarding of records, the partition is retried as a whole.
+			go func() {
+				//nolint:revive
+				for range partStream.Records {
+				}
+			}()
+			return nil, err
+		}
+		files = append(files, *file)
+		log.WithFields(log.Fields{
+			"flowName":    config.FlowJobName,
+			"partitionID": partitionID,
+		}).Infof("wrote %d records to %s", file.NumRows, key)
+
+		if <-exhausted {
+			break
+		}
+		record, ok := <-stream.Records
+		if !ok {
+			break
+		}
+		next = record
+	}
+
+	return files, nil
+}
+
+// countingWriter counts the bytes written so far, read while the file is being written.
+type countingWriter struct {
+	w    io.Writer
+	size *atomic.Int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.size.Add(int64(n))
+	return n, err
+}
+
+// uploadQRepFile streams a file to the bucket, computing its size and checksum on the way.
+func (c *S3Connector) uploadQRepFile(
+	bucket string,
+	key string,
+	size *atomic.Int64,
+	writeFile func(io.Writer) (int, error),
+) (*qRepFile, error) {
+	r, w := io.Pipe()
+	hash := sha256.New()
+	numRowsWritten := make(chan int, 1)
+	go func() {
+		numRows, err := writeFile(&countingWriter{w: io.MultiWriter(w, hash), size: size})
+		if err != nil {
+			w.CloseWithError(err)
+			return
+		}
+		numRowsWritten <- numRows
+		w.Close()
+	}()
+
+	uploader := s3manager.NewUploaderWithClient(&c.client)
+	_, err := uploader.UploadWithContext(c.ctx, &s3manager.UploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   r,
+	})
 	if err != nil {
-		return 0, fmt.Errorf("failed to write records to S3: %w", err)
+		r.CloseWithError(err)
+		return nil, fmt.Errorf("failed to upload %s: %w", key, err)
 	}
 
-	return numRecords, nil
+	numRows := <-numRowsWritten
+	return &qRepFile{
+		Key:       key,
+		NumRows:   numRows,
+		SizeBytes: size.Load(),
+		SHA256:    hex.EncodeToString(hash.Sum(nil)),
+	}, nil
 }
 
 // S3 just sets up destination, not metadata tables
@@ -89,8 +299,77 @@ func (c *S3Connector) SetupQRepMetadataTables(config *protos.QRepConfig) error {
 	return nil
 }
 
+// ConsolidateQRepPartitions writes the manifest of the run, listing the files written for
+// its partitions. The manifest is written last, loaders can wait for it to pick up a run.
 func (c *S3Connector) ConsolidateQRepPartitions(config *protos.QRepConfig) error {
-	log.Infof("Consolidate partitions not needed for S3.")
+	bucket, prefix, err := c.mirrorPrefix(config.FlowJobName)
+	if err != nil {
+		return err
+	}
+
+	partitionKeys := make([]string, 0)
+	err = c.client.ListObjectsV2PagesWithContext(c.ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(path.Join(prefix, qRepPartitionsDir) + "/"),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			partitionKeys = append(partitionKeys, *object.Key)
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list partitions of run: %w", err)
+	}
+
+	format := "avro"
+	if config.OutputFormat == protos.QRepOutputFormat_QREP_OUTPUT_FORMAT_PARQUET {
+		format = "parquet"
+	}
+	manifest := &qRepManifest{
+		FlowJobName:                config.FlowJobName,
+		DestinationTableIdentifier: config.DestinationTableIdentifier,
+		Format:                     format,
+		Compression:                config.OutputCompression,
+		Files:                      make([]qRepFile, 0),
+	}
+	for _, key := range partitionKeys {
+		var partitionFiles qRepPartitionFiles
+		_, err = c.getJSONObject(bucket, key, &partitionFiles)
+		if err != nil {
+			return err
+		}
+		if manifest.Schema == nil {
+			manifest.Schema = partitionFiles.Schema
+		}
+		for _, file := range partitionFiles.Files {
+			manifest.Files = append(manifest.Files, file)
+			manifest.NumRows += int64(file.NumRows)
+		}
+	}
+	sort.Slice(manifest.Files, func(i, j int) bool {
+		return manifest.Files[i].Key < manifest.Files[j].Key
+	})
+	manifest.CompletedAt = time.Now().UTC()
+
+	err = c.putJSONObject(bucket, path.Join(prefix, qRepManifestFileName), manifest)
+	if err != nil {
+		return fmt.Errorf("failed to write manifest of run: %w", err)
+	}
+
+	// the next run starts with a clean slate.
+	for _, key := range partitionKeys {
+		_, err = c.client.DeleteObjectWithContext(c.ctx, &s3.DeleteObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete %s: %w", key, err)
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"flowName": config.FlowJobName,
+	}).Infof("wrote manifest of %d files and %d rows", len(manifest.Files), manifest.NumRows)
 	return nil
 }
 
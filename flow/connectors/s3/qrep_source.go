@@ -0,0 +1,371 @@
+package conns3
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	avro "github.com/PeerDB-io/peer-flow/connectors/utils/avro"
+	parquet "github.com/PeerDB-io/peer-flow/connectors/utils/parquet"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/google/uuid"
+	"github.com/klauspost/compress/zstd"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sourceFormat is the format of an object read by a QRep mirror, from the extension of its key.
+type sourceFormat int
+
+const (
+	sourceFormatUnknown sourceFormat = iota
+	sourceFormatAvro
+	sourceFormatParquet
+	sourceFormatCSV
+)
+
+// qRecordReader reads the records of an object, Next returns io.EOF once all records are read.
+type qRecordReader interface {
+	Schema() *model.QRecordSchema
+	Next() (*model.QRecord, error)
+}
+
+// parseSourceObjectKey returns the format of an object and the compression applied to the whole object,
+// gzip (.gz) or zstd (.zst). Objects whose name starts with _ or . are markers and manifests, not data.
+func parseSourceObjectKey(key string) (sourceFormat, string) {
+	name := path.Base(key)
+	if strings.HasPrefix(name, "_") || strings.HasPrefix(name, ".") {
+		return sourceFormatUnknown, ""
+	}
+
+	compression := ""
+	if strings.HasSuffix(name, ".gz") {
+		compression = "gzip"
+		name = strings.TrimSuffix(name, ".gz")
+	} else if strings.HasSuffix(name, ".zst") {
+		compression = "zstd"
+		name = strings.TrimSuffix(name, ".zst")
+	}
+
+	switch path.Ext(name) {
+	case ".avro":
+		return sourceFormatAvro, compression
+	case ".csv":
+		return sourceFormatCSV, compression
+	case ".parquet":
+		// parquet compresses its pages, compressed parquet files aren't read.
+		if compression == "" {
+			return sourceFormatParquet, ""
+		}
+	}
+	return sourceFormatUnknown, ""
+}
+
+// sourcePrefix returns the bucket and the prefix objects are read from, the watermark table of the mirror
+// is the prefix under the path of the peer.
+func (c *S3Connector) sourcePrefix(config *protos.QRepConfig) (string, string, error) {
+	bucket, prefix, err := c.mirrorPrefix(config.WatermarkTable)
+	if err != nil {
+		return "", "", err
+	}
+	if prefix != "" {
+		prefix += "/"
+	}
+	return bucket, prefix, nil
+}
+
+// GetQRepPartitions returns partitions of the objects modified since the last partition.
+// Objects are ordered by their last modified time, which serves as the watermark of the mirror.
+func (c *S3Connector) GetQRepPartitions(config *protos.QRepConfig,
+	last *protos.QRepPartition,
+) ([]*protos.QRepPartition, error) {
+	bucket, prefix, err := c.sourcePrefix(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastRange *protos.ObjectPartitionRange
+	if last != nil && last.Range != nil {
+		lastRange = last.Range.GetObjectRange()
+	}
+
+	objects := make([]*s3.Object, 0)
+	err = c.client.ListObjectsV2PagesWithContext(c.ctx, &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			if format, _ := parseSourceObjectKey(*object.Key); format == sourceFormatUnknown {
+				continue
+			}
+			if isObjectBeforeWatermark(object, lastRange) {
+				continue
+			}
+			objects = append(objects, object)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list objects under %s: %w", prefix, err)
+	}
+
+	partitions := groupObjectPartitions(objects, config.NumObjectsPerPartition)
+	log.WithFields(log.Fields{
+		"flowName": config.FlowJobName,
+	}).Infof("found %d new objects under s3://%s/%s in %d partitions", len(objects), bucket, prefix, len(partitions))
+	return partitions, nil
+}
+
+// isObjectBeforeWatermark returns whether an object was read by earlier partitions. Objects modified at the
+// watermark are all in the last partition, those that were not listed then are read by the next partitions.
+func isObjectBeforeWatermark(object *s3.Object, lastRange *protos.ObjectPartitionRange) bool {
+	if lastRange == nil || lastRange.LastModified == nil {
+		return false
+	}
+
+	watermark := lastRange.LastModified.AsTime()
+	if object.LastModified.Before(watermark) {
+		return true
+	}
+	if object.LastModified.Equal(watermark) {
+		for _, key := range lastRange.Keys {
+			if key == *object.Key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// groupObjectPartitions groups objects into partitions of up to numObjectsPerPartition objects in the order
+// they were modified. Objects modified at the same time are kept in the same partition, so that the last
+// partition holds all the objects modified at the watermark.
+func groupObjectPartitions(objects []*s3.Object, numObjectsPerPartition uint32) []*protos.QRepPartition {
+	sort.Slice(objects, func(i, j int) bool {
+		if !objects[i].LastModified.Equal(*objects[j].LastModified) {
+			return objects[i].LastModified.Before(*objects[j].LastModified)
+		}
+		return *objects[i].Key < *objects[j].Key
+	})
+	if numObjectsPerPartition == 0 {
+		numObjectsPerPartition = 1
+	}
+
+	partitions := make([]*protos.QRepPartition, 0)
+	var keys []string
+	for i, object := range objects {
+		keys = append(keys, *object.Key)
+
+		isLast := i == len(objects)-1
+		if isLast || (len(keys) >= int(numObjectsPerPartition) &&
+			!objects[i+1].LastModified.Equal(*object.LastModified)) {
+			partitions = append(partitions, &protos.QRepPartition{
+				PartitionId: uuid.New().String(),
+				Range: &protos.PartitionRange{
+					Range: &protos.PartitionRange_ObjectRange{
+						ObjectRange: &protos.ObjectPartitionRange{
+							Keys:         keys,
+							LastModified: timestamppb.New(*object.LastModified),
+						},
+					},
+				},
+			})
+			keys = nil
+		}
+	}
+	return partitions
+}
+
+// PullQRepRecords reads the objects of a partition into a batch, see PullQRepRecordStream.
+func (c *S3Connector) PullQRepRecords(config *protos.QRepConfig,
+	partition *protos.QRepPartition,
+) (*model.QRecordBatch, error) {
+	return model.NewQRecordBatchFromStream(1024, func(stream *model.QRecordStream) error {
+		_, err := c.PullQRepRecordStream(config, partition, stream)
+		return err
+	})
+}
+
+// PullQRepRecordStream reads the objects of a partition into the stream. All objects of a partition must have
+// the same columns, the schema of the stream is the schema of the first object.
+func (c *S3Connector) PullQRepRecordStream(
+	config *protos.QRepConfig,
+	partition *protos.QRepPartition,
+	stream *model.QRecordStream,
+) (int, error) {
+	defer close(stream.Records)
+
+	numRecords, err := c.pullObjects(config, partition, stream)
+	if err != nil {
+		if !stream.IsSchemaSet() {
+			stream.SchemaChan() <- &model.QRecordSchemaOrError{Err: err}
+		} else {
+			stream.Records <- &model.QRecordOrError{Err: err}
+		}
+		log.WithFields(log.Fields{
+			"flowName":    config.FlowJobName,
+			"partitionID": partition.PartitionId,
+		}).Errorf("failed to pull records: %v", err)
+		return 0, err
+	}
+
+	log.WithFields(log.Fields{
+		"flowName":    config.FlowJobName,
+		"partitionID": partition.PartitionId,
+	}).Infof("pulled %d records", numRecords)
+	return numRecords, nil
+}
+
+func (c *S3Connector) pullObjects(
+	config *protos.QRepConfig,
+	partition *protos.QRepPartition,
+	stream *model.QRecordStream,
+) (int, error) {
+	if partition.Range == nil || partition.Range.GetObjectRange() == nil {
+		return 0, fmt.Errorf("partition %s is not a range of objects", partition.PartitionId)
+	}
+	bucket, _, err := c.sourcePrefix(config)
+	if err != nil {
+		return 0, err
+	}
+
+	numRecords := 0
+	var schema *model.QRecordSchema
+	for _, key := range partition.Range.GetObjectRange().Keys {
+		n, err := c.pullObject(bucket, key, stream, &schema)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read s3://%s/%s: %w", bucket, key, err)
+		}
+		numRecords += n
+	}
+	return numRecords, nil
+}
+
+// pullObject reads the records of an object into the stream. The schema of the first object
+// of a partition is set as the schema of the stream, later objects must have the same columns.
+func (c *S3Connector) pullObject(
+	bucket string,
+	key string,
+	stream *model.QRecordStream,
+	schema **model.QRecordSchema,
+) (int, error) {
+	format, compression := parseSourceObjectKey(key)
+
+	var reader qRecordReader
+	switch format {
+	case sourceFormatParquet:
+		// parquet is read from the end of the file, the object is downloaded before it is read.
+		file, err := c.downloadObject(bucket, key)
+		if err != nil {
+			return 0, err
+		}
+		defer func() {
+			file.Close()
+			os.Remove(file.Name())
+		}()
+
+		parquetReader, err := parquet.NewPeerDBParquetReader(c.ctx, file)
+		if err != nil {
+			return 0, err
+		}
+		defer parquetReader.Close()
+		reader = parquetReader
+	case sourceFormatAvro, sourceFormatCSV:
+		out, err := c.client.GetObjectWithContext(c.ctx, &s3.GetObjectInput{
+			Bucket: aws.String(bucket),
+			Key:    aws.String(key),
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to get object: %w", err)
+		}
+		defer out.Body.Close()
+
+		body, err := decompressObject(out.Body, compression)
+		if err != nil {
+			return 0, err
+		}
+		defer body.Close()
+
+		if format == sourceFormatAvro {
+			reader, err = avro.NewPeerDBOCFReader(body)
+		} else {
+			reader, err = newCSVReader(body)
+		}
+		if err != nil {
+			return 0, err
+		}
+	default:
+		return 0, fmt.Errorf("unsupported object format")
+	}
+
+	if *schema == nil {
+		*schema = reader.Schema()
+		err := stream.SetSchema(*schema)
+		if err != nil {
+			return 0, err
+		}
+	} else if !(*schema).EqualNames(reader.Schema()) {
+		return 0, fmt.Errorf("columns %v differ from the columns %v of the partition",
+			reader.Schema().GetColumnNames(), (*schema).GetColumnNames())
+	}
+
+	numRecords := 0
+	for {
+		record, err := reader.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return 0, err
+		}
+		stream.Records <- &model.QRecordOrError{Record: record}
+		numRecords++
+	}
+	return numRecords, nil
+}
+
+// downloadObject downloads an object to a temporary file.
+func (c *S3Connector) downloadObject(bucket string, key string) (*os.File, error) {
+	file, err := os.CreateTemp("", "peerdb_s3_*"+path.Ext(key))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary file: %w", err)
+	}
+
+	downloader := s3manager.NewDownloaderWithClient(&c.client)
+	_, err = downloader.DownloadWithContext(c.ctx, file, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return nil, fmt.Errorf("failed to download object: %w", err)
+	}
+	return file, nil
+}
+
+func decompressObject(body io.Reader, compression string) (io.ReadCloser, error) {
+	switch compression {
+	case "gzip":
+		gzipReader, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gzip object: %w", err)
+		}
+		return gzipReader, nil
+	case "zstd":
+		zstdReader, err := zstd.NewReader(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read zstd object: %w", err)
+		}
+		return zstdReader.IOReadCloser(), nil
+	default:
+		return io.NopCloser(body), nil
+	}
+}
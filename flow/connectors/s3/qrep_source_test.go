@@ -0,0 +1,87 @@
+package conns3
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSourceObjectKey(t *testing.T) {
+	cases := []struct {
+		key         string
+		format      sourceFormat
+		compression string
+	}{
+		{"partner/orders/2023-07-01.avro", sourceFormatAvro, ""},
+		{"partner/orders/2023-07-01.avro.zst", sourceFormatAvro, "zstd"},
+		{"partner/orders/2023-07-01.csv.gz", sourceFormatCSV, "gzip"},
+		{"partner/orders/2023-07-01.parquet", sourceFormatParquet, ""},
+		{"partner/orders/2023-07-01.parquet.gz", sourceFormatUnknown, ""},
+		{"partner/orders/_manifest.json", sourceFormatUnknown, ""},
+		{"partner/orders/_SUCCESS", sourceFormatUnknown, ""},
+		{"partner/orders/readme.txt", sourceFormatUnknown, ""},
+	}
+	for _, c := range cases {
+		format, compression := parseSourceObjectKey(c.key)
+		require.Equal(t, c.format, format, c.key)
+		require.Equal(t, c.compression, compression, c.key)
+	}
+}
+
+func TestGroupObjectPartitions(t *testing.T) {
+	t0 := time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)
+	object := func(key string, lastModified time.Time) *s3.Object {
+		return &s3.Object{Key: aws.String(key), LastModified: aws.Time(lastModified)}
+	}
+
+	objects := []*s3.Object{
+		object("d.csv", t0.Add(2*time.Second)),
+		object("a.csv", t0),
+		object("c.csv", t0.Add(time.Second)),
+		object("b.csv", t0.Add(time.Second)),
+	}
+	partitions := groupObjectPartitions(objects, 1)
+	// b and c are modified at the same time and stay in the same partition.
+	require.Len(t, partitions, 3)
+	require.Equal(t, []string{"a.csv"}, partitions[0].Range.GetObjectRange().Keys)
+	require.Equal(t, []string{"b.csv", "c.csv"}, partitions[1].Range.GetObjectRange().Keys)
+	require.Equal(t, []string{"d.csv"}, partitions[2].Range.GetObjectRange().Keys)
+	require.Equal(t, t0.Add(2*time.Second), partitions[2].Range.GetObjectRange().LastModified.AsTime())
+
+	partitions = groupObjectPartitions(objects, 10)
+	require.Len(t, partitions, 1)
+	require.Len(t, partitions[0].Range.GetObjectRange().Keys, 4)
+
+	// objects listed at the watermark are skipped, objects appearing later at the watermark are not.
+	last := partitions[0].Range.GetObjectRange()
+	require.True(t, isObjectBeforeWatermark(object("c.csv", t0.Add(time.Second)), last))
+	require.True(t, isObjectBeforeWatermark(object("d.csv", t0.Add(2*time.Second)), last))
+	require.False(t, isObjectBeforeWatermark(object("e.csv", t0.Add(2*time.Second)), last))
+	require.False(t, isObjectBeforeWatermark(object("f.csv", t0.Add(3*time.Second)), last))
+	require.False(t, isObjectBeforeWatermark(object("a.csv", t0), &protos.ObjectPartitionRange{}))
+}
+
+func TestCSVReader(t *testing.T) {
+	reader, err := newCSVReader(strings.NewReader("id,name\n1,alice\n2,\n"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"id", "name"}, reader.Schema().GetColumnNames())
+
+	record, err := reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, qvalue.QValue{Kind: qvalue.QValueKindString, Value: "alice"}, record.Entries[1])
+
+	record, err = reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, "2", record.Entries[0].Value)
+	require.Nil(t, record.Entries[1].Value)
+
+	_, err = reader.Next()
+	require.Equal(t, io.EOF, err)
+}
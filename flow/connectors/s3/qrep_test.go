@@ -0,0 +1,277 @@
+package conns3
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+// fakeBucket serves the subset of the S3 API used by the QRep sync of the connector,
+// path style and from memory.
+type fakeBucket struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+type fakeListContents struct {
+	Key  string `xml:"Key"`
+	Size int    `xml:"Size"`
+}
+
+type fakeListBucketResult struct {
+	XMLName     xml.Name           `xml:"ListBucketResult"`
+	Name        string             `xml:"Name"`
+	Prefix      string             `xml:"Prefix"`
+	KeyCount    int                `xml:"KeyCount"`
+	IsTruncated bool               `xml:"IsTruncated"`
+	Contents    []fakeListContents `xml:"Contents"`
+}
+
+func (b *fakeBucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// /<bucket>/<key>
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"), "/", 2)
+	key := ""
+	if len(parts) == 2 {
+		key = parts[1]
+	}
+
+	switch {
+	case r.Method == http.MethodGet && key == "":
+		prefix := r.URL.Query().Get("prefix")
+		result := fakeListBucketResult{Name: parts[0], Prefix: prefix}
+		for objectKey, content := range b.objects {
+			if strings.HasPrefix(objectKey, prefix) {
+				result.Contents = append(result.Contents, fakeListContents{Key: objectKey, Size: len(content)})
+			}
+		}
+		sort.Slice(result.Contents, func(i, j int) bool {
+			return result.Contents[i].Key < result.Contents[j].Key
+		})
+		result.KeyCount = len(result.Contents)
+		w.Header().Set("Content-Type", "application/xml")
+		_ = xml.NewEncoder(w).Encode(result)
+	case r.Method == http.MethodGet:
+		content, ok := b.objects[key]
+		if !ok {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, "<Error><Code>NoSuchKey</Code><Message>%s</Message></Error>", key)
+			return
+		}
+		_, _ = w.Write(content)
+	case r.Method == http.MethodPut:
+		content, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		b.objects[key] = content
+	case r.Method == http.MethodDelete:
+		delete(b.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func (b *fakeBucket) keys() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	keys := make([]string, 0, len(b.objects))
+	for key := range b.objects {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (b *fakeBucket) get(key string) []byte {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.objects[key]
+}
+
+// runQRepSync runs f with a connector writing to a fake bucket, as an activity
+// since the file writers heartbeat.
+func runQRepSync(t *testing.T, f func(c *S3Connector)) *fakeBucket {
+	bucket := &fakeBucket{objects: make(map[string][]byte)}
+	server := httptest.NewServer(bucket)
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Region:           aws.String("us-east-1"),
+		Endpoint:         aws.String(server.URL),
+		S3ForcePathStyle: aws.Bool(true),
+		Credentials:      credentials.NewStaticCredentials("key", "secret", ""),
+	})
+	require.NoError(t, err)
+
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestActivityEnvironment()
+	activity := func(ctx context.Context) error {
+		f(&S3Connector{
+			ctx:    ctx,
+			url:    "s3://bucket/exports",
+			client: *s3.New(sess),
+		})
+		return nil
+	}
+	env.RegisterActivity(activity)
+	_, err = env.ExecuteActivity(activity)
+	require.NoError(t, err)
+	return bucket
+}
+
+func qRepTestStream(t *testing.T, numRows int) *model.QRecordStream {
+	schema := model.NewQRecordSchema([]*model.QField{
+		{Name: "id", Type: qvalue.QValueKindInt64},
+		{Name: "name", Type: qvalue.QValueKindString, Nullable: true},
+	})
+	stream := model.NewQRecordStream(numRows)
+	require.NoError(t, stream.SetSchema(schema))
+	for i := 0; i < numRows; i++ {
+		record := model.NewQRecord(2)
+		record.Set(0, qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(i)})
+		record.Set(1, qvalue.QValue{Kind: qvalue.QValueKindString, Value: fmt.Sprintf("row %d", i)})
+		stream.Records <- &model.QRecordOrError{Record: record}
+	}
+	close(stream.Records)
+	return stream
+}
+
+func countAvroRows(t *testing.T, content []byte) int {
+	reader, err := goavro.NewOCFReader(bytes.NewReader(content))
+	require.NoError(t, err)
+	numRows := 0
+	for reader.Scan() {
+		_, err = reader.Read()
+		require.NoError(t, err)
+		numRows++
+	}
+	require.NoError(t, reader.Err())
+	return numRows
+}
+
+func TestSyncQRepRecordsRollsFiles(t *testing.T) {
+	config := &protos.QRepConfig{
+		FlowJobName:                "orders_export",
+		DestinationTableIdentifier: "orders",
+		TargetFileSizeBytes:        1,
+	}
+	var numRecords int
+	bucket := runQRepSync(t, func(c *S3Connector) {
+		var err error
+		numRecords, err = c.SyncQRepRecords(config, &protos.QRepPartition{PartitionId: "p1"}, qRepTestStream(t, 20))
+		require.NoError(t, err)
+	})
+	require.Equal(t, 20, numRecords)
+
+	var partitionFiles qRepPartitionFiles
+	require.NoError(t, json.Unmarshal(bucket.get("exports/orders_export/_peerdb_partitions/p1.json"), &partitionFiles))
+	require.Equal(t, "p1", partitionFiles.PartitionID)
+	require.Equal(t, []qRepManifestColumn{
+		{Name: "id", Type: string(qvalue.QValueKindInt64)},
+		{Name: "name", Type: string(qvalue.QValueKindString), Nullable: true},
+	}, partitionFiles.Schema)
+	// every part is rolled over once it reaches the target size.
+	require.Greater(t, len(partitionFiles.Files), 1)
+
+	totalRows := 0
+	for i, file := range partitionFiles.Files {
+		require.Equal(t, fmt.Sprintf("exports/orders_export/p1_%d.avro", i), file.Key)
+		content := bucket.get(file.Key)
+		require.Equal(t, int64(len(content)), file.SizeBytes)
+		hash := sha256.Sum256(content)
+		require.Equal(t, hex.EncodeToString(hash[:]), file.SHA256)
+		require.Positive(t, file.NumRows)
+		require.Equal(t, file.NumRows, countAvroRows(t, content))
+		totalRows += file.NumRows
+	}
+	require.Equal(t, 20, totalRows)
+}
+
+func TestSyncQRepRecordsSingleFile(t *testing.T) {
+	config := &protos.QRepConfig{
+		FlowJobName:                "orders_export",
+		DestinationTableIdentifier: "orders",
+	}
+	bucket := runQRepSync(t, func(c *S3Connector) {
+		numRecords, err := c.SyncQRepRecords(config, &protos.QRepPartition{PartitionId: "p1"}, qRepTestStream(t, 20))
+		require.NoError(t, err)
+		require.Equal(t, 20, numRecords)
+	})
+
+	require.Equal(t, []string{
+		"exports/orders_export/_peerdb_partitions/p1.json",
+		"exports/orders_export/p1.avro",
+	}, bucket.keys())
+	require.Equal(t, 20, countAvroRows(t, bucket.get("exports/orders_export/p1.avro")))
+}
+
+func TestConsolidateQRepPartitions(t *testing.T) {
+	config := &protos.QRepConfig{
+		FlowJobName:                "orders_export",
+		DestinationTableIdentifier: "orders",
+		TargetFileSizeBytes:        1,
+	}
+	bucket := runQRepSync(t, func(c *S3Connector) {
+		_, err := c.SyncQRepRecords(config, &protos.QRepPartition{PartitionId: "p2"}, qRepTestStream(t, 5))
+		require.NoError(t, err)
+		_, err = c.SyncQRepRecords(config, &protos.QRepPartition{PartitionId: "p1"}, qRepTestStream(t, 7))
+		require.NoError(t, err)
+		require.NoError(t, c.ConsolidateQRepPartitions(config))
+	})
+
+	var manifest qRepManifest
+	require.NoError(t, json.Unmarshal(bucket.get("exports/orders_export/_manifest.json"), &manifest))
+	require.Equal(t, "orders_export", manifest.FlowJobName)
+	require.Equal(t, "orders", manifest.DestinationTableIdentifier)
+	require.Equal(t, "avro", manifest.Format)
+	require.Equal(t, int64(12), manifest.NumRows)
+	require.Len(t, manifest.Schema, 2)
+	require.False(t, manifest.CompletedAt.IsZero())
+
+	// the manifest lists the files of all partitions, and only those.
+	manifestKeys := make([]string, 0, len(manifest.Files))
+	for _, file := range manifest.Files {
+		manifestKeys = append(manifestKeys, file.Key)
+	}
+	require.True(t, sort.StringsAreSorted(manifestKeys))
+	objectKeys := make([]string, 0)
+	for _, key := range bucket.keys() {
+		if strings.HasSuffix(key, ".avro") {
+			objectKeys = append(objectKeys, key)
+		}
+	}
+	require.Equal(t, objectKeys, manifestKeys)
+
+	// the files recorded for the partitions are cleaned up for the next run.
+	for _, key := range bucket.keys() {
+		require.NotContains(t, key, qRepPartitionsDir)
+	}
+}
@@ -1,9 +1,11 @@
 package connsnowflake
 
 import (
+	"bytes"
 	"fmt"
 	"math/big"
 	"os"
+	"regexp"
 	"testing"
 	"time"
 
@@ -142,7 +144,7 @@ func TestWriteRecordsToAvroFileHappyPath(t *testing.T) {
 	fmt.Printf("[test] avroSchema: %v\n", avroSchema)
 
 	// Call function
-	writer := avro.NewPeerDBOCFWriter(nil, records, avroSchema)
+	writer := avro.NewPeerDBOCFWriter(nil, records, avroSchema, avro.CompressNone)
 	_, err = writer.WriteRecordsToAvroFile(tmpfile.Name())
 	require.NoError(t, err, "expected WriteRecordsToAvroFile to complete without errors")
 
@@ -168,7 +170,7 @@ func TestWriteRecordsToAvroFileNonNull(t *testing.T) {
 	fmt.Printf("[test] avroSchema: %v\n", avroSchema)
 
 	// Call function
-	writer := avro.NewPeerDBOCFWriter(nil, records, avroSchema)
+	writer := avro.NewPeerDBOCFWriter(nil, records, avroSchema, avro.CompressNone)
 	_, err = writer.WriteRecordsToAvroFile(tmpfile.Name())
 	require.NoError(t, err, "expected WriteRecordsToAvroFile to complete without errors")
 
@@ -195,7 +197,7 @@ func TestWriteRecordsToAvroFileAllNulls(t *testing.T) {
 	fmt.Printf("[test] avroSchema: %v\n", avroSchema)
 
 	// Call function
-	writer := avro.NewPeerDBOCFWriter(nil, records, avroSchema)
+	writer := avro.NewPeerDBOCFWriter(nil, records, avroSchema, avro.CompressNone)
 	_, err = writer.WriteRecordsToAvroFile(tmpfile.Name())
 	require.NoError(t, err, "expected WriteRecordsToAvroFile to complete without errors")
 
@@ -204,3 +206,55 @@ func TestWriteRecordsToAvroFileAllNulls(t *testing.T) {
 	require.NoError(t, err)
 	require.NotZero(t, info.Size(), "expected file to not be empty")
 }
+
+func TestWriteOCFCompressed(t *testing.T) {
+	for _, compression := range []string{"deflate", "snappy", "gzip", "zstd"} {
+		codec, err := avro.ParseAvroCompressionCodec(compression)
+		require.NoError(t, err)
+
+		records, schema := generateRecords(t, true, 10, false)
+		avroSchema, err := model.GetAvroSchemaDefinition("not_applicable", schema)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		writer := avro.NewPeerDBOCFWriter(nil, records, avroSchema, codec)
+		numRows, err := writer.WriteOCF(&buf)
+		require.NoError(t, err, "expected WriteOCF to complete without errors for %s", compression)
+		require.Equal(t, 10, numRows)
+		require.NotZero(t, buf.Len())
+	}
+
+	_, err := avro.ParseAvroCompressionCodec("lz4")
+	require.Error(t, err)
+}
+
+func TestWriteRecordsInChunks(t *testing.T) {
+	records, schema := generateRecords(t, true, 10, false)
+
+	avroSchema, err := model.GetAvroSchemaDefinition("not_applicable", schema)
+	require.NoError(t, err)
+
+	// a chunk size of one byte puts every record in its own file.
+	writer := avro.NewPeerDBOCFWriter(nil, records, avroSchema, avro.CompressNone)
+	numChunks := 0
+	numRows, err := writer.WriteChunks(1, func(chunk int, data []byte) error {
+		require.Equal(t, numChunks, chunk)
+		require.NotEmpty(t, data)
+		numChunks++
+		return nil
+	})
+	require.NoError(t, err, "expected WriteChunks to complete without errors")
+	require.Equal(t, 10, numRows)
+	require.Equal(t, 10, numChunks)
+}
+
+func TestStageFilesPattern(t *testing.T) {
+	// Snowflake matches the pattern against the whole path of a staged file.
+	pattern := regexp.MustCompile("^(?:" + stageFilesPattern("17") + ")$")
+	for _, path := range []string{"17_0.avro", "17_12.avro.gz", "peerdb_stage_flow/17_3.avro.gz"} {
+		require.True(t, pattern.MatchString(path), path)
+	}
+	for _, path := range []string{"117_0.avro.gz", "17_0.avro.gz.bak", "17.avro", "18_0.avro.gz"} {
+		require.False(t, pattern.MatchString(path), path)
+	}
+}
@@ -22,7 +22,7 @@ func TestGenerateUpdateStatement_WithUnchangedToastCols(t *testing.T) {
 		THEN UPDATE SET "COL1" = SOURCE."COL1", "COL2" = SOURCE."COL2"`,
 	}
 
-	result := c.generateUpdateStatement(allCols, unchangedToastCols)
+	result := c.generateUpdateStatement(allCols, unchangedToastCols, nil)
 
 	for i := range expected {
 		expected[i] = removeSpacesTabsNewlines(expected[i])
@@ -44,7 +44,7 @@ func TestGenerateUpdateStatement_EmptyColumns(t *testing.T) {
 		THEN UPDATE SET "COL1" = SOURCE."COL1", "COL2" = SOURCE."COL2", "COL3" = SOURCE."COL3"`,
 	}
 
-	result := c.generateUpdateStatement(allCols, unchangedToastCols)
+	result := c.generateUpdateStatement(allCols, unchangedToastCols, nil)
 
 	for i := range expected {
 		expected[i] = removeSpacesTabsNewlines(expected[i])
@@ -62,3 +62,29 @@ func removeSpacesTabsNewlines(s string) string {
 	s = strings.ReplaceAll(s, "\n", "")
 	return s
 }
+
+func TestGenerateMergeCommand_SoftDelete(t *testing.T) {
+	allCols := []string{"ID", "UPDATED_AT", "VAL", "IS_DELETED"}
+	mergeCmd, err := GenerateMergeCommand(allCols, []string{"id"}, "updated_at", "is_deleted", "tmp", "dst_table")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// soft deleted rows are brought back even when their watermark didn't move.
+	expected := []string{
+		`WHEN MATCHED AND (src."UPDATED_AT" > dst."UPDATED_AT" OR COALESCE(dst."IS_DELETED", FALSE))
+		THEN UPDATE SET "ID" = src."ID", "UPDATED_AT" = src."UPDATED_AT", "VAL" = src."VAL", "IS_DELETED" = FALSE`,
+		`WHEN NOT MATCHED THEN INSERT ("ID", "UPDATED_AT", "VAL", "IS_DELETED")
+		VALUES (src."ID", src."UPDATED_AT", src."VAL", FALSE)`,
+	}
+	for _, clause := range expected {
+		if !strings.Contains(removeSpacesTabsNewlines(mergeCmd), removeSpacesTabsNewlines(clause)) {
+			t.Errorf("expected merge command to contain %s, got %s", clause, mergeCmd)
+		}
+	}
+
+	_, err = GenerateMergeCommand(allCols, []string{"id"}, "updated_at", "deleted", "tmp", "dst_table")
+	if err == nil {
+		t.Errorf("expected an error for a soft delete column missing from the destination table")
+	}
+}
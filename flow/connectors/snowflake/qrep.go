@@ -18,18 +18,6 @@ import (
 
 const qRepMetadataTableName = "_peerdb_query_replication_metadata"
 
-func (c *SnowflakeConnector) GetQRepPartitions(config *protos.QRepConfig,
-	last *protos.QRepPartition,
-) ([]*protos.QRepPartition, error) {
-	panic("not implemented")
-}
-
-func (c *SnowflakeConnector) PullQRepRecords(config *protos.QRepConfig,
-	partition *protos.QRepPartition,
-) (*model.QRecordBatch, error) {
-	panic("not implemented")
-}
-
 func (c *SnowflakeConnector) SyncQRepRecords(
 	config *protos.QRepConfig,
 	partition *protos.QRepPartition,
@@ -208,7 +196,7 @@ func (c *SnowflakeConnector) createStage(stageName string, config *protos.QRepCo
 }
 
 func (c *SnowflakeConnector) createExternalStage(stageName string, config *protos.QRepConfig) (string, error) {
-	awsCreds, err := utils.GetAWSSecrets()
+	awsCreds, err := utils.GetAWSSecrets(c.stagingS3Connection)
 	if err != nil {
 		log.WithFields(log.Fields{
 			"flowName": config.FlowJobName,
@@ -225,25 +213,37 @@ func (c *SnowflakeConnector) createExternalStage(stageName string, config *proto
 	}
 
 	cleanURL := fmt.Sprintf("s3://%s/%s/%s", s3o.Bucket, s3o.Prefix, config.FlowJobName)
+	// S3 compatible stores are reached through an s3compat:// URL and the endpoint host.
+	endpointStr := ""
+	if awsCreds.Endpoint != "" {
+		cleanURL = fmt.Sprintf("s3compat://%s/%s/%s", s3o.Bucket, s3o.Prefix, config.FlowJobName)
+		endpoint := strings.TrimPrefix(strings.TrimPrefix(awsCreds.Endpoint, "https://"), "http://")
+		endpointStr = fmt.Sprintf("ENDPOINT = '%s'", endpoint)
+	}
 
 	s3Int := config.DestinationPeer.GetSnowflakeConfig().S3Integration
 	if s3Int == "" {
 		credsStr := fmt.Sprintf("CREDENTIALS=(AWS_KEY_ID='%s' AWS_SECRET_KEY='%s')",
 			awsCreds.AccessKeyID, awsCreds.SecretAccessKey)
+		if awsCreds.AccessKeyID == "" && awsCreds.AwsRoleArn != "" {
+			credsStr = fmt.Sprintf("CREDENTIALS=(AWS_ROLE='%s')", awsCreds.AwsRoleArn)
+		}
 
 		stageStatement := `
 		CREATE OR REPLACE STAGE %s
 		URL = '%s'
 		%s
+		%s
 		FILE_FORMAT = (TYPE = AVRO);`
-		return fmt.Sprintf(stageStatement, stageName, cleanURL, credsStr), nil
+		return fmt.Sprintf(stageStatement, stageName, cleanURL, endpointStr, credsStr), nil
 	} else {
 		stageStatement := `
 		CREATE OR REPLACE STAGE %s
 		URL = '%s'
+		%s
 		STORAGE_INTEGRATION = %s
 		FILE_FORMAT = (TYPE = AVRO);`
-		return fmt.Sprintf(stageStatement, stageName, cleanURL, s3Int), nil
+		return fmt.Sprintf(stageStatement, stageName, cleanURL, endpointStr, s3Int), nil
 	}
 }
 
@@ -347,7 +347,7 @@ func (c *SnowflakeConnector) dropStage(stagingPath string, job string) error {
 		log.Infof("Deleting contents of bucket %s with prefix %s/%s", s3o.Bucket, s3o.Prefix, job)
 
 		// deleting the contents of the bucket with prefix
-		s3svc, err := utils.CreateS3Client()
+		s3svc, err := utils.CreateS3Client(c.stagingS3Connection)
 		if err != nil {
 			log.WithFields(log.Fields{
 				"flowName": job,
@@ -1,10 +1,13 @@
 package connsnowflake
 
 import (
+	"bytes"
+	"context"
 	"database/sql"
 	"fmt"
-	"os"
+	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/PeerDB-io/peer-flow/connectors/utils"
@@ -14,7 +17,7 @@ import (
 	"github.com/PeerDB-io/peer-flow/model"
 	util "github.com/PeerDB-io/peer-flow/utils"
 	log "github.com/sirupsen/logrus"
-	_ "github.com/snowflakedb/gosnowflake"
+	"github.com/snowflakedb/gosnowflake"
 	"go.temporal.io/sdk/activity"
 )
 
@@ -54,38 +57,30 @@ func (s *SnowflakeAvroSyncMethod) SyncRecords(
 		return 0, err
 	}
 
-	numRecords, localFilePath, err := s.writeToAvroFile(stream, avroSchema, "17", flowJobName)
+	stage := s.connector.getStageNameForJob(s.config.FlowJobName)
+	err = s.connector.createStage(stage, s.config)
 	if err != nil {
 		return 0, err
 	}
 	log.WithFields(log.Fields{
 		"destinationTable": dstTableName,
 		"flowName":         flowJobName,
-	}).Infof("written %d records to Avro file", numRecords)
+	}).Infof("Created stage %s", stage)
 
-	stage := s.connector.getStageNameForJob(s.config.FlowJobName)
-	err = s.connector.createStage(stage, s.config)
+	numRecords, err := s.writeToStage(stream, avroSchema, stage, "17", flowJobName)
 	if err != nil {
 		return 0, err
 	}
 	log.WithFields(log.Fields{
 		"destinationTable": dstTableName,
 		"flowName":         flowJobName,
-	}).Infof("Created stage %s", stage)
+	}).Infof("pushed %d records to stage %s", numRecords, stage)
 
 	allCols, err := s.connector.getColsFromTable(s.config.DestinationTableIdentifier)
 	if err != nil {
 		return 0, err
 	}
 
-	err = s.putFileToStage(localFilePath, stage)
-	if err != nil {
-		return 0, err
-	}
-	log.WithFields(log.Fields{
-		"destinationTable": dstTableName,
-	}).Infof("pushed avro file to stage")
-
 	err = CopyStageToDestination(s.connector, s.config, s.config.DestinationTableIdentifier, stage, allCols)
 	if err != nil {
 		return 0, err
@@ -120,36 +115,17 @@ func (s *SnowflakeAvroSyncMethod) SyncQRepRecords(
 		return 0, err
 	}
 
-	numRecords, localFilePath, err := s.writeToAvroFile(stream, avroSchema, partition.PartitionId, config.FlowJobName)
-	if err != nil {
-		return 0, err
-	}
-
-	if localFilePath != "" {
-		defer func() {
-			log.Infof("removing temp file %s", localFilePath)
-			err := os.Remove(localFilePath)
-			if err != nil {
-				log.WithFields(log.Fields{
-					"flowName":         config.FlowJobName,
-					"partitionID":      partition.PartitionId,
-					"destinationTable": dstTableName,
-				}).Errorf("failed to remove temp file %s: %v", localFilePath, err)
-			}
-		}()
-	}
-
 	stage := s.connector.getStageNameForJob(config.FlowJobName)
 
 	putFileStartTime := time.Now()
-	err = s.putFileToStage(localFilePath, stage)
+	numRecords, err := s.writeToStage(stream, avroSchema, stage, partition.PartitionId, config.FlowJobName)
 	if err != nil {
 		return 0, err
 	}
 	log.WithFields(log.Fields{
 		"flowName":    config.FlowJobName,
 		"partitionID": partition.PartitionId,
-	}).Infof("Put file to stage in Avro sync for snowflake")
+	}).Infof("Put records to stage in Avro sync for snowflake")
 	metrics.LogQRepSyncMetrics(s.connector.ctx, config.FlowJobName, int64(numRecords),
 		time.Since(putFileStartTime))
 
@@ -179,35 +155,29 @@ func (s *SnowflakeAvroSyncMethod) getAvroSchema(
 	return avroSchema, nil
 }
 
-func (s *SnowflakeAvroSyncMethod) writeToAvroFile(
+func (s *SnowflakeAvroSyncMethod) writeToStage(
 	stream *model.QRecordStream,
 	avroSchema *model.QRecordAvroSchemaDefinition,
+	stage string,
 	partitionID string,
 	flowJobName string,
-) (int, string, error) {
-	var numRecords int
-	ocfWriter := avro.NewPeerDBOCFWriter(s.connector.ctx, stream, avroSchema)
+) (int, error) {
+	ocfWriter := avro.NewPeerDBOCFWriter(s.connector.ctx, stream, avroSchema, avro.CompressNone)
 	if s.config.StagingPath == "" {
-		tmpDir, err := os.MkdirTemp("", "peerdb-avro")
-		if err != nil {
-			return 0, "", fmt.Errorf("failed to create temp dir: %w", err)
-		}
-
-		localFilePath := fmt.Sprintf("%s/%s.avro", tmpDir, partitionID)
 		log.WithFields(log.Fields{
 			"flowName":    flowJobName,
 			"partitionID": partitionID,
-		}).Infof("writing records to local file %s", localFilePath)
-		numRecords, err = ocfWriter.WriteRecordsToAvroFile(localFilePath)
+		}).Infof("OCF: Streaming records to stage %s", stage)
+		numRecords, err := s.putChunksToStage(ocfWriter, stage, partitionID)
 		if err != nil {
-			return 0, "", fmt.Errorf("failed to write records to Avro file: %w", err)
+			return 0, fmt.Errorf("failed to put records to stage: %w", err)
 		}
 
-		return numRecords, localFilePath, nil
+		return numRecords, nil
 	} else if strings.HasPrefix(s.config.StagingPath, "s3://") {
 		s3o, err := utils.NewS3BucketAndPrefix(s.config.StagingPath)
 		if err != nil {
-			return 0, "", fmt.Errorf("failed to parse staging path: %w", err)
+			return 0, fmt.Errorf("failed to parse staging path: %w", err)
 		}
 
 		s3Key := fmt.Sprintf("%s/%s/%s.avro", s3o.Prefix, s.config.FlowJobName, partitionID)
@@ -215,39 +185,114 @@ func (s *SnowflakeAvroSyncMethod) writeToAvroFile(
 			"flowName":    flowJobName,
 			"partitionID": partitionID,
 		}).Infof("OCF: Writing records to S3")
-		numRecords, err = ocfWriter.WriteRecordsToS3(s3o.Bucket, s3Key)
+		numRecords, err := ocfWriter.WriteRecordsToS3(s3o.Bucket, s3Key, s.connector.stagingS3Connection)
 		if err != nil {
-			return 0, "", fmt.Errorf("failed to write records to S3: %w", err)
+			return 0, fmt.Errorf("failed to write records to S3: %w", err)
 		}
 
-		return numRecords, "", nil
+		return numRecords, nil
 	}
 
-	return 0, "", fmt.Errorf("unsupported staging path: %s", s.config.StagingPath)
+	return 0, fmt.Errorf("unsupported staging path: %s", s.config.StagingPath)
 }
 
-func (s *SnowflakeAvroSyncMethod) putFileToStage(localFilePath string, stage string) error {
-	if localFilePath == "" {
-		log.Infof("no file to put to stage")
-		return nil
+// putChunksToStage streams the records to an internal stage as Avro files of about stageFileSize bytes.
+// The files are built in memory and PUT from there, at most stageUploadParallelism at once, so neither
+// local disk nor more than a few files worth of memory is needed whatever the number of records.
+func (s *SnowflakeAvroSyncMethod) putChunksToStage(
+	ocfWriter *avro.PeerDBOCFWriter,
+	stage string,
+	partitionID string,
+) (int, error) {
+	type stageChunk struct {
+		fileName string
+		data     []byte
 	}
 
-	activity.RecordHeartbeat(s.connector.ctx, "putting file to stage")
-	putCmd := fmt.Sprintf("PUT file://%s @%s", localFilePath, stage)
-
-	sutdown := utils.HeartbeatRoutine(s.connector.ctx, 10*time.Second, func() string {
-		return fmt.Sprintf("putting file to stage %s", stage)
+	shutdown := utils.HeartbeatRoutine(s.connector.ctx, 10*time.Second, func() string {
+		return fmt.Sprintf("putting files to stage %s", stage)
 	})
-
 	defer func() {
-		sutdown <- true
+		shutdown <- true
 	}()
 
-	if _, err := s.connector.database.Exec(putCmd); err != nil {
-		return fmt.Errorf("failed to put file to stage: %w", err)
+	// a previous attempt of the partition may have put more files, or files split differently, they are
+	// removed so that COPY INTO doesn't load them along with the files of this attempt.
+	//nolint:gosec
+	removeCmd := fmt.Sprintf("REMOVE @%s PATTERN = %s", stage, snowflakeString(stageFilesPattern(partitionID)))
+	if _, err := s.connector.database.ExecContext(s.connector.ctx, removeCmd); err != nil {
+		return 0, fmt.Errorf("failed to remove files of previous attempts from stage %s: %w", stage, err)
+	}
+
+	ctx, cancel := context.WithCancel(s.connector.ctx)
+	defer cancel()
+
+	// the channel is unbuffered, a file is only built once the previous one has been picked up.
+	chunks := make(chan stageChunk)
+	numChunks := 0
+	var putErr error
+	var putErrOnce sync.Once
+	var wg sync.WaitGroup
+	for i := 0; i < s.connector.stageUploadParallelism; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chunk := range chunks {
+				if ctx.Err() != nil {
+					continue
+				}
+				if err := s.putStreamToStage(ctx, chunk.fileName, chunk.data, stage); err != nil {
+					putErrOnce.Do(func() {
+						putErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+	numRecords, err := ocfWriter.WriteChunks(s.connector.stageFileSize, func(chunk int, data []byte) error {
+		select {
+		case chunks <- stageChunk{fileName: fmt.Sprintf("%s_%d.avro", partitionID, chunk), data: data}:
+			numChunks++
+			return nil
+		case <-ctx.Done():
+			return fmt.Errorf("stopped writing files to stage %s: %w", stage, ctx.Err())
+		}
+	})
+	close(chunks)
+	wg.Wait()
+	if putErr != nil {
+		return 0, putErr
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	log.Infof("put %d files with %d records to stage %s", numChunks, numRecords, stage)
+	return numRecords, nil
+}
+
+// stageFilesPattern matches the files putChunksToStage puts to a stage for a partition,
+// <partition>_<chunk>.avro, which PUT compresses to <partition>_<chunk>.avro.gz.
+func stageFilesPattern(partitionID string) string {
+	return fmt.Sprintf(`(.*/)?%s_[0-9]+\.avro(\.gz)?`, regexp.QuoteMeta(partitionID))
+}
+
+// putStreamToStage PUTs an in-memory file to an internal stage.
+func (s *SnowflakeAvroSyncMethod) putStreamToStage(
+	ctx context.Context,
+	fileName string,
+	data []byte,
+	stage string,
+) error {
+	// with a file stream the driver reads the data from the stream, the path only names the staged file.
+	putCmd := fmt.Sprintf("PUT file://%s @%s", fileName, stage)
+	streamCtx := gosnowflake.WithFileStream(ctx, bytes.NewReader(data))
+	if _, err := s.connector.database.ExecContext(streamCtx, putCmd); err != nil {
+		return fmt.Errorf("failed to put file %s to stage: %w", fileName, err)
 	}
 
-	log.Infof("put file %s to stage %s", localFilePath, stage)
 	return nil
 }
 
@@ -287,7 +332,11 @@ func CopyStageToDestination(
 
 	case false:
 		upsertKeyCols := config.WriteMode.UpsertKeyColumns
-		err := writeHandler.HandleUpsertMode(allCols, upsertKeyCols, config.WatermarkColumn,
+		softDeleteCol := ""
+		if config.DeleteMode == protos.QRepDeleteMode_QREP_DELETE_MODE_SOFT {
+			softDeleteCol = config.SoftDeleteColumn
+		}
+		err := writeHandler.HandleUpsertMode(allCols, upsertKeyCols, config.WatermarkColumn, softDeleteCol,
 			config.FlowJobName)
 		if err != nil {
 			return fmt.Errorf("failed to handle upsert mode: %w", err)
@@ -361,10 +410,14 @@ func (s *SnowflakeAvroWriteHandler) HandleAppendMode(flowJobName string) error {
 	return nil
 }
 
+// GenerateMergeCommand merges the rows of the temp table into the destination table, rows are updated when
+// their watermark is newer. With a soft delete column, soft deleted rows are brought back whenever they show up
+// at the source again, since the reconciliation of deletes skips them.
 func GenerateMergeCommand(
 	allCols []string,
 	upsertKeyCols []string,
 	watermarkCol string,
+	softDeleteCol string,
 	tempTableName string,
 	dstTable string,
 ) (string, error) {
@@ -384,6 +437,14 @@ func GenerateMergeCommand(
 		return "", fmt.Errorf("watermark column '%s' not found in destination table", watermarkCol)
 	}
 
+	if softDeleteCol != "" {
+		matched, ok := caseMatchedCols[strings.ToLower(softDeleteCol)]
+		if !ok {
+			return "", fmt.Errorf("soft delete column '%s' not found in destination table", softDeleteCol)
+		}
+		softDeleteCol = matched
+	}
+
 	upsertKeys := []string{}
 	partitionKeyCols := []string{}
 	for _, key := range upsertKeyCols {
@@ -398,15 +459,24 @@ func GenerateMergeCommand(
 	insertValuesClauses := []string{}
 	for _, column := range allCols {
 		quotedColumn := utils.QuoteIdentifier(column)
-		updateSetClauses = append(updateSetClauses, fmt.Sprintf("%s = src.%s", quotedColumn, quotedColumn))
+		value := fmt.Sprintf("src.%s", quotedColumn)
+		if column == softDeleteCol {
+			value = "FALSE"
+		}
+		updateSetClauses = append(updateSetClauses, fmt.Sprintf("%s = %s", quotedColumn, value))
 		insertColumnsClauses = append(insertColumnsClauses, quotedColumn)
-		insertValuesClauses = append(insertValuesClauses, fmt.Sprintf("src.%s", quotedColumn))
+		insertValuesClauses = append(insertValuesClauses, value)
 	}
 	updateSetClause := strings.Join(updateSetClauses, ", ")
 	insertColumnsClause := strings.Join(insertColumnsClauses, ", ")
 	insertValuesClause := strings.Join(insertValuesClauses, ", ")
 
 	quotedWMC := utils.QuoteIdentifier(watermarkCol)
+	matchedCondition := fmt.Sprintf("src.%s > dst.%s", quotedWMC, quotedWMC)
+	if softDeleteCol != "" {
+		matchedCondition = fmt.Sprintf("(%s OR COALESCE(dst.%s, FALSE))",
+			matchedCondition, utils.QuoteIdentifier(softDeleteCol))
+	}
 
 	selectCmd := fmt.Sprintf(`
 		SELECT *
@@ -418,9 +488,9 @@ func GenerateMergeCommand(
 		MERGE INTO %s dst
 		USING (%s) src
 		ON %s
-		WHEN MATCHED AND src.%s > dst.%s THEN UPDATE SET %s
+		WHEN MATCHED AND %s THEN UPDATE SET %s
 		WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s)
-	`, dstTable, selectCmd, upsertKeyClause, quotedWMC, quotedWMC,
+	`, dstTable, selectCmd, upsertKeyClause, matchedCondition,
 		updateSetClause, insertColumnsClause, insertValuesClause)
 
 	return mergeCmd, nil
@@ -431,6 +501,7 @@ func (s *SnowflakeAvroWriteHandler) HandleUpsertMode(
 	allCols []string,
 	upsertKeyCols []string,
 	watermarkCol string,
+	softDeleteCol string,
 	flowJobName string,
 ) error {
 	runID, err := util.RandomUInt64()
@@ -459,7 +530,8 @@ func (s *SnowflakeAvroWriteHandler) HandleUpsertMode(
 	}
 	log.Infof("copied file from stage %s to temp table %s", s.stage, tempTableName)
 
-	mergeCmd, err := GenerateMergeCommand(allCols, upsertKeyCols, watermarkCol, tempTableName, s.dstTableName)
+	mergeCmd, err := GenerateMergeCommand(allCols, upsertKeyCols, watermarkCol, softDeleteCol,
+		tempTableName, s.dstTableName)
 	if err != nil {
 		return fmt.Errorf("failed to generate merge command: %w", err)
 	}
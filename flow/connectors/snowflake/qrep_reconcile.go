@@ -0,0 +1,130 @@
+package connsnowflake
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// number of keys deleted by a single statement.
+const deleteKeysBatchSize = 1000
+
+// PullQRepKeys streams the upsert key columns of the rows of the destination table, rows that are
+// already soft deleted are skipped. They are brought back by GenerateMergeCommand when they show up again.
+func (c *SnowflakeConnector) PullQRepKeys(config *protos.QRepConfig, stream *model.QRecordStream) (int, error) {
+	keyColumns, softDeleteColumn, err := c.caseMatchedKeyColumns(config)
+	if err != nil {
+		stream.SchemaChan() <- &model.QRecordSchemaOrError{Err: err}
+		close(stream.Records)
+		return 0, err
+	}
+
+	//nolint:gosec
+	query := fmt.Sprintf("SELECT %s FROM %s",
+		strings.Join(keyColumns, ", "), config.DestinationTableIdentifier)
+	if config.DeleteMode == protos.QRepDeleteMode_QREP_DELETE_MODE_SOFT {
+		query += fmt.Sprintf(" WHERE NOT COALESCE(%s, FALSE)", softDeleteColumn)
+	}
+
+	return c.newQueryExecutor().ExecuteAndProcessQueryStream(stream, query)
+}
+
+// DeleteQRepKeys deletes, or soft deletes, the rows of the destination table with the given keys.
+func (c *SnowflakeConnector) DeleteQRepKeys(config *protos.QRepConfig, keys []*model.QRecord) (int64, error) {
+	keyColumns, softDeleteColumn, err := c.caseMatchedKeyColumns(config)
+	if err != nil {
+		return 0, err
+	}
+	keyColumnList := strings.Join(keyColumns, ", ")
+	dstTable := config.DestinationTableIdentifier
+
+	var totalRowsDeleted int64
+	for start := 0; start < len(keys); start += deleteKeysBatchSize {
+		end := start + deleteKeysBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		tuples := make([]string, 0, end-start)
+		args := make([]interface{}, 0, (end-start)*len(keyColumns))
+		for _, key := range keys[start:end] {
+			params := make([]string, 0, len(keyColumns))
+			for _, value := range key.Entries {
+				arg := value.Value
+				if rat, ok := arg.(*big.Rat); ok {
+					arg = rat.FloatString(int(rat.Denom().BitLen()))
+				}
+				args = append(args, arg)
+				params = append(params, "?")
+			}
+			tuples = append(tuples, "("+strings.Join(params, ", ")+")")
+		}
+
+		var stmt string
+		if config.DeleteMode == protos.QRepDeleteMode_QREP_DELETE_MODE_SOFT {
+			stmt = fmt.Sprintf("UPDATE %s SET %s = TRUE WHERE (%s) IN (%s)",
+				dstTable, softDeleteColumn, keyColumnList, strings.Join(tuples, ", "))
+		} else {
+			stmt = fmt.Sprintf("DELETE FROM %s WHERE (%s) IN (%s)",
+				dstTable, keyColumnList, strings.Join(tuples, ", "))
+		}
+
+		result, err := c.database.ExecContext(c.ctx, stmt, args...)
+		if err != nil {
+			return 0, fmt.Errorf("failed to delete keys from %s: %w", dstTable, err)
+		}
+		rowsDeleted, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get rows deleted from %s: %w", dstTable, err)
+		}
+		totalRowsDeleted += rowsDeleted
+	}
+
+	log.WithFields(log.Fields{
+		"flowName": config.FlowJobName,
+	}).Infof("deleted %d rows from %s", totalRowsDeleted, dstTable)
+	return totalRowsDeleted, nil
+}
+
+// caseMatchedKeyColumns returns the quoted upsert key columns and soft delete column of the config,
+// matching the case of the columns of the destination table like GenerateMergeCommand.
+func (c *SnowflakeConnector) caseMatchedKeyColumns(config *protos.QRepConfig) ([]string, string, error) {
+	if config.WriteMode == nil || len(config.WriteMode.UpsertKeyColumns) == 0 {
+		return nil, "", fmt.Errorf("upsert key columns are required to reconcile deletes")
+	}
+
+	allCols, err := c.getColsFromTable(config.DestinationTableIdentifier)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get columns of destination table: %w", err)
+	}
+	caseMatchedCols := map[string]string{}
+	for _, col := range allCols {
+		caseMatchedCols[strings.ToLower(col)] = col
+	}
+
+	keyColumns := make([]string, 0, len(config.WriteMode.UpsertKeyColumns))
+	for _, col := range config.WriteMode.UpsertKeyColumns {
+		matched, ok := caseMatchedCols[strings.ToLower(col)]
+		if !ok {
+			return nil, "", fmt.Errorf("upsert key column '%s' not found in destination table", col)
+		}
+		keyColumns = append(keyColumns, utils.QuoteIdentifier(matched))
+	}
+
+	softDeleteColumn := ""
+	if config.DeleteMode == protos.QRepDeleteMode_QREP_DELETE_MODE_SOFT {
+		matched, ok := caseMatchedCols[strings.ToLower(config.SoftDeleteColumn)]
+		if !ok {
+			return nil, "", fmt.Errorf("soft delete column '%s' not found in destination table",
+				config.SoftDeleteColumn)
+		}
+		softDeleteColumn = utils.QuoteIdentifier(matched)
+	}
+
+	return keyColumns, softDeleteColumn, nil
+}
@@ -0,0 +1,179 @@
+package connsnowflake
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	peersql "github.com/PeerDB-io/peer-flow/connectors/sql"
+	utils "github.com/PeerDB-io/peer-flow/connectors/utils/partition"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	log "github.com/sirupsen/logrus"
+)
+
+func (c *SnowflakeConnector) GetQRepPartitions(config *protos.QRepConfig,
+	last *protos.QRepPartition,
+) ([]*protos.QRepPartition, error) {
+	if config.WatermarkColumn == "" {
+		log.Infof("watermark column is empty, doing full table refresh")
+		return []*protos.QRepPartition{
+			{
+				PartitionId:        uuid.New().String(),
+				FullTablePartition: true,
+			},
+		}, nil
+	}
+
+	// only rows after the end of the last partition are replicated. The watermark column is quoted,
+	// it has to be named as stored, e.g. in upper case for columns created unquoted.
+	quotedWatermarkColumn := fmt.Sprintf(`"%s"`, config.WatermarkColumn)
+	whereClause := ""
+	args := make([]interface{}, 0, 1)
+	if last != nil && last.Range != nil {
+		lastEnd, err := utils.PartitionRangeEnd(last)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, lastEnd)
+		whereClause = fmt.Sprintf("WHERE %s > ?", quotedWatermarkColumn)
+	}
+
+	//nolint:gosec
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s %s", config.WatermarkTable, whereClause)
+	var totalRows int64
+	err := c.database.QueryRowContext(c.ctx, countQuery, args...).Scan(&totalRows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query for total rows: %w", err)
+	}
+
+	if totalRows == 0 {
+		log.Warnf("no records to replicate for flow job %s, returning", config.FlowJobName)
+		return make([]*protos.QRepPartition, 0), nil
+	}
+
+	numPartitions := int64(1)
+	if config.NumRowsPerPartition > 0 {
+		numRowsPerPartition := int64(config.NumRowsPerPartition)
+		numPartitions = (totalRows + numRowsPerPartition - 1) / numRowsPerPartition
+	}
+	log.Infof("total rows: %d, num partitions: %d, num rows per partition: %d",
+		totalRows, numPartitions, config.NumRowsPerPartition)
+
+	partitionsQuery := utils.PartitionsQuery(quotedWatermarkColumn, config.WatermarkTable, whereClause, numPartitions)
+	log.Infof("partitions query: %s - args: %v", partitionsQuery, args)
+	rows, err := c.database.QueryContext(c.ctx, partitionsQuery, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query for partitions: %w", err)
+	}
+	defer rows.Close()
+
+	partitionHelper := utils.NewPartitionHelper()
+	for rows.Next() {
+		var bucket int64
+		var start, end interface{}
+		if err := rows.Scan(&bucket, &start, &end); err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		start, err = snowflakeWatermarkValue(start)
+		if err != nil {
+			return nil, err
+		}
+		end, err = snowflakeWatermarkValue(end)
+		if err != nil {
+			return nil, err
+		}
+
+		err = partitionHelper.AddPartition(start, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to add partition: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read partitions: %w", err)
+	}
+
+	return partitionHelper.GetPartitions(), nil
+}
+
+// snowflakeWatermarkValue converts a value of the watermark column to an int64 or a time.Time.
+// Values of integer columns (NUMBER with scale 0) are returned as strings by the driver.
+func snowflakeWatermarkValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case int64, time.Time:
+		return v, nil
+	case string:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("watermark column value %s is not an integer: %w", v, err)
+		}
+		return n, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %T for watermark column, expected integer or timestamp", value)
+	}
+}
+
+// PullQRepRecords reads the rows of a partition into a batch, see PullQRepRecordStream.
+func (c *SnowflakeConnector) PullQRepRecords(config *protos.QRepConfig,
+	partition *protos.QRepPartition,
+) (*model.QRecordBatch, error) {
+	query, rangeParams, err := buildQRepPullQuery(config.Query, partition)
+	if err != nil {
+		return nil, err
+	}
+
+	executor := c.newQueryExecutor()
+	if rangeParams == nil {
+		return executor.ExecuteAndProcessQuery(query)
+	}
+	return executor.NamedExecuteAndProcessQuery(query, rangeParams)
+}
+
+// PullQRepRecordStream reads the rows of a partition into the stream.
+func (c *SnowflakeConnector) PullQRepRecordStream(
+	config *protos.QRepConfig,
+	partition *protos.QRepPartition,
+	stream *model.QRecordStream,
+) (int, error) {
+	query, rangeParams, err := buildQRepPullQuery(config.Query, partition)
+	if err != nil {
+		stream.SchemaChan() <- &model.QRecordSchemaOrError{Err: err}
+		close(stream.Records)
+		return 0, err
+	}
+
+	executor := c.newQueryExecutor()
+	if rangeParams == nil {
+		return executor.ExecuteAndProcessQueryStream(stream, query)
+	}
+	return executor.NamedExecuteAndProcessQueryStream(stream, query, rangeParams)
+}
+
+func (c *SnowflakeConnector) newQueryExecutor() *peersql.GenericSQLQueryExecutor {
+	return peersql.NewGenericSQLQueryExecutor(c.ctx, sqlx.NewDb(c.database, "snowflake"),
+		snowflakeTypeToQValueKindMap, qValueKindToSnowflakeTypeMap)
+}
+
+// buildQRepPullQuery templates {{.start}} and {{.end}} of the query with the bounds of the partition.
+// The returned params are nil for full table partitions, whose query is run as is.
+func buildQRepPullQuery(query string,
+	partition *protos.QRepPartition,
+) (string, map[string]interface{}, error) {
+	templated, rangeStart, rangeEnd, err := utils.TemplatePullQuery(query, partition, ":startRange", ":endRange")
+	if err != nil {
+		return "", nil, err
+	}
+	log.Infof("templated query: %s", templated)
+
+	if partition.FullTablePartition {
+		return templated, nil, nil
+	}
+
+	return templated, map[string]interface{}{
+		"startRange": rangeStart,
+		"endRange":   rangeEnd,
+	}, nil
+}
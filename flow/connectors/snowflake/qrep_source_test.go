@@ -0,0 +1,29 @@
+package connsnowflake
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSnowflakeWatermarkValue(t *testing.T) {
+	value, err := snowflakeWatermarkValue("42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != int64(42) {
+		t.Errorf("expected 42, got %v", value)
+	}
+
+	now := time.Now()
+	value, err = snowflakeWatermarkValue(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != now {
+		t.Errorf("expected %v, got %v", now, value)
+	}
+
+	if _, err := snowflakeWatermarkValue("1.5"); err == nil {
+		t.Error("expected an error for a non integer watermark value")
+	}
+}
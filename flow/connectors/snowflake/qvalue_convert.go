@@ -50,6 +50,7 @@ var snowflakeTypeToQValueKindMap = map[string]qvalue.QValueKind{
 	"TIMESTAMP":     qvalue.QValueKindTimestamp,
 	"TIMESTAMP_NTZ": qvalue.QValueKindTimestamp,
 	"TIMESTAMP_TZ":  qvalue.QValueKindTimestampTZ,
+	"TIMESTAMP_LTZ": qvalue.QValueKindTimestampTZ,
 	"TIME":          qvalue.QValueKindTime,
 	"DATE":          qvalue.QValueKindDate,
 	"BLOB":          qvalue.QValueKindBytes,
@@ -60,6 +61,8 @@ var snowflakeTypeToQValueKindMap = map[string]qvalue.QValueKind{
 	"DECIMAL":       qvalue.QValueKindNumeric,
 	"NUMERIC":       qvalue.QValueKindNumeric,
 	"VARIANT":       qvalue.QValueKindJSON,
+	"OBJECT":        qvalue.QValueKindJSON,
+	"ARRAY":         qvalue.QValueKindJSON,
 }
 
 func qValueKindToSnowflakeType(colType qvalue.QValueKind) string {
@@ -34,18 +34,24 @@ const (
 	createRawTableSQL             = `CREATE TABLE IF NOT EXISTS %s.%s(_PEERDB_UID STRING NOT NULL,
 		_PEERDB_TIMESTAMP INT NOT NULL,_PEERDB_DESTINATION_TABLE_NAME STRING NOT NULL,_PEERDB_DATA STRING NOT NULL,
 		_PEERDB_RECORD_TYPE INTEGER NOT NULL, _PEERDB_MATCH_DATA STRING,_PEERDB_BATCH_ID INT,
-		_PEERDB_UNCHANGED_TOAST_COLUMNS STRING)`
+		_PEERDB_UNCHANGED_TOAST_COLUMNS STRING,_PEERDB_SOURCE_LSN INT,_PEERDB_COMMIT_TS INT)`
+	// raw tables of mirrors created before the source LSN and commit timestamp were recorded lack these columns.
+	countRawTableSystemColumnsSQL = `SELECT COUNT(*) FROM INFORMATION_SCHEMA.COLUMNS WHERE TABLE_SCHEMA=?
+		AND TABLE_NAME=? AND COLUMN_NAME IN ('_PEERDB_SOURCE_LSN','_PEERDB_COMMIT_TS')`
+	addRawTableSourceLSNColumnSQL = "ALTER TABLE %s.%s ADD COLUMN IF NOT EXISTS _PEERDB_SOURCE_LSN INT"
+	addRawTableCommitTSColumnSQL  = "ALTER TABLE %s.%s ADD COLUMN IF NOT EXISTS _PEERDB_COMMIT_TS INT"
+
 	rawTableMultiValueInsertSQL = "INSERT INTO %s.%s VALUES%s"
 	createNormalizedTableSQL    = "CREATE TABLE IF NOT EXISTS %s(%s)"
 	toVariantColumnName         = "VAR_COLS"
 	mergeStatementSQL           = `MERGE INTO %s TARGET USING (WITH VARIANT_CONVERTED AS (SELECT _PEERDB_UID,
 		_PEERDB_TIMESTAMP,
 		TO_VARIANT(PARSE_JSON(_PEERDB_DATA)) %s,_PEERDB_RECORD_TYPE,_PEERDB_MATCH_DATA,_PEERDB_BATCH_ID,
-		_PEERDB_UNCHANGED_TOAST_COLUMNS FROM
+		_PEERDB_UNCHANGED_TOAST_COLUMNS,_PEERDB_SOURCE_LSN,_PEERDB_COMMIT_TS FROM
 		 _PEERDB_INTERNAL.%s WHERE _PEERDB_BATCH_ID > %d AND _PEERDB_BATCH_ID <= %d AND
 		 _PEERDB_DESTINATION_TABLE_NAME = ? ), FLATTENED AS
 		 (SELECT _PEERDB_UID,_PEERDB_TIMESTAMP,_PEERDB_RECORD_TYPE,_PEERDB_MATCH_DATA,_PEERDB_BATCH_ID,
-			_PEERDB_UNCHANGED_TOAST_COLUMNS,%s
+			_PEERDB_UNCHANGED_TOAST_COLUMNS,_PEERDB_SOURCE_LSN,_PEERDB_COMMIT_TS,%s
 		 FROM VARIANT_CONVERTED), DEDUPLICATED_FLATTENED AS (SELECT _PEERDB_RANKED.* FROM
 		 (SELECT RANK() OVER
 		 (PARTITION BY %s ORDER BY _PEERDB_TIMESTAMP DESC) AS _PEERDB_RANK, * FROM FLATTENED)
@@ -54,6 +60,32 @@ const (
 		 WHEN NOT MATCHED AND (SOURCE._PEERDB_RECORD_TYPE != 2) THEN INSERT (%s) VALUES(%s)
 		 %s
 		 WHEN MATCHED AND (SOURCE._PEERDB_RECORD_TYPE = 2) THEN %s`
+	// in history mode every change becomes a version, the source holds every version to insert
+	// and, with _PEERDB_CLOSE set, the first change of each row to close its current version.
+	// the versions since the last change of a TOAST column share a toast group and take its value,
+	// group 0 means no version in the batch changed it and the current version has it.
+	historyMergeStatementSQL = `MERGE INTO %s TARGET USING (WITH VARIANT_CONVERTED AS (SELECT _PEERDB_UID,
+		_PEERDB_TIMESTAMP,
+		TO_VARIANT(PARSE_JSON(_PEERDB_DATA)) %s,_PEERDB_RECORD_TYPE,_PEERDB_MATCH_DATA,_PEERDB_BATCH_ID,
+		_PEERDB_UNCHANGED_TOAST_COLUMNS,_PEERDB_SOURCE_LSN,_PEERDB_COMMIT_TS FROM
+		 _PEERDB_INTERNAL.%s WHERE _PEERDB_BATCH_ID > %d AND _PEERDB_BATCH_ID <= %d AND
+		 _PEERDB_DESTINATION_TABLE_NAME = ? ), FLATTENED AS
+		 (SELECT _PEERDB_UID,_PEERDB_TIMESTAMP,_PEERDB_RECORD_TYPE,_PEERDB_MATCH_DATA,_PEERDB_BATCH_ID,
+			_PEERDB_UNCHANGED_TOAST_COLUMNS,_PEERDB_SOURCE_LSN,_PEERDB_COMMIT_TS,%s
+		 FROM VARIANT_CONVERTED), VERSIONED AS (SELECT FLATTENED.*,
+		 TO_TIMESTAMP_TZ(_PEERDB_COMMIT_TS, 6) AS _PEERDB_VALID_FROM,
+		 LEAD(TO_TIMESTAMP_TZ(_PEERDB_COMMIT_TS, 6)) OVER
+		 (PARTITION BY %s ORDER BY _PEERDB_TIMESTAMP) AS _PEERDB_VALID_TO,
+		 ROW_NUMBER() OVER (PARTITION BY %s ORDER BY _PEERDB_TIMESTAMP) AS _PEERDB_VERSION%s FROM FLATTENED),
+		 TOASTED AS (SELECT VERSIONED.*%s FROM VERSIONED),
+		 RESOLVED AS (SELECT %s,VERSIONED._PEERDB_RECORD_TYPE,VERSIONED._PEERDB_SOURCE_LSN,
+		 VERSIONED._PEERDB_COMMIT_TS,VERSIONED._PEERDB_VALID_FROM,VERSIONED._PEERDB_VALID_TO,VERSIONED._PEERDB_VERSION
+		 FROM TOASTED VERSIONED LEFT JOIN %s _PEERDB_CURRENT ON %s AND _PEERDB_CURRENT."_PEERDB_IS_CURRENT")
+		 SELECT RESOLVED.*, FALSE AS _PEERDB_CLOSE FROM RESOLVED
+		 UNION ALL SELECT RESOLVED.*, TRUE AS _PEERDB_CLOSE FROM RESOLVED WHERE _PEERDB_VERSION = 1) SOURCE
+		 ON SOURCE._PEERDB_CLOSE AND %s AND TARGET."_PEERDB_IS_CURRENT"
+		 WHEN MATCHED THEN UPDATE SET "_PEERDB_VALID_TO" = SOURCE._PEERDB_VALID_FROM, "_PEERDB_IS_CURRENT" = FALSE
+		 WHEN NOT MATCHED AND NOT SOURCE._PEERDB_CLOSE AND %s THEN INSERT (%s) VALUES(%s)`
 	getDistinctDestinationTableNames = `SELECT DISTINCT _PEERDB_DESTINATION_TABLE_NAME FROM %s.%s WHERE
 	 _PEERDB_BATCH_ID > %d AND _PEERDB_BATCH_ID <= %d`
 	getTableNametoUnchangedColsSQL = `SELECT _PEERDB_DESTINATION_TABLE_NAME,
@@ -76,8 +108,21 @@ const (
 	isDeletedColumnName         = "_PEERDB_IS_DELETED"
 
 	syncRecordsChunkSize = 1024
+
+	defaultStageFileSizeMB        = 64
+	defaultStageUploadParallelism = 4
 )
 
+// Snowflake types of the system columns of normalized tables.
+var systemColumnTypes = map[string]string{
+	utils.SyncedAtColumnName:        "TIMESTAMP_TZ",
+	utils.SourceLSNColumnName:       "INTEGER",
+	utils.CommitTimestampColumnName: "TIMESTAMP_TZ",
+	utils.ValidFromColumnName:       "TIMESTAMP_TZ",
+	utils.ValidToColumnName:         "TIMESTAMP_TZ",
+	utils.IsCurrentColumnName:       "BOOLEAN DEFAULT TRUE",
+}
+
 type tableNameComponents struct {
 	schemaIdentifier string
 	tableIdentifier  string
@@ -87,6 +132,12 @@ type SnowflakeConnector struct {
 	ctx                context.Context
 	database           *sql.DB
 	tableSchemaMapping map[string]*protos.TableSchema
+	// used to stage files on s3:// staging paths.
+	stagingS3Connection *protos.S3ConnectionConfig
+	// size in bytes of the Avro files streamed to internal stages.
+	stageFileSize int
+	// number of Avro files uploaded to internal stages at once.
+	stageUploadParallelism int
 }
 
 type snowflakeRawRecord struct {
@@ -98,6 +149,8 @@ type snowflakeRawRecord struct {
 	matchData             string
 	batchID               int64
 	unchangedToastColumns string
+	sourceLSN             int64
+	commitTimestamp       int64
 }
 
 // creating this to capture array results from snowflake.
@@ -153,10 +206,22 @@ func NewSnowflakeConnector(ctx context.Context,
 		return nil, fmt.Errorf("failed to open connection to Snowflake peer: %w", err)
 	}
 
+	stageFileSizeMB := defaultStageFileSizeMB
+	if snowflakeProtoConfig.StageFileSizeMb > 0 {
+		stageFileSizeMB = int(snowflakeProtoConfig.StageFileSizeMb)
+	}
+	stageUploadParallelism := defaultStageUploadParallelism
+	if snowflakeProtoConfig.StageUploadParallelism > 0 {
+		stageUploadParallelism = int(snowflakeProtoConfig.StageUploadParallelism)
+	}
+
 	return &SnowflakeConnector{
-		ctx:                ctx,
-		database:           database,
-		tableSchemaMapping: nil,
+		ctx:                    ctx,
+		database:               database,
+		tableSchemaMapping:     nil,
+		stagingS3Connection:    snowflakeProtoConfig.StagingS3Connection,
+		stageFileSize:          stageFileSizeMB * 1024 * 1024,
+		stageUploadParallelism: stageUploadParallelism,
 	}, nil
 }
 
@@ -341,12 +406,20 @@ func (c *SnowflakeConnector) SetupNormalizedTables(
 		if err != nil {
 			return nil, fmt.Errorf("error occurred while checking if normalized table exists: %w", err)
 		}
+		tableOptions := req.SnowflakeTableOptions[tableIdentifier]
 		if tableAlreadyExists {
+			if tableOptions != nil {
+				err = c.reconcileTableOptions(tableIdentifier, tableSchema, tableOptions)
+				if err != nil {
+					return nil, err
+				}
+			}
 			tableExistsMapping[tableIdentifier] = true
 			continue
 		}
 
-		normalizedTableCreateSQL := generateCreateTableSQLForNormalizedTable(tableIdentifier, tableSchema)
+		normalizedTableCreateSQL := generateCreateTableSQLForNormalizedTable(tableIdentifier, tableSchema,
+			req.SystemColumns, req.HistoryMode, tableOptions)
 		_, err = c.database.ExecContext(c.ctx, normalizedTableCreateSQL)
 		if err != nil {
 			return nil, fmt.Errorf("[sf] error while creating normalized table: %w", err)
@@ -387,6 +460,11 @@ func (c *SnowflakeConnector) SyncRecords(req *model.SyncRecordsRequest) (*model.
 	}
 	syncBatchID = syncBatchID + 1
 
+	err = c.addRawTableSystemColumns(rawTableIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
 	// transaction for SyncRecords
 	syncRecordsTx, err := c.database.BeginTx(c.ctx, nil)
 	if err != nil {
@@ -446,7 +524,12 @@ func (c *SnowflakeConnector) syncRecordsViaSQL(req *model.SyncRecordsRequest, ra
 			// json.Marshal converts bytes in Hex automatically to BASE64 string.
 			itemsJSON, err := typedRecord.Items.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to serialize insert record items to JSON: %w", err)
+				err = req.SkipFailedRecord(record,
+					fmt.Errorf("failed to serialize insert record items to JSON: %w", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			// add insert record to the raw table
@@ -459,16 +542,28 @@ func (c *SnowflakeConnector) syncRecordsViaSQL(req *model.SyncRecordsRequest, ra
 				matchData:             "",
 				batchID:               syncBatchID,
 				unchangedToastColumns: utils.KeysToString(typedRecord.UnchangedToastColumns),
+				sourceLSN:             typedRecord.CheckPointID,
+				commitTimestamp:       typedRecord.CommitTime.UnixMicro(),
 			})
 			tableNameRowsMapping[typedRecord.DestinationTableName] += 1
 		case *model.UpdateRecord:
 			newItemsJSON, err := typedRecord.NewItems.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to serialize update record new items to JSON: %w", err)
+				err = req.SkipFailedRecord(record,
+					fmt.Errorf("failed to serialize update record new items to JSON: %w", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 			oldItemsJSON, err := typedRecord.OldItems.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to serialize update record old items to JSON: %w", err)
+				err = req.SkipFailedRecord(record,
+					fmt.Errorf("failed to serialize update record old items to JSON: %w", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			// add update record to the raw table
@@ -481,12 +576,19 @@ func (c *SnowflakeConnector) syncRecordsViaSQL(req *model.SyncRecordsRequest, ra
 				matchData:             oldItemsJSON,
 				batchID:               syncBatchID,
 				unchangedToastColumns: utils.KeysToString(typedRecord.UnchangedToastColumns),
+				sourceLSN:             typedRecord.CheckPointID,
+				commitTimestamp:       typedRecord.CommitTime.UnixMicro(),
 			})
 			tableNameRowsMapping[typedRecord.DestinationTableName] += 1
 		case *model.DeleteRecord:
 			itemsJSON, err := typedRecord.Items.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to serialize delete record items to JSON: %w", err)
+				err = req.SkipFailedRecord(record,
+					fmt.Errorf("failed to serialize delete record items to JSON: %w", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			// append delete record to the raw table
@@ -499,6 +601,8 @@ func (c *SnowflakeConnector) syncRecordsViaSQL(req *model.SyncRecordsRequest, ra
 				matchData:             itemsJSON,
 				batchID:               syncBatchID,
 				unchangedToastColumns: utils.KeysToString(typedRecord.UnchangedToastColumns),
+				sourceLSN:             typedRecord.CheckPointID,
+				commitTimestamp:       typedRecord.CommitTime.UnixMicro(),
 			})
 			tableNameRowsMapping[typedRecord.DestinationTableName] += 1
 		default:
@@ -582,6 +686,16 @@ func (c *SnowflakeConnector) syncRecordsViaAvro(req *model.SyncRecordsRequest, r
 				Type:     qvalue.QValueKindString,
 				Nullable: true,
 			},
+			{
+				Name:     "_peerdb_source_lsn",
+				Type:     qvalue.QValueKindInt64,
+				Nullable: true,
+			},
+			{
+				Name:     "_peerdb_commit_ts",
+				Type:     qvalue.QValueKindInt64,
+				Nullable: true,
+			},
 		},
 	})
 	if err != nil {
@@ -594,13 +708,18 @@ func (c *SnowflakeConnector) syncRecordsViaAvro(req *model.SyncRecordsRequest, r
 	tableNameRowsMapping := make(map[string]uint32)
 
 	for _, record := range req.Records.Records {
-		var entries [8]qvalue.QValue
+		var entries [10]qvalue.QValue
 		switch typedRecord := record.(type) {
 		case *model.InsertRecord:
 			// json.Marshal converts bytes in Hex automatically to BASE64 string.
 			itemsJSON, err := typedRecord.Items.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to serialize insert record items to JSON: %w", err)
+				err = req.SkipFailedRecord(record,
+					fmt.Errorf("failed to serialize insert record items to JSON: %w", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			// add insert record to the raw table
@@ -628,11 +747,21 @@ func (c *SnowflakeConnector) syncRecordsViaAvro(req *model.SyncRecordsRequest, r
 		case *model.UpdateRecord:
 			newItemsJSON, err := typedRecord.NewItems.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to serialize update record new items to JSON: %w", err)
+				err = req.SkipFailedRecord(record,
+					fmt.Errorf("failed to serialize update record new items to JSON: %w", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 			oldItemsJSON, err := typedRecord.OldItems.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to serialize update record old items to JSON: %w", err)
+				err = req.SkipFailedRecord(record,
+					fmt.Errorf("failed to serialize update record old items to JSON: %w", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			// add update record to the raw table
@@ -660,7 +789,12 @@ func (c *SnowflakeConnector) syncRecordsViaAvro(req *model.SyncRecordsRequest, r
 		case *model.DeleteRecord:
 			itemsJSON, err := typedRecord.Items.ToJSON()
 			if err != nil {
-				return nil, fmt.Errorf("failed to serialize delete record items to JSON: %w", err)
+				err = req.SkipFailedRecord(record,
+					fmt.Errorf("failed to serialize delete record items to JSON: %w", err))
+				if err != nil {
+					return nil, err
+				}
+				continue
 			}
 
 			// append delete record to the raw table
@@ -706,10 +840,18 @@ func (c *SnowflakeConnector) syncRecordsViaAvro(req *model.SyncRecordsRequest, r
 			Kind:  qvalue.QValueKindInt64,
 			Value: syncBatchID,
 		}
+		entries[8] = qvalue.QValue{
+			Kind:  qvalue.QValueKindInt64,
+			Value: record.GetCheckPointID(),
+		}
+		entries[9] = qvalue.QValue{
+			Kind:  qvalue.QValueKindInt64,
+			Value: record.GetCommitTime().UnixMicro(),
+		}
 
 		recordStream.Records <- &model.QRecordOrError{
 			Record: &model.QRecord{
-				NumEntries: 8,
+				NumEntries: 10,
 				Entries:    entries[:],
 			},
 		}
@@ -783,6 +925,11 @@ func (c *SnowflakeConnector) NormalizeRecords(req *model.NormalizeRecordsRequest
 		return nil, fmt.Errorf("couldn't tablename to unchanged cols mapping: %w", err)
 	}
 
+	err = c.addRawTableSystemColumns(getRawTableIdentifier(req.FlowJobName))
+	if err != nil {
+		return nil, err
+	}
+
 	// transaction for NormalizeRecords
 	normalizeRecordsTx, err := c.database.BeginTx(c.ctx, nil)
 	if err != nil {
@@ -808,6 +955,8 @@ func (c *SnowflakeConnector) NormalizeRecords(req *model.NormalizeRecordsRequest
 			getRawTableIdentifier(req.FlowJobName),
 			syncBatchID, normalizeBatchID,
 			req.SoftDelete,
+			req.SystemColumns,
+			req.HistoryMode,
 			normalizeRecordsTx)
 		if err != nil {
 			return nil, err
@@ -861,6 +1010,10 @@ func (c *SnowflakeConnector) CreateRawTable(req *protos.CreateRawTableInput) (*p
 	if err != nil {
 		return nil, fmt.Errorf("unable to commit transaction for creation of raw table: %w", err)
 	}
+	err = c.addRawTableSystemColumns(rawTableIdentifier)
+	if err != nil {
+		return nil, err
+	}
 
 	if req.CdcSyncMode == protos.QRepSyncMode_QREP_SYNC_MODE_STORAGE_AVRO {
 		stage := c.getStageNameForJob(req.FlowJobName)
@@ -943,17 +1096,26 @@ func (c *SnowflakeConnector) checkIfTableExists(schemaIdentifier string, tableId
 func generateCreateTableSQLForNormalizedTable(
 	sourceTableIdentifier string,
 	sourceTableSchema *protos.TableSchema,
+	systemColumns *protos.SystemColumns,
+	historyMode bool,
+	tableOptions *protos.SnowflakeTableOptions,
 ) string {
 	createTableSQLArray := make([]string, 0, len(sourceTableSchema.Columns))
 	primaryColUpper := strings.ToUpper(sourceTableSchema.PrimaryKeyColumn)
+	fanIn := sourceTableSchema.SourceIdentifierColumn != ""
 	for columnName, genericColumnType := range sourceTableSchema.Columns {
 		columnNameUpper := strings.ToUpper(columnName)
-		if primaryColUpper == columnNameUpper {
-			createTableSQLArray = append(createTableSQLArray, fmt.Sprintf(`"%s" %s PRIMARY KEY,`,
-				columnNameUpper, qValueKindToSnowflakeType(qvalue.QValueKind(genericColumnType))))
+		columnComment := ""
+		if comment, ok := sourceTableSchema.ColumnComments[columnName]; ok && tableOptions.GetColumnComments() {
+			columnComment = " COMMENT " + snowflakeString(comment)
+		}
+		// in history mode a row has many versions, so the primary key is not unique
+		if !fanIn && !historyMode && primaryColUpper == columnNameUpper {
+			createTableSQLArray = append(createTableSQLArray, fmt.Sprintf(`"%s" %s PRIMARY KEY%s,`,
+				columnNameUpper, qValueKindToSnowflakeType(qvalue.QValueKind(genericColumnType)), columnComment))
 		} else {
-			createTableSQLArray = append(createTableSQLArray, fmt.Sprintf(`"%s" %s,`, columnNameUpper,
-				qValueKindToSnowflakeType(qvalue.QValueKind(genericColumnType))))
+			createTableSQLArray = append(createTableSQLArray, fmt.Sprintf(`"%s" %s%s,`, columnNameUpper,
+				qValueKindToSnowflakeType(qvalue.QValueKind(genericColumnType)), columnComment))
 		}
 	}
 
@@ -961,9 +1123,33 @@ func generateCreateTableSQLForNormalizedTable(
 	// this is boolean default false, and is used to mark records as deleted
 	createTableSQLArray = append(createTableSQLArray,
 		fmt.Sprintf(`"%s" BOOLEAN DEFAULT FALSE,`, isDeletedColumnName))
+	for _, columnName := range utils.GetSystemColumnNames(false, systemColumns) {
+		createTableSQLArray = append(createTableSQLArray, fmt.Sprintf(`"%s" %s,`,
+			strings.ToUpper(columnName), systemColumnTypes[columnName]))
+	}
+	if historyMode {
+		for _, columnName := range utils.GetHistoryColumnNames() {
+			createTableSQLArray = append(createTableSQLArray, fmt.Sprintf(`"%s" %s,`,
+				strings.ToUpper(columnName), systemColumnTypes[columnName]))
+		}
+	}
+
+	// fan-in tables have a composite primary key that includes the source identifier column
+	if fanIn && !historyMode {
+		primaryKeyColumns := utils.GetPrimaryKeyColumns(sourceTableSchema)
+		for i, columnName := range primaryKeyColumns {
+			primaryKeyColumns[i] = fmt.Sprintf(`"%s"`, strings.ToUpper(columnName))
+		}
+		createTableSQLArray = append(createTableSQLArray, fmt.Sprintf("PRIMARY KEY(%s),",
+			strings.Join(primaryKeyColumns, ",")))
+	}
 
-	return fmt.Sprintf(createNormalizedTableSQL, sourceTableIdentifier,
-		strings.TrimSuffix(strings.Join(createTableSQLArray, ""), ","))
+	createSQL := createNormalizedTableSQL
+	if tableOptions.GetTransient() {
+		createSQL = createTransientNormalizedTableSQL
+	}
+	return fmt.Sprintf(createSQL, sourceTableIdentifier,
+		strings.TrimSuffix(strings.Join(createTableSQLArray, ""), ",")) + tableOptionsClause(tableOptions)
 }
 
 func generateMultiValueInsertSQL(tableIdentifier string, chunkSize int) string {
@@ -975,6 +1161,28 @@ func generateMultiValueInsertSQL(tableIdentifier string, chunkSize int) string {
 			strings.TrimSuffix(strings.Repeat("?,", rawTableWidth), ",")), chunkSize), ","))
 }
 
+// addRawTableSystemColumns adds the source LSN and commit timestamp columns to a raw table created
+// before they existed. DDL commits the open transaction in Snowflake, so this runs outside of one.
+func (c *SnowflakeConnector) addRawTableSystemColumns(rawTableIdentifier string) error {
+	var numColumns int
+	err := c.database.QueryRowContext(c.ctx, countRawTableSystemColumnsSQL, peerDBInternalSchema,
+		strings.ToUpper(rawTableIdentifier)).Scan(&numColumns)
+	if err != nil {
+		return fmt.Errorf("error checking columns of raw table %s: %w", rawTableIdentifier, err)
+	}
+	if numColumns == 2 {
+		return nil
+	}
+
+	for _, addColumnSQL := range []string{addRawTableSourceLSNColumnSQL, addRawTableCommitTSColumnSQL} {
+		_, err = c.database.ExecContext(c.ctx, fmt.Sprintf(addColumnSQL, peerDBInternalSchema, rawTableIdentifier))
+		if err != nil {
+			return fmt.Errorf("error adding system columns to raw table %s: %w", rawTableIdentifier, err)
+		}
+	}
+	return nil
+}
+
 func getRawTableIdentifier(jobName string) string {
 	jobName = regexp.MustCompile("[^a-zA-Z0-9]+").ReplaceAllString(jobName, "_")
 	return fmt.Sprintf("%s_%s", rawTablePrefix, jobName)
@@ -986,7 +1194,8 @@ func (c *SnowflakeConnector) insertRecordsInRawTable(rawTableIdentifier string,
 
 	for _, record := range snowflakeRawRecords {
 		rawRecordsData = append(rawRecordsData, record.uid, record.timestamp, record.destinationTableName,
-			record.data, record.recordType, record.matchData, record.batchID, record.unchangedToastColumns)
+			record.data, record.recordType, record.matchData, record.batchID, record.unchangedToastColumns,
+			record.sourceLSN, record.commitTimestamp)
 	}
 	_, err := syncRecordsTx.ExecContext(c.ctx,
 		generateMultiValueInsertSQL(rawTableIdentifier, len(snowflakeRawRecords)), rawRecordsData...)
@@ -1003,6 +1212,8 @@ func (c *SnowflakeConnector) generateAndExecuteMergeStatement(
 	syncBatchID int64,
 	normalizeBatchID int64,
 	softDelete bool,
+	systemColumns *protos.SystemColumns,
+	historyMode bool,
 	normalizeRecordsTx *sql.Tx,
 ) (int64, error) {
 	normalizedTableSchema := c.tableSchemaMapping[destinationTableIdentifier]
@@ -1032,6 +1243,8 @@ func (c *SnowflakeConnector) generateAndExecuteMergeStatement(
 	for _, columnName := range columnNames {
 		quotedUpperColNames = append(quotedUpperColNames, fmt.Sprintf(`"%s"`, strings.ToUpper(columnName)))
 	}
+	systemColumnNames, systemColumnValues := generateSystemColumnValues(softDelete, systemColumns, false)
+	quotedUpperColNames = append(quotedUpperColNames, systemColumnNames...)
 	insertColumnsSQL := strings.TrimSuffix(strings.Join(quotedUpperColNames, ","), ",")
 
 	insertValuesSQLArray := make([]string, 0, len(columnNames))
@@ -1039,24 +1252,41 @@ func (c *SnowflakeConnector) generateAndExecuteMergeStatement(
 		quotedUpperColumnName := fmt.Sprintf(`"%s"`, strings.ToUpper(columnName))
 		insertValuesSQLArray = append(insertValuesSQLArray, fmt.Sprintf("SOURCE.%s,", quotedUpperColumnName))
 	}
+	for _, systemColumnValue := range systemColumnValues {
+		insertValuesSQLArray = append(insertValuesSQLArray, systemColumnValue+",")
+	}
 	insertValuesSQL := strings.TrimSuffix(strings.Join(insertValuesSQLArray, ""), ",")
 
-	updateStatementsforToastCols := c.generateUpdateStatement(columnNames, unchangedToastColumns)
+	updateStatementsforToastCols := c.generateUpdateStatement(columnNames, unchangedToastColumns,
+		generateSystemColumnSets(softDelete, systemColumns, false))
 	updateStringToastCols := strings.Join(updateStatementsforToastCols, " ")
 
-	// TARGET.<pkey> = SOURCE.<pkey>
-	pkeyColStr := fmt.Sprintf("TARGET.%s = SOURCE.%s",
-		normalizedTableSchema.PrimaryKeyColumn, normalizedTableSchema.PrimaryKeyColumn)
+	// TARGET.<pkey> = SOURCE.<pkey>, fan-in tables also match on the source identifier column
+	primaryKeyColumns := utils.GetPrimaryKeyColumns(normalizedTableSchema)
+	pkeyColStrArray := make([]string, 0, len(primaryKeyColumns))
+	for _, primaryKeyColumn := range primaryKeyColumns {
+		pkeyColStrArray = append(pkeyColStrArray, fmt.Sprintf("TARGET.%s = SOURCE.%s",
+			primaryKeyColumn, primaryKeyColumn))
+	}
+	pkeyColStr := strings.Join(pkeyColStrArray, " AND ")
 
 	deletePart := "DELETE"
 	if softDelete {
-		deletePart = fmt.Sprintf("UPDATE SET %s = TRUE", isDeletedColumnName)
+		deletePart = fmt.Sprintf("UPDATE SET %s",
+			strings.Join(generateSystemColumnSets(softDelete, systemColumns, true), ", "))
 	}
 
-	mergeStatement := fmt.Sprintf(mergeStatementSQL, destinationTableIdentifier, toVariantColumnName,
-		rawTableIdentifier, normalizeBatchID, syncBatchID, flattenedCastsSQL,
-		normalizedTableSchema.PrimaryKeyColumn, pkeyColStr, insertColumnsSQL, insertValuesSQL,
-		updateStringToastCols, deletePart)
+	var mergeStatement string
+	if historyMode {
+		mergeStatement = generateHistoryMergeStatement(destinationTableIdentifier, normalizedTableSchema,
+			unchangedToastColumns, rawTableIdentifier, syncBatchID, normalizeBatchID, flattenedCastsSQL,
+			softDelete, systemColumns)
+	} else {
+		mergeStatement = fmt.Sprintf(mergeStatementSQL, destinationTableIdentifier, toVariantColumnName,
+			rawTableIdentifier, normalizeBatchID, syncBatchID, flattenedCastsSQL,
+			strings.Join(primaryKeyColumns, ","), pkeyColStr, insertColumnsSQL, insertValuesSQL,
+			updateStringToastCols, deletePart)
+	}
 
 	result, err := normalizeRecordsTx.ExecContext(c.ctx, mergeStatement, destinationTableIdentifier)
 	if err != nil {
@@ -1067,6 +1297,95 @@ func (c *SnowflakeConnector) generateAndExecuteMergeStatement(
 	return result.RowsAffected()
 }
 
+// generateHistoryMergeStatement generates the MERGE that normalizes a batch in history mode, unchanged
+// TOAST columns are taken from the latest earlier version of the row that has them.
+func generateHistoryMergeStatement(
+	destinationTableIdentifier string,
+	normalizedTableSchema *protos.TableSchema,
+	unchangedToastColumns []string,
+	rawTableIdentifier string,
+	syncBatchID int64,
+	normalizeBatchID int64,
+	flattenedCastsSQL string,
+	softDelete bool,
+	systemColumns *protos.SystemColumns,
+) string {
+	toastColumns := make(map[string]struct{})
+	for _, cols := range unchangedToastColumns {
+		for _, col := range strings.Split(cols, ",") {
+			if col != "" {
+				toastColumns[col] = struct{}{}
+			}
+		}
+	}
+
+	primaryKeyColumns := utils.GetPrimaryKeyColumns(normalizedTableSchema)
+	partitionBySQL := strings.Join(primaryKeyColumns, ",")
+
+	toastSQL := utils.GenerateHistoryToastSQL(toastColumns, &utils.HistoryToastDialect{
+		QuoteIdentifier: systemColumnDialect.QuoteIdentifier,
+		UnchangedCondition: func(columnName string) string {
+			return fmt.Sprintf("ARRAY_CONTAINS('%s'::VARIANT, SPLIT(COALESCE(_PEERDB_UNCHANGED_TOAST_COLUMNS, ''), ','))",
+				columnName)
+		},
+		GroupPartitionBy: partitionBySQL,
+		ValuePartitionBy: partitionBySQL,
+		OrderBy:          "_PEERDB_TIMESTAMP",
+		VersionPrefix:    "VERSIONED.",
+		CurrentPrefix:    "_PEERDB_CURRENT.",
+	})
+
+	resolvedColumnsSQLArray := make([]string, 0, len(normalizedTableSchema.Columns))
+	insertColumnsSQLArray := make([]string, 0, len(normalizedTableSchema.Columns))
+	insertValuesSQLArray := make([]string, 0, len(normalizedTableSchema.Columns))
+	for columnName := range normalizedTableSchema.Columns {
+		quotedUpperColumnName := fmt.Sprintf(`"%s"`, strings.ToUpper(columnName))
+		if resolved, ok := toastSQL.Resolved[columnName]; ok {
+			resolvedColumnsSQLArray = append(resolvedColumnsSQLArray, fmt.Sprintf("%s AS %s", resolved,
+				quotedUpperColumnName))
+		} else {
+			resolvedColumnsSQLArray = append(resolvedColumnsSQLArray, "VERSIONED."+quotedUpperColumnName)
+		}
+		insertColumnsSQLArray = append(insertColumnsSQLArray, quotedUpperColumnName)
+		insertValuesSQLArray = append(insertValuesSQLArray, "SOURCE."+quotedUpperColumnName)
+	}
+
+	currentOnSQLArray := make([]string, 0, len(primaryKeyColumns))
+	targetOnSQLArray := make([]string, 0, len(primaryKeyColumns))
+	for _, primaryKeyColumn := range primaryKeyColumns {
+		currentOnSQLArray = append(currentOnSQLArray, fmt.Sprintf("_PEERDB_CURRENT.%s = VERSIONED.%s",
+			primaryKeyColumn, primaryKeyColumn))
+		targetOnSQLArray = append(targetOnSQLArray, fmt.Sprintf("TARGET.%s = SOURCE.%s",
+			primaryKeyColumn, primaryKeyColumn))
+	}
+
+	// with soft delete, a delete inserts a version marked as deleted instead of only closing the row
+	systemColumnNames, systemColumnValues := generateSystemColumnValues(softDelete, systemColumns, false)
+	for i, columnName := range systemColumnNames {
+		if columnName == fmt.Sprintf(`"%s"`, isDeletedColumnName) {
+			systemColumnValues[i] = "(SOURCE._PEERDB_RECORD_TYPE = 2)"
+		}
+	}
+	insertColumnsSQLArray = append(insertColumnsSQLArray, systemColumnNames...)
+	insertValuesSQLArray = append(insertValuesSQLArray, systemColumnValues...)
+	for _, columnName := range utils.GetHistoryColumnNames() {
+		insertColumnsSQLArray = append(insertColumnsSQLArray, fmt.Sprintf(`"%s"`, strings.ToUpper(columnName)))
+	}
+	insertValuesSQLArray = append(insertValuesSQLArray, "SOURCE._PEERDB_VALID_FROM", "SOURCE._PEERDB_VALID_TO",
+		"SOURCE._PEERDB_VALID_TO IS NULL")
+	insertConditionSQL := "(SOURCE._PEERDB_RECORD_TYPE != 2)"
+	if softDelete {
+		insertConditionSQL = "TRUE"
+	}
+
+	return fmt.Sprintf(historyMergeStatementSQL, destinationTableIdentifier, toVariantColumnName,
+		rawTableIdentifier, normalizeBatchID, syncBatchID, flattenedCastsSQL, partitionBySQL, partitionBySQL,
+		toastSQL.GroupsSQL, toastSQL.ValuesSQL,
+		strings.Join(resolvedColumnsSQLArray, ","), destinationTableIdentifier,
+		strings.Join(currentOnSQLArray, " AND "), strings.Join(targetOnSQLArray, " AND "), insertConditionSQL,
+		strings.Join(insertColumnsSQLArray, ","), strings.Join(insertValuesSQLArray, ","))
+}
+
 // parseTableName parses a table name into schema and table name.
 func parseTableName(tableName string) (*tableNameComponents, error) {
 	parts := strings.Split(tableName, ".")
@@ -1171,7 +1490,8 @@ and updating the other columns.
 6. Repeat steps 1-5 for each unique set of unchanged toast column groups.
 7. Return the list of generated update statements.
 */
-func (c *SnowflakeConnector) generateUpdateStatement(allCols []string, unchangedToastCols []string) []string {
+func (c *SnowflakeConnector) generateUpdateStatement(allCols []string, unchangedToastCols []string,
+	systemColumnSets []string) []string {
 	updateStmts := make([]string, 0)
 
 	for _, cols := range unchangedToastCols {
@@ -1182,6 +1502,7 @@ func (c *SnowflakeConnector) generateUpdateStatement(allCols []string, unchanged
 			quotedUpperColName := fmt.Sprintf(`"%s"`, strings.ToUpper(colName))
 			tmpArray = append(tmpArray, fmt.Sprintf("%s = SOURCE.%s", quotedUpperColName, quotedUpperColName))
 		}
+		tmpArray = append(tmpArray, systemColumnSets...)
 		ssep := strings.Join(tmpArray, ", ")
 		updateStmt := fmt.Sprintf(`WHEN MATCHED AND
 		(SOURCE._PEERDB_RECORD_TYPE != 2) AND _PEERDB_UNCHANGED_TOAST_COLUMNS='%s'
@@ -1190,3 +1511,23 @@ func (c *SnowflakeConnector) generateUpdateStatement(allCols []string, unchanged
 	}
 	return updateStmts
 }
+
+// systemColumnDialect is how a MERGE computes the system columns from its SOURCE.
+var systemColumnDialect = &utils.SystemColumnDialect{
+	QuoteIdentifier: func(columnName string) string {
+		return fmt.Sprintf(`"%s"`, strings.ToUpper(columnName))
+	},
+	CurrentTimestamp: "CURRENT_TIMESTAMP",
+	SourceLSN:        "SOURCE._PEERDB_SOURCE_LSN",
+	CommitTimestamp:  "TO_TIMESTAMP_TZ(SOURCE._PEERDB_COMMIT_TS, 6)",
+	SetSeparator:     " = ",
+}
+
+func generateSystemColumnValues(softDelete bool, systemColumns *protos.SystemColumns,
+	deleted bool) ([]string, []string) {
+	return utils.GenerateSystemColumnValues(softDelete, systemColumns, systemColumnDialect, deleted)
+}
+
+func generateSystemColumnSets(softDelete bool, systemColumns *protos.SystemColumns, deleted bool) []string {
+	return utils.GenerateSystemColumnSets(softDelete, systemColumns, systemColumnDialect, deleted)
+}
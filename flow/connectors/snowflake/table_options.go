@@ -0,0 +1,248 @@
+package connsnowflake
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	getTableOptionsSQL = `SELECT IS_TRANSIENT, COALESCE(CLUSTERING_KEY, ''), RETENTION_TIME
+	 FROM INFORMATION_SCHEMA.TABLES WHERE TABLE_SCHEMA=? AND TABLE_NAME=?`
+	getColumnCommentsSQL = `SELECT COLUMN_NAME, COALESCE(COMMENT, '') FROM INFORMATION_SCHEMA.COLUMNS
+	 WHERE TABLE_SCHEMA=? AND TABLE_NAME=?`
+	getTableTagsSQL = `SELECT TAG_DATABASE, TAG_SCHEMA, TAG_NAME
+	 FROM TABLE(INFORMATION_SCHEMA.TAG_REFERENCES(?, 'table')) WHERE LEVEL = 'TABLE'`
+	createTransientNormalizedTableSQL = "CREATE TRANSIENT TABLE IF NOT EXISTS %s(%s)"
+	alterClusterBySQL                 = "ALTER TABLE %s CLUSTER BY (%s)"
+	alterDropClusteringKeySQL         = "ALTER TABLE %s DROP CLUSTERING KEY"
+	alterDataRetentionSQL             = "ALTER TABLE %s SET DATA_RETENTION_TIME_IN_DAYS = %d"
+	alterColumnCommentSQL             = `ALTER TABLE %s ALTER COLUMN "%s" COMMENT %s`
+	alterUnsetColumnCommentSQL        = `ALTER TABLE %s ALTER COLUMN "%s" UNSET COMMENT`
+	alterSetTagsSQL                   = "ALTER TABLE %s SET TAG %s"
+	alterUnsetTagsSQL                 = "ALTER TABLE %s UNSET TAG %s"
+)
+
+// snowflakeString quotes a string literal.
+func snowflakeString(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, "'", "''").Replace(value) + "'"
+}
+
+// normalizeClusteringKey makes clustering keys comparable, Snowflake reports them as LINEAR(A, B).
+func normalizeClusteringKey(clusteringKey string) string {
+	clusteringKey = strings.TrimSpace(clusteringKey)
+	if strings.HasPrefix(strings.ToUpper(clusteringKey), "LINEAR(") && strings.HasSuffix(clusteringKey, ")") {
+		clusteringKey = clusteringKey[len("LINEAR(") : len(clusteringKey)-1]
+	}
+	return strings.ToUpper(strings.NewReplacer(" ", "", `"`, "").Replace(clusteringKey))
+}
+
+// tagsClause returns the tags of a table as tag = 'value' pairs, sorted by tag.
+func tagsClause(tags map[string]string) string {
+	tagNames := make([]string, 0, len(tags))
+	for tagName := range tags {
+		tagNames = append(tagNames, tagName)
+	}
+	sort.Strings(tagNames)
+
+	pairs := make([]string, 0, len(tags))
+	for _, tagName := range tagNames {
+		pairs = append(pairs, fmt.Sprintf("%s = %s", tagName, snowflakeString(tags[tagName])))
+	}
+	return strings.Join(pairs, ", ")
+}
+
+// tableOptionsClause returns the clustering, retention and tags of a create table statement.
+func tableOptionsClause(options *protos.SnowflakeTableOptions) string {
+	var clause strings.Builder
+	if len(options.GetClusterBy()) > 0 {
+		clause.WriteString(fmt.Sprintf(" CLUSTER BY (%s)", strings.Join(options.ClusterBy, ",")))
+	}
+	if options != nil && options.DataRetentionDays != nil {
+		clause.WriteString(fmt.Sprintf(" DATA_RETENTION_TIME_IN_DAYS = %d", *options.DataRetentionDays))
+	}
+	if len(options.GetTags()) > 0 {
+		clause.WriteString(fmt.Sprintf(" WITH TAG (%s)", tagsClause(options.Tags)))
+	}
+	return clause.String()
+}
+
+// reconcileTableOptions brings an existing normalized table in line with its options,
+// only the options that differ from the table are altered.
+func (c *SnowflakeConnector) reconcileTableOptions(
+	tableIdentifier string,
+	tableSchema *protos.TableSchema,
+	options *protos.SnowflakeTableOptions,
+) error {
+	components, err := parseTableName(tableIdentifier)
+	if err != nil {
+		return fmt.Errorf("error while parsing table schema and name: %w", err)
+	}
+	schemaName := strings.ToUpper(components.schemaIdentifier)
+	tableName := strings.ToUpper(components.tableIdentifier)
+
+	var isTransient, clusteringKey string
+	var retentionDays int32
+	err = c.database.QueryRowContext(c.ctx, getTableOptionsSQL, schemaName, tableName).
+		Scan(&isTransient, &clusteringKey, &retentionDays)
+	if err != nil {
+		return fmt.Errorf("failed to get options of table %s: %w", tableIdentifier, err)
+	}
+
+	var statements []string
+	if options.Transient != (isTransient == "YES") {
+		log.WithFields(log.Fields{
+			"table": tableIdentifier,
+		}).Warnf("table is transient: %s, but transient is %t in its options, recreate the table to change it",
+			isTransient, options.Transient)
+	}
+	if normalizeClusteringKey(clusteringKey) != normalizeClusteringKey(strings.Join(options.ClusterBy, ",")) {
+		if len(options.ClusterBy) == 0 {
+			statements = append(statements, fmt.Sprintf(alterDropClusteringKeySQL, tableIdentifier))
+		} else {
+			statements = append(statements, fmt.Sprintf(alterClusterBySQL, tableIdentifier,
+				strings.Join(options.ClusterBy, ",")))
+		}
+	}
+	if options.DataRetentionDays != nil && *options.DataRetentionDays != retentionDays {
+		statements = append(statements, fmt.Sprintf(alterDataRetentionSQL, tableIdentifier,
+			*options.DataRetentionDays))
+	}
+	if options.ColumnComments {
+		commentStatements, err := c.columnCommentStatements(tableIdentifier, schemaName, tableName, tableSchema)
+		if err != nil {
+			return err
+		}
+		statements = append(statements, commentStatements...)
+	}
+	// tags are always set again, and the tags of the table that are no longer in its options are unset.
+	if len(options.Tags) > 0 {
+		statements = append(statements, fmt.Sprintf(alterSetTagsSQL, tableIdentifier, tagsClause(options.Tags)))
+	}
+	tagStatement, err := c.unsetTagsStatement(tableIdentifier, options.Tags)
+	if err != nil {
+		return err
+	}
+	if tagStatement != "" {
+		statements = append(statements, tagStatement)
+	}
+
+	for _, statement := range statements {
+		if _, err := c.database.ExecContext(c.ctx, statement); err != nil {
+			return fmt.Errorf("failed to reconcile options of table %s: %w", tableIdentifier, err)
+		}
+	}
+	return nil
+}
+
+// columnCommentStatements returns the statements setting the column comments of a table that differ
+// from the comments of the source columns, the comments of source columns without one are unset.
+func (c *SnowflakeConnector) columnCommentStatements(
+	tableIdentifier string,
+	schemaName string,
+	tableName string,
+	tableSchema *protos.TableSchema,
+) ([]string, error) {
+	rows, err := c.database.QueryContext(c.ctx, getColumnCommentsSQL, schemaName, tableName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get column comments of table %s: %w", tableIdentifier, err)
+	}
+	defer rows.Close()
+
+	currentComments := make(map[string]string)
+	for rows.Next() {
+		var columnName, comment string
+		if err := rows.Scan(&columnName, &comment); err != nil {
+			return nil, fmt.Errorf("failed to read column comment of table %s: %w", tableIdentifier, err)
+		}
+		currentComments[columnName] = comment
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read column comments of table %s: %w", tableIdentifier, err)
+	}
+
+	return commentStatements(tableIdentifier, tableSchema, currentComments), nil
+}
+
+// commentStatements compares the comments of the source columns with the current comments of the table,
+// keyed by upper case column name.
+func commentStatements(tableIdentifier string, tableSchema *protos.TableSchema,
+	currentComments map[string]string,
+) []string {
+	columnNames := make([]string, 0, len(tableSchema.Columns))
+	for columnName := range tableSchema.Columns {
+		columnNames = append(columnNames, columnName)
+	}
+	sort.Strings(columnNames)
+
+	var statements []string
+	for _, columnName := range columnNames {
+		columnNameUpper := strings.ToUpper(columnName)
+		comment := tableSchema.ColumnComments[columnName]
+		// columns added since the table was created are left to schema changes.
+		currentComment, ok := currentComments[columnNameUpper]
+		if !ok || currentComment == comment {
+			continue
+		}
+		if comment == "" {
+			statements = append(statements, fmt.Sprintf(alterUnsetColumnCommentSQL, tableIdentifier, columnNameUpper))
+		} else {
+			statements = append(statements, fmt.Sprintf(alterColumnCommentSQL, tableIdentifier, columnNameUpper,
+				snowflakeString(comment)))
+		}
+	}
+	return statements
+}
+
+// unsetTagsStatement returns the statement unsetting the tags set on a table that are not in its options,
+// or an empty statement when there are none.
+func (c *SnowflakeConnector) unsetTagsStatement(tableIdentifier string, tags map[string]string) (string, error) {
+	rows, err := c.database.QueryContext(c.ctx, getTableTagsSQL, tableIdentifier)
+	if err != nil {
+		return "", fmt.Errorf("failed to get tags of table %s: %w", tableIdentifier, err)
+	}
+	defer rows.Close()
+
+	var currentTags []string
+	for rows.Next() {
+		var tagDatabase, tagSchema, tagName string
+		if err := rows.Scan(&tagDatabase, &tagSchema, &tagName); err != nil {
+			return "", fmt.Errorf("failed to read tag of table %s: %w", tableIdentifier, err)
+		}
+		currentTags = append(currentTags, fmt.Sprintf(`"%s"."%s"."%s"`, tagDatabase, tagSchema, tagName))
+	}
+	if err := rows.Err(); err != nil {
+		return "", fmt.Errorf("failed to read tags of table %s: %w", tableIdentifier, err)
+	}
+
+	droppedTags := droppedTags(currentTags, tags)
+	if len(droppedTags) == 0 {
+		return "", nil
+	}
+	return fmt.Sprintf(alterUnsetTagsSQL, tableIdentifier, strings.Join(droppedTags, ", ")), nil
+}
+
+// droppedTags returns the tags of a table, as quoted database.schema.name, that none of the tags of its options
+// name. Tags of the options may leave out their database and schema, and are unquoted so upper case.
+func droppedTags(currentTags []string, tags map[string]string) []string {
+	var dropped []string
+	for _, currentTag := range currentTags {
+		qualifiedName := strings.ReplaceAll(currentTag, `"`, "")
+		found := false
+		for tagName := range tags {
+			tagName = strings.ToUpper(tagName)
+			if qualifiedName == tagName || strings.HasSuffix(qualifiedName, "."+tagName) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			dropped = append(dropped, currentTag)
+		}
+	}
+	sort.Strings(dropped)
+	return dropped
+}
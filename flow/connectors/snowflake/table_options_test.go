@@ -0,0 +1,82 @@
+package connsnowflake
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCreateNormalizedTableWithOptions(t *testing.T) {
+	tableSchema := &protos.TableSchema{
+		Columns:          map[string]string{"id": "int64"},
+		PrimaryKeyColumn: "id",
+		ColumnComments:   map[string]string{"id": "the user's id"},
+	}
+
+	createSQL := generateCreateTableSQLForNormalizedTable("public.users", tableSchema, nil, false,
+		&protos.SnowflakeTableOptions{
+			ClusterBy:         []string{"TO_DATE(_PEERDB_SYNCED_AT)", "ID"},
+			Transient:         true,
+			DataRetentionDays: proto.Int32(0),
+			ColumnComments:    true,
+			Tags:              map[string]string{"governance.tags.owner": "growth", "cost_center": "42"},
+		})
+	expected := `CREATE TRANSIENT TABLE IF NOT EXISTS public.users("ID" INTEGER PRIMARY KEY COMMENT 'the user''s id',` +
+		`"_PEERDB_IS_DELETED" BOOLEAN DEFAULT FALSE) CLUSTER BY (TO_DATE(_PEERDB_SYNCED_AT),ID)` +
+		` DATA_RETENTION_TIME_IN_DAYS = 0 WITH TAG (cost_center = '42', governance.tags.owner = 'growth')`
+	if createSQL != expected {
+		t.Errorf("unexpected create table statement:\n%s\nexpected:\n%s", createSQL, expected)
+	}
+
+	createSQL = generateCreateTableSQLForNormalizedTable("public.users", tableSchema, nil, false,
+		&protos.SnowflakeTableOptions{})
+	expected = `CREATE TABLE IF NOT EXISTS public.users("ID" INTEGER PRIMARY KEY,` +
+		`"_PEERDB_IS_DELETED" BOOLEAN DEFAULT FALSE)`
+	if createSQL != expected {
+		t.Errorf("unexpected create table statement:\n%s\nexpected:\n%s", createSQL, expected)
+	}
+}
+
+func TestNormalizeClusteringKey(t *testing.T) {
+	if normalizeClusteringKey(`LINEAR("ID", to_date(created_at))`) != normalizeClusteringKey("ID,TO_DATE(CREATED_AT)") {
+		t.Error("expected the clustering keys to match")
+	}
+	if normalizeClusteringKey("LINEAR(ID)") == normalizeClusteringKey("ID,CREATED_AT") {
+		t.Error("expected the clustering keys to differ")
+	}
+	if normalizeClusteringKey("") != "" {
+		t.Error("expected no clustering key")
+	}
+}
+
+func TestCommentStatements(t *testing.T) {
+	tableSchema := &protos.TableSchema{
+		Columns:          map[string]string{"id": "int64", "name": "string", "email": "string", "age": "int32"},
+		PrimaryKeyColumn: "id",
+		ColumnComments:   map[string]string{"id": "the user's id", "name": "full name"},
+	}
+	currentComments := map[string]string{"ID": "the user's id", "NAME": "", "EMAIL": "removed at the source"}
+
+	statements := commentStatements("public.users", tableSchema, currentComments)
+	expected := []string{
+		`ALTER TABLE public.users ALTER COLUMN "EMAIL" UNSET COMMENT`,
+		`ALTER TABLE public.users ALTER COLUMN "NAME" COMMENT 'full name'`,
+	}
+	if strings.Join(statements, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected comment statements:\n%s\nexpected:\n%s",
+			strings.Join(statements, "\n"), strings.Join(expected, "\n"))
+	}
+}
+
+func TestDroppedTags(t *testing.T) {
+	currentTags := []string{`"DB"."GOVERNANCE"."OWNER"`, `"DB"."PUBLIC"."COST_CENTER"`, `"DB"."PUBLIC"."TEAM"`}
+	dropped := droppedTags(currentTags, map[string]string{"governance.owner": "growth", "cost_center": "42"})
+	if len(dropped) != 1 || dropped[0] != `"DB"."PUBLIC"."TEAM"` {
+		t.Errorf("expected only the team tag to be dropped, got %v", dropped)
+	}
+	if dropped := droppedTags(currentTags, nil); len(dropped) != 3 {
+		t.Errorf("expected all tags to be dropped, got %v", dropped)
+	}
+}
@@ -138,7 +138,7 @@ func (g *GenericSQLQueryExecutor) columnTypeToQField(ct *sql.ColumnType) (*model
 	}, nil
 }
 
-func (g *GenericSQLQueryExecutor) processRows(rows *sqlx.Rows) (*model.QRecordBatch, error) {
+func (g *GenericSQLQueryExecutor) rowsToQFields(rows *sqlx.Rows) ([]*model.QField, error) {
 	dbColTypes, err := rows.ColumnTypes()
 	if err != nil {
 		return nil, err
@@ -154,73 +154,89 @@ func (g *GenericSQLQueryExecutor) processRows(rows *sqlx.Rows) (*model.QRecordBa
 		}
 		qfields[i] = qfield
 	}
+	return qfields, nil
+}
 
-	var records []*model.QRecord
-	totalRowsProcessed := 0
-	const heartBeatNumRows = 25000
+func (g *GenericSQLQueryExecutor) scanRecord(rows *sqlx.Rows, qfields []*model.QField) (*model.QRecord, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
 
-	for rows.Next() {
-		columns, err := rows.Columns()
-		if err != nil {
-			return nil, err
+	values := make([]interface{}, len(columns))
+	for i := range values {
+		switch qfields[i].Type {
+		case qvalue.QValueKindTimestamp, qvalue.QValueKindTimestampTZ, qvalue.QValueKindTime,
+			qvalue.QValueKindTimeTZ, qvalue.QValueKindDate:
+			var t sql.NullTime
+			values[i] = &t
+		case qvalue.QValueKindInt16:
+			var n sql.NullInt16
+			values[i] = &n
+		case qvalue.QValueKindInt32:
+			var n sql.NullInt32
+			values[i] = &n
+		case qvalue.QValueKindInt64:
+			var n sql.NullInt64
+			values[i] = &n
+		case qvalue.QValueKindFloat32:
+			var f sql.NullFloat64
+			values[i] = &f
+		case qvalue.QValueKindFloat64:
+			var f sql.NullFloat64
+			values[i] = &f
+		case qvalue.QValueKindBoolean:
+			var b sql.NullBool
+			values[i] = &b
+		case qvalue.QValueKindString:
+			var s sql.NullString
+			values[i] = &s
+		case qvalue.QValueKindBytes, qvalue.QValueKindBit:
+			values[i] = new([]byte)
+		case qvalue.QValueKindNumeric:
+			var s sql.NullString
+			values[i] = &s
+		default:
+			values[i] = new(interface{})
 		}
+	}
 
-		values := make([]interface{}, len(columns))
-		for i := range values {
-			switch qfields[i].Type {
-			case qvalue.QValueKindTimestamp, qvalue.QValueKindTimestampTZ, qvalue.QValueKindTime,
-				qvalue.QValueKindTimeTZ, qvalue.QValueKindDate:
-				var t sql.NullTime
-				values[i] = &t
-			case qvalue.QValueKindInt16:
-				var n sql.NullInt16
-				values[i] = &n
-			case qvalue.QValueKindInt32:
-				var n sql.NullInt32
-				values[i] = &n
-			case qvalue.QValueKindInt64:
-				var n sql.NullInt64
-				values[i] = &n
-			case qvalue.QValueKindFloat32:
-				var f sql.NullFloat64
-				values[i] = &f
-			case qvalue.QValueKindFloat64:
-				var f sql.NullFloat64
-				values[i] = &f
-			case qvalue.QValueKindBoolean:
-				var b sql.NullBool
-				values[i] = &b
-			case qvalue.QValueKindString:
-				var s sql.NullString
-				values[i] = &s
-			case qvalue.QValueKindBytes, qvalue.QValueKindBit:
-				values[i] = new([]byte)
-			case qvalue.QValueKindNumeric:
-				var s sql.NullString
-				values[i] = &s
-			default:
-				values[i] = new(interface{})
-			}
-		}
+	if err := rows.Scan(values...); err != nil {
+		return nil, err
+	}
 
-		if err := rows.Scan(values...); err != nil {
+	qValues := make([]qvalue.QValue, len(values))
+	for i, val := range values {
+		qv, err := toQValue(qfields[i].Type, val)
+		if err != nil {
+			log.Errorf("failed to convert value: %v", err)
 			return nil, err
 		}
+		qValues[i] = qv
+	}
 
-		qValues := make([]qvalue.QValue, len(values))
-		for i, val := range values {
-			qv, err := toQValue(qfields[i].Type, val)
-			if err != nil {
-				log.Errorf("failed to convert value: %v", err)
-				return nil, err
-			}
-			qValues[i] = qv
-		}
+	// Create a QRecord
+	record := model.NewQRecord(len(qValues))
+	for i, qv := range qValues {
+		record.Set(i, qv)
+	}
+	return record, nil
+}
+
+func (g *GenericSQLQueryExecutor) processRows(rows *sqlx.Rows) (*model.QRecordBatch, error) {
+	qfields, err := g.rowsToQFields(rows)
+	if err != nil {
+		return nil, err
+	}
 
-		// Create a QRecord
-		record := model.NewQRecord(len(qValues))
-		for i, qv := range qValues {
-			record.Set(i, qv)
+	var records []*model.QRecord
+	totalRowsProcessed := 0
+	const heartBeatNumRows = 25000
+
+	for rows.Next() {
+		record, err := g.scanRecord(rows, qfields)
+		if err != nil {
+			return nil, err
 		}
 
 		records = append(records, record)
@@ -244,6 +260,47 @@ func (g *GenericSQLQueryExecutor) processRows(rows *sqlx.Rows) (*model.QRecordBa
 	}, nil
 }
 
+// processRowsStream sends the rows to the stream, the records of the stream are closed once all rows are sent.
+func (g *GenericSQLQueryExecutor) processRowsStream(rows *sqlx.Rows, stream *model.QRecordStream) (int, error) {
+	defer close(stream.Records)
+
+	qfields, err := g.rowsToQFields(rows)
+	if err != nil {
+		stream.SchemaChan() <- &model.QRecordSchemaOrError{Err: err}
+		return 0, err
+	}
+	err = stream.SetSchema(model.NewQRecordSchema(qfields))
+	if err != nil {
+		return 0, err
+	}
+
+	totalRowsProcessed := 0
+	const heartBeatNumRows = 25000
+
+	for rows.Next() {
+		record, err := g.scanRecord(rows, qfields)
+		if err != nil {
+			stream.Records <- &model.QRecordOrError{Err: err}
+			return 0, err
+		}
+
+		stream.Records <- &model.QRecordOrError{Record: record}
+		totalRowsProcessed += 1
+
+		if totalRowsProcessed%heartBeatNumRows == 0 {
+			activity.RecordHeartbeat(g.ctx, fmt.Sprintf("processed %d rows", totalRowsProcessed))
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Errorf("failed to iterate over rows: %v", err)
+		stream.Records <- &model.QRecordOrError{Err: err}
+		return 0, err
+	}
+
+	return totalRowsProcessed, nil
+}
+
 func (g *GenericSQLQueryExecutor) ExecuteAndProcessQuery(
 	query string, args ...interface{}) (*model.QRecordBatch, error) {
 	rows, err := g.db.QueryxContext(g.ctx, query, args...)
@@ -266,6 +323,34 @@ func (g *GenericSQLQueryExecutor) NamedExecuteAndProcessQuery(
 	return g.processRows(rows)
 }
 
+// ExecuteAndProcessQueryStream is ExecuteAndProcessQuery sending the rows to a stream.
+func (g *GenericSQLQueryExecutor) ExecuteAndProcessQueryStream(
+	stream *model.QRecordStream, query string, args ...interface{}) (int, error) {
+	rows, err := g.db.QueryxContext(g.ctx, query, args...)
+	if err != nil {
+		stream.SchemaChan() <- &model.QRecordSchemaOrError{Err: err}
+		close(stream.Records)
+		return 0, err
+	}
+	defer rows.Close()
+
+	return g.processRowsStream(rows, stream)
+}
+
+// NamedExecuteAndProcessQueryStream is NamedExecuteAndProcessQuery sending the rows to a stream.
+func (g *GenericSQLQueryExecutor) NamedExecuteAndProcessQueryStream(
+	stream *model.QRecordStream, query string, arg interface{}) (int, error) {
+	rows, err := g.db.NamedQueryContext(g.ctx, query, arg)
+	if err != nil {
+		stream.SchemaChan() <- &model.QRecordSchemaOrError{Err: err}
+		close(stream.Records)
+		return 0, err
+	}
+	defer rows.Close()
+
+	return g.processRowsStream(rows, stream)
+}
+
 func (g *GenericSQLQueryExecutor) ExecuteQuery(query string, args ...interface{}) error {
 	_, err := g.db.ExecContext(g.ctx, query, args...)
 	return err
@@ -375,6 +460,9 @@ func toQValue(kind qvalue.QValueKind, val interface{}) (qvalue.QValue, error) {
 
 	case qvalue.QValueKindJSON:
 		vraw := val.(*interface{})
+		if *vraw == nil {
+			return qvalue.QValue{Kind: qvalue.QValueKindJSON, Value: nil}, nil
+		}
 		vstring := (*vraw).(string)
 
 		if strings.HasPrefix(vstring, "[") {
@@ -3,6 +3,7 @@ package connsqlserver
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	"text/template"
 
 	utils "github.com/PeerDB-io/peer-flow/connectors/utils/partition"
@@ -34,6 +35,10 @@ func (c *SQLServerConnector) GetQRepPartitions(
 		return nil, fmt.Errorf("num rows per partition must be greater than 0 for sql server")
 	}
 
+	if columns := utils.WatermarkColumns(config.WatermarkColumn); len(columns) > 1 {
+		return c.getTuplePartitions(config, utils.QuoteColumns(columns), last)
+	}
+
 	var err error
 	numRowsPerPartition := int64(config.NumRowsPerPartition)
 	quotedWatermarkColumn := fmt.Sprintf("\"%s\"", config.WatermarkColumn)
@@ -54,6 +59,8 @@ func (c *SQLServerConnector) GetQRepPartitions(
 			minVal = lastRange.IntRange.End
 		case *protos.PartitionRange_TimestampRange:
 			minVal = lastRange.TimestampRange.End.AsTime()
+		case *protos.PartitionRange_StringRange:
+			minVal = lastRange.StringRange.End
 		}
 		log.Infof("count query: %s - minVal: %v", countQuery, minVal)
 		params := map[string]interface{}{
@@ -155,19 +162,123 @@ func (c *SQLServerConnector) GetQRepPartitions(
 	return partitionHelper.GetPartitions(), nil
 }
 
+// getTuplePartitions splits the rows of a composite watermark into partitions of num_rows_per_partition rows.
+// SQL Server has no row value comparison, tuples are compared with utils.TupleComparison.
+func (c *SQLServerConnector) getTuplePartitions(
+	config *protos.QRepConfig, quotedColumns []string, last *protos.QRepPartition) ([]*protos.QRepPartition, error) {
+	whereClause := ""
+	params := map[string]interface{}{}
+	if last != nil && last.Range != nil {
+		lastRange, ok := last.Range.Range.(*protos.PartitionRange_TupleRange)
+		if !ok || len(lastRange.TupleRange.End) != len(quotedColumns) {
+			return nil, fmt.Errorf("last partition doesn't have a range over the %d watermark columns",
+				len(quotedColumns))
+		}
+		minVal := utils.FromPartitionValues(lastRange.TupleRange.End)
+		whereClause = "WHERE " + utils.TupleComparison(quotedColumns, ">", namedParams("minVal", len(minVal)))
+		for i, v := range minVal {
+			params[fmt.Sprintf("minVal%d", i)] = v
+		}
+	}
+
+	//nolint:gosec
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM %s %s", config.WatermarkTable, whereClause)
+	countRows, err := c.db.NamedQuery(countQuery, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query for total rows: %w", err)
+	}
+	defer countRows.Close()
+
+	var totalRows int64
+	if countRows.Next() {
+		if err = countRows.Scan(&totalRows); err != nil {
+			return nil, fmt.Errorf("failed to query for total rows: %w", err)
+		}
+	}
+
+	if totalRows == 0 {
+		log.Warnf("no records to replicate for flow job %s, returning", config.FlowJobName)
+		return make([]*protos.QRepPartition, 0), nil
+	}
+
+	numRowsPerPartition := int64(config.NumRowsPerPartition)
+	numPartitions := (totalRows + numRowsPerPartition - 1) / numRowsPerPartition
+	log.Infof("total rows: %d, num partitions: %d, num rows per partition: %d",
+		totalRows, numPartitions, numRowsPerPartition)
+
+	partitionsQuery := utils.TuplePartitionsQuery(quotedColumns, config.WatermarkTable, whereClause, numPartitions)
+	log.Infof("partitions query: %s", partitionsQuery)
+	rows, err := c.db.NamedQuery(partitionsQuery, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query for partitions: %w", err)
+	}
+	defer rows.Close()
+
+	partitionHelper := utils.NewPartitionHelper()
+	for rows.Next() {
+		values, err := rows.SliceScan()
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan row: %w", err)
+		}
+
+		err = partitionHelper.AddTuplePartition(values[:len(quotedColumns)], values[len(quotedColumns):])
+		if err != nil {
+			return nil, fmt.Errorf("failed to add partition: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read partitions: %w", err)
+	}
+
+	return partitionHelper.GetPartitions(), nil
+}
+
 func (c *SQLServerConnector) PullQRepRecords(
 	config *protos.QRepConfig, partition *protos.QRepPartition) (*model.QRecordBatch, error) {
-	// Build the query to pull records within the range from the source table
-	// Be sure to order the results by the watermark column to ensure consistency across pulls
-	query, err := BuildQuery(config.Query)
+	query, rangeParams, err := buildPullQuery(config, partition)
 	if err != nil {
 		return nil, err
 	}
 
-	if partition.FullTablePartition {
+	if rangeParams == nil {
 		// this is a full table partition, so just run the query
 		return c.ExecuteAndProcessQuery(query)
 	}
+	return c.NamedExecuteAndProcessQuery(query, rangeParams)
+}
+
+// PullQRepRecordStream reads the rows of a partition into the stream.
+func (c *SQLServerConnector) PullQRepRecordStream(
+	config *protos.QRepConfig,
+	partition *protos.QRepPartition,
+	stream *model.QRecordStream,
+) (int, error) {
+	query, rangeParams, err := buildPullQuery(config, partition)
+	if err != nil {
+		stream.SchemaChan() <- &model.QRecordSchemaOrError{Err: err}
+		close(stream.Records)
+		return 0, err
+	}
+
+	if rangeParams == nil {
+		return c.ExecuteAndProcessQueryStream(stream, query)
+	}
+	return c.NamedExecuteAndProcessQueryStream(stream, query, rangeParams)
+}
+
+// buildPullQuery builds the query to pull the records within the range of a partition from the source table,
+// the returned params are nil for full table partitions.
+func buildPullQuery(
+	config *protos.QRepConfig, partition *protos.QRepPartition) (string, map[string]interface{}, error) {
+	// Be sure to order the results by the watermark column to ensure consistency across pulls
+	query, err := BuildQuery(config.Query)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if partition.FullTablePartition {
+		return query, nil, nil
+	}
 
 	var rangeStart interface{}
 	var rangeEnd interface{}
@@ -180,16 +291,34 @@ func (c *SQLServerConnector) PullQRepRecords(
 	case *protos.PartitionRange_TimestampRange:
 		rangeStart = x.TimestampRange.Start.AsTime()
 		rangeEnd = x.TimestampRange.End.AsTime()
+	case *protos.PartitionRange_StringRange:
+		rangeStart = x.StringRange.Start
+		rangeEnd = x.StringRange.End
+	case *protos.PartitionRange_TupleRange:
+		columns := utils.QuoteColumns(utils.WatermarkColumns(config.WatermarkColumn))
+		if len(columns) != len(x.TupleRange.Start) || len(columns) != len(x.TupleRange.End) {
+			return "", nil, fmt.Errorf("range of partition doesn't match the %d watermark columns", len(columns))
+		}
+		tupleQuery, err := BuildTupleQuery(config.Query, columns)
+		if err != nil {
+			return "", nil, err
+		}
+		rangeParams := map[string]interface{}{}
+		for i, v := range utils.FromPartitionValues(x.TupleRange.Start) {
+			rangeParams[fmt.Sprintf("startRange%d", i)] = v
+		}
+		for i, v := range utils.FromPartitionValues(x.TupleRange.End) {
+			rangeParams[fmt.Sprintf("endRange%d", i)] = v
+		}
+		return tupleQuery, rangeParams, nil
 	default:
-		return nil, fmt.Errorf("unknown range type: %v", x)
+		return "", nil, fmt.Errorf("unknown range type: %v", x)
 	}
 
-	rangeParams := map[string]interface{}{
+	return query, map[string]interface{}{
 		"startRange": rangeStart,
 		"endRange":   rangeEnd,
-	}
-
-	return c.NamedExecuteAndProcessQuery(query, rangeParams)
+	}, nil
 }
 
 func BuildQuery(query string) (string, error) {
@@ -215,6 +344,49 @@ func BuildQuery(query string) (string, error) {
 	return res, nil
 }
 
+// BuildTupleQuery templates a query over a composite watermark, {{.start}} and {{.end}} are the bounds of
+// the range as lists of params like the row values of Postgres, e.g. (:startRange0, :startRange1). SQL Server
+// has no row value comparison, {{.range}} is the condition of the range, e.g. for (updated_at, id):
+//
+//	("updated_at" > :startRange0 OR ("updated_at" = :startRange0 AND "id" >= :startRange1)) AND
+//	("updated_at" < :endRange0 OR ("updated_at" = :endRange0 AND "id" <= :endRange1))
+func BuildTupleQuery(query string, quotedColumns []string) (string, error) {
+	tmpl, err := template.New("query").Parse(query)
+	if err != nil {
+		return "", err
+	}
+
+	startParams := namedParams("startRange", len(quotedColumns))
+	endParams := namedParams("endRange", len(quotedColumns))
+	data := map[string]interface{}{
+		"start": "(" + strings.Join(startParams, ", ") + ")",
+		"end":   "(" + strings.Join(endParams, ", ") + ")",
+		"range": fmt.Sprintf("%s AND %s",
+			utils.TupleComparison(quotedColumns, ">=", startParams),
+			utils.TupleComparison(quotedColumns, "<=", endParams)),
+	}
+
+	buf := new(bytes.Buffer)
+
+	err = tmpl.Execute(buf, data)
+	if err != nil {
+		return "", err
+	}
+	res := buf.String()
+
+	log.Infof("templated query: %s", res)
+	return res, nil
+}
+
+// namedParams returns n named params, :name0 to :name<n-1>.
+func namedParams(name string, n int) []string {
+	params := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		params = append(params, fmt.Sprintf(":%s%d", name, i))
+	}
+	return params
+}
+
 func (c *SQLServerConnector) SyncQRepRecords(
 	config *protos.QRepConfig,
 	partition *protos.QRepPartition,
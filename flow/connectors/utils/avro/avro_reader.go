@@ -0,0 +1,254 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/linkedin/goavro/v2"
+)
+
+// avroField is the kind a field of an Avro record is read as.
+type avroField struct {
+	kind  qvalue.QValueKind
+	union bool
+}
+
+// PeerDBOCFReader reads the records of an Avro object container file as QRecords.
+// Fields of primitive and logical types are read as the matching kind,
+// other fields (records, maps, enums and unions of several types) are read as JSON.
+type PeerDBOCFReader struct {
+	ocfReader *goavro.OCFReader
+	fields    []avroField
+	schema    *model.QRecordSchema
+}
+
+func NewPeerDBOCFReader(r io.Reader) (*PeerDBOCFReader, error) {
+	ocfReader, err := goavro.NewOCFReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OCF reader: %w", err)
+	}
+
+	var avroSchema struct {
+		Type   string `json:"type"`
+		Fields []struct {
+			Name string      `json:"name"`
+			Type interface{} `json:"type"`
+		} `json:"fields"`
+	}
+	err = json.Unmarshal([]byte(ocfReader.Codec().Schema()), &avroSchema)
+	if err != nil || avroSchema.Type != "record" {
+		return nil, fmt.Errorf("avro schema of the file is not a record: %s", ocfReader.Codec().Schema())
+	}
+
+	fields := make([]avroField, 0, len(avroSchema.Fields))
+	qFields := make([]*model.QField, 0, len(avroSchema.Fields))
+	for _, field := range avroSchema.Fields {
+		kind, nullable := avroTypeToQValueKind(field.Type)
+		_, union := field.Type.([]interface{})
+		fields = append(fields, avroField{kind: kind, union: union})
+		qFields = append(qFields, &model.QField{
+			Name:     field.Name,
+			Type:     kind,
+			Nullable: nullable,
+		})
+	}
+
+	return &PeerDBOCFReader{
+		ocfReader: ocfReader,
+		fields:    fields,
+		schema:    model.NewQRecordSchema(qFields),
+	}, nil
+}
+
+// Schema returns the schema of the records, derived from the Avro schema of the file.
+func (p *PeerDBOCFReader) Schema() *model.QRecordSchema {
+	return p.schema
+}
+
+// Next returns the next record of the file, io.EOF once all records are read.
+func (p *PeerDBOCFReader) Next() (*model.QRecord, error) {
+	if !p.ocfReader.Scan() {
+		if err := p.ocfReader.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read avro file: %w", err)
+		}
+		return nil, io.EOF
+	}
+
+	datum, err := p.ocfReader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read avro record: %w", err)
+	}
+	avroRecord, ok := datum.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("avro record is not a record: %T", datum)
+	}
+
+	entries := make([]qvalue.QValue, 0, len(p.fields))
+	for i, field := range p.schema.Fields {
+		value, err := avroValueToQValue(p.fields[i], avroRecord[field.Name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read field %s: %w", field.Name, err)
+		}
+		entries = append(entries, value)
+	}
+	return &model.QRecord{
+		NumEntries: len(entries),
+		Entries:    entries,
+	}, nil
+}
+
+// avroTypeToQValueKind returns the kind of an Avro type and whether it is nullable.
+func avroTypeToQValueKind(avroType interface{}) (qvalue.QValueKind, bool) {
+	switch t := avroType.(type) {
+	case string:
+		return avroPrimitiveToQValueKind(t), t == "null"
+	case []interface{}:
+		// only unions of null and a single type keep their kind.
+		nullable := false
+		var nonNull []interface{}
+		for _, member := range t {
+			if member == "null" {
+				nullable = true
+			} else {
+				nonNull = append(nonNull, member)
+			}
+		}
+		if len(nonNull) != 1 {
+			return qvalue.QValueKindJSON, nullable
+		}
+		kind, _ := avroTypeToQValueKind(nonNull[0])
+		return kind, nullable
+	case map[string]interface{}:
+		switch t["logicalType"] {
+		case "timestamp-millis", "timestamp-micros":
+			return qvalue.QValueKindTimestamp, false
+		case "date":
+			return qvalue.QValueKindDate, false
+		case "time-millis", "time-micros":
+			return qvalue.QValueKindTime, false
+		case "decimal":
+			return qvalue.QValueKindNumeric, false
+		case "uuid":
+			return qvalue.QValueKindUUID, false
+		}
+
+		switch t["type"] {
+		case "array":
+			if items, ok := t["items"].(string); ok {
+				switch items {
+				case "int":
+					return qvalue.QValueKindArrayInt32, false
+				case "long":
+					return qvalue.QValueKindArrayInt64, false
+				case "float":
+					return qvalue.QValueKindArrayFloat32, false
+				case "double":
+					return qvalue.QValueKindArrayFloat64, false
+				case "string":
+					return qvalue.QValueKindArrayString, false
+				}
+			}
+			return qvalue.QValueKindJSON, false
+		case "fixed":
+			return qvalue.QValueKindBytes, false
+		default:
+			if primitive, ok := t["type"].(string); ok {
+				return avroPrimitiveToQValueKind(primitive), false
+			}
+			return qvalue.QValueKindJSON, false
+		}
+	default:
+		return qvalue.QValueKindJSON, false
+	}
+}
+
+func avroPrimitiveToQValueKind(avroType string) qvalue.QValueKind {
+	switch avroType {
+	case "boolean":
+		return qvalue.QValueKindBoolean
+	case "int":
+		return qvalue.QValueKindInt32
+	case "long":
+		return qvalue.QValueKindInt64
+	case "float":
+		return qvalue.QValueKindFloat32
+	case "double":
+		return qvalue.QValueKindFloat64
+	case "bytes":
+		return qvalue.QValueKindBytes
+	case "string", "null":
+		return qvalue.QValueKindString
+	default:
+		return qvalue.QValueKindJSON
+	}
+}
+
+// avroValueToQValue converts a value decoded by goavro to a QValue of the kind of the field.
+func avroValueToQValue(field avroField, value interface{}) (qvalue.QValue, error) {
+	// values of unions are decoded as a map of the name of their type to the value.
+	if union, ok := value.(map[string]interface{}); ok && field.union {
+		for _, v := range union {
+			value = v
+		}
+	}
+	if value == nil {
+		return qvalue.QValue{Kind: field.kind, Value: nil}, nil
+	}
+
+	switch field.kind {
+	case qvalue.QValueKindTime:
+		d, ok := value.(time.Duration)
+		if !ok {
+			return qvalue.QValue{}, fmt.Errorf("expected time.Duration for time, got %T", value)
+		}
+		return qvalue.QValue{Kind: field.kind, Value: time.Unix(0, 0).UTC().Add(d)}, nil
+	case qvalue.QValueKindNumeric:
+		rat, ok := value.(*big.Rat)
+		if !ok {
+			return qvalue.QValue{}, fmt.Errorf("expected *big.Rat for decimal, got %T", value)
+		}
+		return qvalue.QValue{Kind: field.kind, Value: rat}, nil
+	case qvalue.QValueKindArrayInt32:
+		return avroArrayToQValue[int32](field.kind, value)
+	case qvalue.QValueKindArrayInt64:
+		return avroArrayToQValue[int64](field.kind, value)
+	case qvalue.QValueKindArrayFloat32:
+		return avroArrayToQValue[float32](field.kind, value)
+	case qvalue.QValueKindArrayFloat64:
+		return avroArrayToQValue[float64](field.kind, value)
+	case qvalue.QValueKindArrayString:
+		return avroArrayToQValue[string](field.kind, value)
+	case qvalue.QValueKindJSON:
+		jsonValue, err := json.Marshal(value)
+		if err != nil {
+			return qvalue.QValue{}, fmt.Errorf("failed to convert value to JSON: %w", err)
+		}
+		return qvalue.QValue{Kind: field.kind, Value: string(jsonValue)}, nil
+	default:
+		return qvalue.QValue{Kind: field.kind, Value: value}, nil
+	}
+}
+
+func avroArrayToQValue[T int32 | int64 | float32 | float64 | string](
+	kind qvalue.QValueKind,
+	value interface{},
+) (qvalue.QValue, error) {
+	items, ok := value.([]interface{})
+	if !ok {
+		return qvalue.QValue{}, fmt.Errorf("expected array, got %T", value)
+	}
+	elements := make([]T, 0, len(items))
+	for _, item := range items {
+		element, ok := item.(T)
+		if !ok {
+			return qvalue.QValue{}, fmt.Errorf("unexpected array element %T", item)
+		}
+		elements = append(elements, element)
+	}
+	return qvalue.QValue{Kind: kind, Value: elements}, nil
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/linkedin/goavro/v2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOCF(t *testing.T) {
+	schema := `{
+		"type": "record",
+		"name": "orders",
+		"fields": [
+			{"name": "id", "type": "long"},
+			{"name": "amount", "type": ["null", {
+				"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2
+			}]},
+			{"name": "created_at", "type": {"type": "long", "logicalType": "timestamp-micros"}},
+			{"name": "tags", "type": {"type": "array", "items": "string"}},
+			{"name": "address", "type": ["null", {"type": "record", "name": "address", "fields": [
+				{"name": "city", "type": "string"}
+			]}]}
+		]
+	}`
+	createdAt := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	var buf bytes.Buffer
+	ocfWriter, err := goavro.NewOCFWriter(goavro.OCFConfig{W: &buf, Schema: schema})
+	require.NoError(t, err)
+	err = ocfWriter.Append([]interface{}{
+		map[string]interface{}{
+			"id":         int64(1),
+			"amount":     goavro.Union("bytes.decimal", big.NewRat(1050, 100)),
+			"created_at": createdAt,
+			"tags":       []interface{}{"a", "b"},
+			"address":    goavro.Union("address", map[string]interface{}{"city": "Paris"}),
+		},
+		map[string]interface{}{
+			"id":         int64(2),
+			"amount":     nil,
+			"created_at": createdAt,
+			"tags":       []interface{}{},
+			"address":    nil,
+		},
+	})
+	require.NoError(t, err)
+
+	reader, err := NewPeerDBOCFReader(&buf)
+	require.NoError(t, err)
+
+	fields := reader.Schema().Fields
+	require.Equal(t, qvalue.QValueKindInt64, fields[0].Type)
+	require.Equal(t, qvalue.QValueKindNumeric, fields[1].Type)
+	require.True(t, fields[1].Nullable)
+	require.Equal(t, qvalue.QValueKindTimestamp, fields[2].Type)
+	require.Equal(t, qvalue.QValueKindArrayString, fields[3].Type)
+	require.Equal(t, qvalue.QValueKindJSON, fields[4].Type)
+
+	record, err := reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), record.Entries[0].Value)
+	require.Equal(t, "21/2", record.Entries[1].Value.(*big.Rat).String())
+	require.True(t, createdAt.Equal(record.Entries[2].Value.(time.Time)))
+	require.Equal(t, []string{"a", "b"}, record.Entries[3].Value)
+	require.Equal(t, `{"city":"Paris"}`, record.Entries[4].Value)
+
+	record, err = reader.Next()
+	require.NoError(t, err)
+	require.Nil(t, record.Entries[1].Value)
+	require.Nil(t, record.Entries[4].Value)
+
+	_, err = reader.Next()
+	require.Equal(t, io.EOF, err)
+}
@@ -1,44 +1,103 @@
 package utils
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"github.com/PeerDB-io/peer-flow/model"
 	"github.com/PeerDB-io/peer-flow/model/qvalue"
 	"go.temporal.io/sdk/activity"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/klauspost/compress/zstd"
 	"github.com/linkedin/goavro/v2"
 	log "github.com/sirupsen/logrus"
 )
 
+// AvroCompressionCodec is the compression of an Avro file. Deflate and snappy compress
+// the blocks of the file, gzip and zstd compress the whole file.
+type AvroCompressionCodec int64
+
+const (
+	CompressNone AvroCompressionCodec = iota
+	CompressDeflate
+	CompressSnappy
+	CompressGZip
+	CompressZstd
+)
+
+// ParseAvroCompressionCodec returns the codec for a compression option, none when not set.
+func ParseAvroCompressionCodec(compression string) (AvroCompressionCodec, error) {
+	switch strings.ToLower(compression) {
+	case "", "none":
+		return CompressNone, nil
+	case "deflate":
+		return CompressDeflate, nil
+	case "snappy":
+		return CompressSnappy, nil
+	case "gzip":
+		return CompressGZip, nil
+	case "zstd":
+		return CompressZstd, nil
+	default:
+		return CompressNone, fmt.Errorf("unsupported avro compression: %s", compression)
+	}
+}
+
+// FileExtension returns the extension of Avro files written with the codec.
+func (codec AvroCompressionCodec) FileExtension() string {
+	switch codec {
+	case CompressGZip:
+		return "avro.gz"
+	case CompressZstd:
+		return "avro.zst"
+	default:
+		return "avro"
+	}
+}
+
 type PeerDBOCFWriter struct {
-	ctx        context.Context
-	stream     *model.QRecordStream
-	avroSchema *model.QRecordAvroSchemaDefinition
+	ctx         context.Context
+	stream      *model.QRecordStream
+	avroSchema  *model.QRecordAvroSchemaDefinition
+	compression AvroCompressionCodec
 }
 
 func NewPeerDBOCFWriter(
 	ctx context.Context,
 	stream *model.QRecordStream,
 	avroSchema *model.QRecordAvroSchemaDefinition,
+	compression AvroCompressionCodec,
 ) *PeerDBOCFWriter {
 	return &PeerDBOCFWriter{
-		ctx:        ctx,
-		stream:     stream,
-		avroSchema: avroSchema,
+		ctx:         ctx,
+		stream:      stream,
+		avroSchema:  avroSchema,
+		compression: compression,
 	}
 }
 
 func (p *PeerDBOCFWriter) createOCFWriter(w io.Writer) (*goavro.OCFWriter, error) {
+	compressionName := goavro.CompressionNullLabel
+	switch p.compression {
+	case CompressDeflate:
+		compressionName = goavro.CompressionDeflateLabel
+	case CompressSnappy:
+		compressionName = goavro.CompressionSnappyLabel
+	}
+
 	ocfWriter, err := goavro.NewOCFWriter(goavro.OCFConfig{
-		W:      w,
-		Schema: p.avroSchema.Schema,
+		W:               w,
+		Schema:          p.avroSchema.Schema,
+		CompressionName: compressionName,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create OCF writer: %w", err)
@@ -46,11 +105,13 @@ func (p *PeerDBOCFWriter) createOCFWriter(w io.Writer) (*goavro.OCFWriter, error
 	return ocfWriter, nil
 }
 
-func (p *PeerDBOCFWriter) writeRecordsToOCFWriter(ocfWriter *goavro.OCFWriter) (int, error) {
+// writeRecordsToOCFWriter appends the records of the stream to the OCF writer until the stream ends,
+// or until full reports that the file is large enough. It returns whether the stream has ended.
+func (p *PeerDBOCFWriter) writeRecordsToOCFWriter(ocfWriter *goavro.OCFWriter, full func() bool) (int, bool, error) {
 	schema, err := p.stream.Schema()
 	if err != nil {
 		log.Errorf("failed to get schema from stream: %v", err)
-		return 0, fmt.Errorf("failed to get schema from stream: %w", err)
+		return 0, false, fmt.Errorf("failed to get schema from stream: %w", err)
 	}
 
 	colNames := schema.GetColumnNames()
@@ -59,7 +120,7 @@ func (p *PeerDBOCFWriter) writeRecordsToOCFWriter(ocfWriter *goavro.OCFWriter) (
 	for qRecordOrErr := range p.stream.Records {
 		if qRecordOrErr.Err != nil {
 			log.Errorf("[avro] failed to get record from stream: %v", qRecordOrErr.Err)
-			return 0, fmt.Errorf("[avro] failed to get record from stream: %w", qRecordOrErr.Err)
+			return 0, false, fmt.Errorf("[avro] failed to get record from stream: %w", qRecordOrErr.Err)
 		}
 
 		qRecord := qRecordOrErr.Record
@@ -73,13 +134,13 @@ func (p *PeerDBOCFWriter) writeRecordsToOCFWriter(ocfWriter *goavro.OCFWriter) (
 		avroMap, err := avroConverter.Convert()
 		if err != nil {
 			log.Errorf("failed to convert QRecord to Avro compatible map: %v", err)
-			return 0, fmt.Errorf("failed to convert QRecord to Avro compatible map: %w", err)
+			return 0, false, fmt.Errorf("failed to convert QRecord to Avro compatible map: %w", err)
 		}
 
 		err = ocfWriter.Append([]interface{}{avroMap})
 		if err != nil {
 			log.Errorf("failed to write record to OCF: %v", err)
-			return 0, fmt.Errorf("failed to write record to OCF: %w", err)
+			return 0, false, fmt.Errorf("failed to write record to OCF: %w", err)
 		}
 
 		if numRows%heartBeatNumRows == 0 {
@@ -91,6 +152,9 @@ func (p *PeerDBOCFWriter) writeRecordsToOCFWriter(ocfWriter *goavro.OCFWriter) (
 		}
 
 		numRows++
+		if full != nil && full() {
+			return numRows, false, nil
+		}
 	}
 
 	if p.ctx != nil {
@@ -98,22 +162,79 @@ func (p *PeerDBOCFWriter) writeRecordsToOCFWriter(ocfWriter *goavro.OCFWriter) (
 		activity.RecordHeartbeat(p.ctx, msg)
 	}
 
-	return numRows, nil
+	return numRows, true, nil
 }
 
 func (p *PeerDBOCFWriter) WriteOCF(w io.Writer) (int, error) {
+	numRows, _, err := p.writeOCF(w, nil)
+	return numRows, err
+}
+
+func (p *PeerDBOCFWriter) writeOCF(w io.Writer, full func() bool) (int, bool, error) {
+	// gzip and zstd compress the whole file instead of its blocks.
+	var fileCompressor io.WriteCloser
+	switch p.compression {
+	case CompressGZip:
+		fileCompressor = gzip.NewWriter(w)
+	case CompressZstd:
+		zstdWriter, err := zstd.NewWriter(w)
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to create zstd writer: %w", err)
+		}
+		fileCompressor = zstdWriter
+	}
+	if fileCompressor != nil {
+		w = fileCompressor
+	}
+
 	ocfWriter, err := p.createOCFWriter(w)
 	if err != nil {
-		return 0, fmt.Errorf("failed to create OCF writer: %w", err)
+		return 0, false, fmt.Errorf("failed to create OCF writer: %w", err)
 	}
-	numRows, err := p.writeRecordsToOCFWriter(ocfWriter)
+	numRows, done, err := p.writeRecordsToOCFWriter(ocfWriter, full)
 	if err != nil {
-		return 0, fmt.Errorf("failed to write records to OCF writer: %w", err)
+		return 0, false, fmt.Errorf("failed to write records to OCF writer: %w", err)
+	}
+
+	if fileCompressor != nil {
+		err = fileCompressor.Close()
+		if err != nil {
+			return 0, false, fmt.Errorf("failed to close compressed OCF file: %w", err)
+		}
+	}
+	return numRows, done, nil
+}
+
+// WriteChunks writes the records as a sequence of OCF files of about chunkSize bytes each and passes
+// every file to handleChunk once it is complete, so the writer holds only one file in memory.
+func (p *PeerDBOCFWriter) WriteChunks(chunkSize int, handleChunk func(chunk int, data []byte) error) (int, error) {
+	numRows := 0
+	for chunk := 0; ; chunk++ {
+		var buf bytes.Buffer
+		chunkRows, done, err := p.writeOCF(&buf, func() bool {
+			return buf.Len() >= chunkSize
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to write OCF chunk %d: %w", chunk, err)
+		}
+
+		// the stream can end right after a full chunk, there is nothing to hand over then.
+		if chunkRows > 0 {
+			if err := handleChunk(chunk, buf.Bytes()); err != nil {
+				return 0, fmt.Errorf("failed to handle OCF chunk %d: %w", chunk, err)
+			}
+			numRows += chunkRows
+		}
+		if done {
+			return numRows, nil
+		}
 	}
-	return numRows, nil
 }
 
-func (p *PeerDBOCFWriter) WriteRecordsToS3(bucketName, key string) (int, error) {
+func (p *PeerDBOCFWriter) WriteRecordsToS3(
+	bucketName, key string,
+	connConfig *protos.S3ConnectionConfig,
+) (int, error) {
 	r, w := io.Pipe()
 	numRowsWritten := make(chan int, 1)
 	go func() {
@@ -125,7 +246,7 @@ func (p *PeerDBOCFWriter) WriteRecordsToS3(bucketName, key string) (int, error)
 		numRowsWritten <- numRows
 	}()
 
-	s3svc, err := utils.CreateS3Client()
+	s3svc, err := utils.CreateS3Client(connConfig)
 	if err != nil {
 		log.Errorf("failed to create S3 client: %v", err)
 		return 0, fmt.Errorf("failed to create S3 client: %w", err)
@@ -5,7 +5,10 @@ import (
 	"os"
 	"strings"
 
+	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
 )
@@ -15,31 +18,58 @@ type AWSSecrets struct {
 	SecretAccessKey string
 	AwsRoleArn      string
 	Region          string
+	Endpoint        string
+	ForcePathStyle  bool
 }
 
-func GetAWSSecrets() (*AWSSecrets, error) {
-	awsRegion := os.Getenv("AWS_REGION")
+// GetAWSSecrets returns the credentials to reach S3 with. The credentials of the
+// connection config are used as a whole when any of them is set, the AWS_*
+// environment variables only when the peer has none, so the two are never mixed.
+func GetAWSSecrets(connConfig *protos.S3ConnectionConfig) (*AWSSecrets, error) {
+	awsRegion := getOrEnv(connConfig.GetRegion(), "AWS_REGION")
+	awsEndpoint := getOrEnv(connConfig.GetEndpoint(), "AWS_ENDPOINT")
 	if awsRegion == "" {
-		return nil, fmt.Errorf("AWS_REGION must be set")
+		// S3 compatible stores mostly ignore the region, but the SDK needs one.
+		if awsEndpoint == "" {
+			return nil, fmt.Errorf("AWS_REGION must be set")
+		}
+		awsRegion = "us-east-1"
 	}
 
-	awsKey := os.Getenv("AWS_ACCESS_KEY_ID")
-	awsSecret := os.Getenv("AWS_SECRET_ACCESS_KEY")
-	awsRoleArn := os.Getenv("AWS_ROLE_ARN")
+	awsKey := connConfig.GetAccessKeyId()
+	awsSecret := connConfig.GetSecretAccessKey()
+	awsRoleArn := connConfig.GetRoleArn()
+	if awsKey == "" && awsSecret == "" && awsRoleArn == "" {
+		awsKey = os.Getenv("AWS_ACCESS_KEY_ID")
+		awsSecret = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		awsRoleArn = os.Getenv("AWS_ROLE_ARN")
+	}
 
 	// one of (awsKey and awsSecret) or awsRoleArn must be set
 	if awsKey == "" && awsSecret == "" && awsRoleArn == "" {
 		return nil, fmt.Errorf("one of (AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY) or AWS_ROLE_ARN must be set")
 	}
+	if (awsKey == "") != (awsSecret == "") {
+		return nil, fmt.Errorf("access key id and secret access key must be set together")
+	}
 
 	return &AWSSecrets{
 		AccessKeyID:     awsKey,
 		SecretAccessKey: awsSecret,
 		AwsRoleArn:      awsRoleArn,
 		Region:          awsRegion,
+		Endpoint:        awsEndpoint,
+		ForcePathStyle:  connConfig.GetForcePathStyle(),
 	}, nil
 }
 
+func getOrEnv(value string, envKey string) string {
+	if value != "" {
+		return value
+	}
+	return os.Getenv(envKey)
+}
+
 type S3BucketAndPrefix struct {
 	Bucket string
 	Prefix string
@@ -66,16 +96,36 @@ func NewS3BucketAndPrefix(s3Path string) (*S3BucketAndPrefix, error) {
 	}, nil
 }
 
-func CreateS3Client() (*s3.S3, error) {
-	awsSecrets, err := GetAWSSecrets()
+// CreateS3Client creates a client for the bucket of a peer, see GetAWSSecrets.
+func CreateS3Client(connConfig *protos.S3ConnectionConfig) (*s3.S3, error) {
+	awsSecrets, err := GetAWSSecrets(connConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get AWS secrets: %w", err)
 	}
 
-	sess := session.Must(session.NewSession(&aws.Config{
-		Region: aws.String(awsSecrets.Region),
-	}))
+	awsConfig := &aws.Config{
+		Region:           aws.String(awsSecrets.Region),
+		S3ForcePathStyle: aws.Bool(awsSecrets.ForcePathStyle),
+	}
+	if awsSecrets.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(awsSecrets.Endpoint)
+	}
+	if awsSecrets.AccessKeyID != "" && awsSecrets.SecretAccessKey != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(
+			awsSecrets.AccessKeyID, awsSecrets.SecretAccessKey, "")
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AWS session: %w", err)
+	}
 
-	s3svc := s3.New(sess)
-	return s3svc, nil
+	// a role set through AWS_ROLE_ARN is picked up by the default credential chain (web identity),
+	// a role of the peer is assumed explicitly.
+	if roleArn := connConfig.GetRoleArn(); roleArn != "" {
+		return s3.New(sess, &aws.Config{
+			Credentials: stscreds.NewCredentials(sess, roleArn),
+		}), nil
+	}
+	return s3.New(sess), nil
 }
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+func TestGetAWSSecrets(t *testing.T) {
+	t.Setenv("AWS_REGION", "us-west-2")
+	t.Setenv("AWS_ACCESS_KEY_ID", "env-key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "env-secret")
+	t.Setenv("AWS_ROLE_ARN", "env-role")
+
+	testCases := []struct {
+		name        string
+		config      *protos.S3ConnectionConfig
+		expected    AWSSecrets
+		expectError bool
+	}{
+		{
+			name:   "environment only",
+			config: &protos.S3ConnectionConfig{},
+			expected: AWSSecrets{
+				AccessKeyID:     "env-key",
+				SecretAccessKey: "env-secret",
+				AwsRoleArn:      "env-role",
+				Region:          "us-west-2",
+			},
+		},
+		{
+			name:   "peer role is not mixed with environment keys",
+			config: &protos.S3ConnectionConfig{RoleArn: "peer-role"},
+			expected: AWSSecrets{
+				AwsRoleArn: "peer-role",
+				Region:     "us-west-2",
+			},
+		},
+		{
+			name: "peer keys are not mixed with environment role",
+			config: &protos.S3ConnectionConfig{
+				AccessKeyId:     "peer-key",
+				SecretAccessKey: "peer-secret",
+				Region:          "eu-central-1",
+			},
+			expected: AWSSecrets{
+				AccessKeyID:     "peer-key",
+				SecretAccessKey: "peer-secret",
+				Region:          "eu-central-1",
+			},
+		},
+		{
+			name:        "peer key without secret",
+			config:      &protos.S3ConnectionConfig{AccessKeyId: "peer-key"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		actual, err := GetAWSSecrets(tc.config)
+		if tc.expectError {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %+v", tc.name, actual)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if *actual != tc.expected {
+			t.Errorf("%s: expected %+v, got %+v", tc.name, tc.expected, *actual)
+		}
+	}
+}
@@ -0,0 +1,272 @@
+// Package deadletter keeps the records of a mirror that fail to convert or to be written to a destination,
+// so that the rest of their batch can be synced.
+package deadletter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/connectors/utils/monitoring"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/jackc/pglogrepl"
+	"github.com/jackc/pgx/v5/pgconn"
+	log "github.com/sirupsen/logrus"
+	"github.com/snowflakedb/gosnowflake"
+	"go.temporal.io/sdk/temporal"
+)
+
+// LimitExceededErrorType is the type of the error that halts a mirror with too many failed records.
+const LimitExceededErrorType = "DeadLetterLimitExceeded"
+
+// Letter is a record that failed, with its table, LSN and error.
+type Letter struct {
+	FlowJobName string    `json:"flow_name"`
+	Destination string    `json:"destination"`
+	TableName   string    `json:"table_name"`
+	LSN         int64     `json:"lsn"`
+	Record      string    `json:"record"`
+	Error       string    `json:"error"`
+	FailedAt    time.Time `json:"failed_at"`
+}
+
+// NewLetter returns the letter of a record that failed to be written to a destination.
+func NewLetter(flowJobName string, destination string, record model.Record, err error) *Letter {
+	tableName := record.GetTableName()
+	// the table name of deletes is the source table.
+	if deleteRecord, ok := record.(*model.DeleteRecord); ok {
+		tableName = deleteRecord.DestinationTableName
+	}
+
+	return &Letter{
+		FlowJobName: flowJobName,
+		Destination: destination,
+		TableName:   tableName,
+		LSN:         record.GetCheckPointID(),
+		Record:      recordJSON(record.GetItems()),
+		Error:       err.Error(),
+		FailedAt:    time.Now().UTC(),
+	}
+}
+
+// recordJSON returns the items of a record as JSON, on a best effort basis since a value of the record
+// may be why it failed. Values that can't be marshalled are kept as quoted Go values.
+func recordJSON(items model.RecordItems) string {
+	values := make(map[string]json.RawMessage, len(items))
+	for column, value := range items {
+		encoded, err := json.Marshal(value.Value)
+		if err != nil {
+			encoded, _ = json.Marshal(fmt.Sprintf("%q", fmt.Sprint(value.Value)))
+		}
+		values[column] = encoded
+	}
+
+	encoded, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Sprintf("%q", fmt.Sprint(items))
+	}
+	return string(encoded)
+}
+
+// Sink is where the letters of a mirror are kept.
+type Sink interface {
+	Write(ctx context.Context, letter *Letter) error
+}
+
+// NewSink returns the sink of a mirror, an S3 prefix or the catalog.
+func NewSink(config *protos.DeadLetterConfig, catalog *monitoring.CatalogMirrorMonitor) (Sink, error) {
+	if config.GetS3Path() != "" {
+		return newS3Sink(config.GetS3Path(), config.GetS3Connection())
+	}
+
+	if !catalog.IsActive() {
+		return nil, fmt.Errorf("the catalog is not available to keep dead letters, set an S3 path instead")
+	}
+	return &catalogSink{catalog: catalog}, nil
+}
+
+// catalogSink keeps the letters in the peerdb_stats.dead_letters table of the catalog.
+type catalogSink struct {
+	catalog *monitoring.CatalogMirrorMonitor
+}
+
+func (s *catalogSink) Write(ctx context.Context, letter *Letter) error {
+	return s.catalog.AddDeadLetter(ctx, letter.FlowJobName, letter.Destination, letter.TableName,
+		pglogrepl.LSN(letter.LSN), letter.Record, letter.Error, letter.FailedAt)
+}
+
+// s3Sink writes every letter as a JSON object under <prefix>/<flow>/<destination>/<lsn>.json,
+// so that a record that fails again replaces its previous letter.
+type s3Sink struct {
+	client *s3.S3
+	bucket string
+	prefix string
+}
+
+func newS3Sink(s3Path string, connection *protos.S3ConnectionConfig) (*s3Sink, error) {
+	bucketAndPrefix, err := utils.NewS3BucketAndPrefix(s3Path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid dead letter path %s: %w", s3Path, err)
+	}
+
+	client, err := utils.CreateS3Client(connection)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 client for dead letters: %w", err)
+	}
+
+	return &s3Sink{
+		client: client,
+		bucket: bucketAndPrefix.Bucket,
+		prefix: bucketAndPrefix.Prefix,
+	}, nil
+}
+
+func (s *s3Sink) key(letter *Letter) string {
+	return path.Join(s.prefix, letter.FlowJobName, letter.Destination, fmt.Sprintf("%016X.json", letter.LSN))
+}
+
+func (s *s3Sink) Write(ctx context.Context, letter *Letter) error {
+	body, err := json.Marshal(letter)
+	if err != nil {
+		return fmt.Errorf("failed to marshal dead letter: %w", err)
+	}
+
+	_, err = s.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(s.key(letter)),
+		Body:        bytes.NewReader(body),
+		ContentType: aws.String("application/json"),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to write dead letter to s3://%s/%s: %w", s.bucket, s.key(letter), err)
+	}
+	return nil
+}
+
+// Handler sends the failed records of a batch to a sink, until the batch has more failed records
+// than the limits of the mirror allow.
+type Handler struct {
+	ctx         context.Context
+	sink        Sink
+	flowJobName string
+	destination string
+	maxErrors   int
+	numErrors   int
+	// seen has the LSNs of the records already sent, a record that failed to convert is converted again
+	// when the part of the batch it is in is retried.
+	seen map[int64]struct{}
+	mu   sync.Mutex
+}
+
+// NewHandler returns the handler of the failed records of a batch of numRecords records.
+func NewHandler(
+	ctx context.Context,
+	config *protos.DeadLetterConfig,
+	sink Sink,
+	flowJobName string,
+	destination string,
+	numRecords int,
+) *Handler {
+	maxErrors := -1
+	if config.GetMaxErrors() > 0 {
+		maxErrors = int(config.GetMaxErrors())
+	}
+	if config.GetMaxErrorPercent() > 0 {
+		// rounded up, so that a small batch still allows a failed record, and never below max errors.
+		maxErrorsOfBatch := (numRecords*int(config.GetMaxErrorPercent()) + 99) / 100
+		if maxErrorsOfBatch > maxErrors {
+			maxErrors = maxErrorsOfBatch
+		}
+	}
+
+	return &Handler{
+		ctx:         ctx,
+		sink:        sink,
+		flowJobName: flowJobName,
+		destination: destination,
+		maxErrors:   maxErrors,
+		seen:        make(map[int64]struct{}),
+	}
+}
+
+// Add sends a failed record to the sink, so that the batch can go on without it. It returns a non retryable
+// error once the batch has too many failed records, which halts the mirror.
+func (h *Handler) Add(record model.Record, err error) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.seen[record.GetCheckPointID()]; ok {
+		return nil
+	}
+	if h.maxErrors >= 0 && h.numErrors >= h.maxErrors {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("more than %d records of the batch failed, halting mirror %s", h.maxErrors, h.flowJobName),
+			LimitExceededErrorType, err)
+	}
+
+	letter := NewLetter(h.flowJobName, h.destination, record, err)
+	if sinkErr := h.sink.Write(h.ctx, letter); sinkErr != nil {
+		return fmt.Errorf("failed to write dead letter for %v: %w", err, sinkErr)
+	}
+	h.seen[record.GetCheckPointID()] = struct{}{}
+	h.numErrors++
+
+	log.WithFields(log.Fields{
+		"flowName":    h.flowJobName,
+		"destination": h.destination,
+		"table":       letter.TableName,
+		"lsn":         pglogrepl.LSN(letter.LSN).String(),
+	}).Warnf("sent failed record to dead letters: %v", err)
+	return nil
+}
+
+// NumErrors returns the number of failed records sent to the sink.
+func (h *Handler) NumErrors() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.numErrors
+}
+
+// IsRecordError tells whether an error of a sync is caused by some of the records of the batch, so that syncing
+// the batch without them succeeds: the errors connectors mark as such, and the data exceptions and constraint
+// violations of Postgres and Snowflake, or the invalid values of BigQuery. Other errors fail the whole batch.
+func IsRecordError(err error) bool {
+	var recordErr *model.RecordError
+	if errors.As(err, &recordErr) {
+		return true
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return isRecordSQLState(pgErr.Code)
+	}
+	var snowflakeErr *gosnowflake.SnowflakeError
+	if errors.As(err, &snowflakeErr) {
+		return isRecordSQLState(snowflakeErr.SQLState)
+	}
+
+	var bqErr *bigquery.Error
+	if errors.As(err, &bqErr) {
+		return bqErr.Reason == "invalid"
+	}
+	var bqPutErr bigquery.PutMultiError
+	return errors.As(err, &bqPutErr)
+}
+
+// isRecordSQLState tells whether a SQLSTATE is of the data exception (22) or integrity constraint violation (23)
+// classes, which are caused by the values written.
+func isRecordSQLState(sqlState string) bool {
+	return strings.HasPrefix(sqlState, "22") || strings.HasPrefix(sqlState, "23")
+}
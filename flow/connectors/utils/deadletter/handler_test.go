@@ -0,0 +1,126 @@
+package deadletter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/snowflakedb/gosnowflake"
+	"go.temporal.io/sdk/temporal"
+)
+
+type memorySink struct {
+	letters []*Letter
+}
+
+func (s *memorySink) Write(_ context.Context, letter *Letter) error {
+	s.letters = append(s.letters, letter)
+	return nil
+}
+
+func TestHandlerLimits(t *testing.T) {
+	for _, tc := range []struct {
+		config    *protos.DeadLetterConfig
+		maxErrors int
+	}{
+		{config: &protos.DeadLetterConfig{}, maxErrors: -1},
+		{config: &protos.DeadLetterConfig{MaxErrors: 5}, maxErrors: 5},
+		{config: &protos.DeadLetterConfig{MaxErrorPercent: 10}, maxErrors: 20},
+		{config: &protos.DeadLetterConfig{MaxErrorPercent: 3}, maxErrors: 6},
+		{config: &protos.DeadLetterConfig{MaxErrorPercent: 1}, maxErrors: 2},
+		{config: &protos.DeadLetterConfig{MaxErrors: 5, MaxErrorPercent: 1}, maxErrors: 5},
+		{config: &protos.DeadLetterConfig{MaxErrors: 5, MaxErrorPercent: 10}, maxErrors: 20},
+	} {
+		handler := NewHandler(context.Background(), tc.config, &memorySink{}, "flow", "dest", 200)
+		if handler.maxErrors != tc.maxErrors {
+			t.Errorf("expected at most %d errors for %v, got %d", tc.maxErrors, tc.config, handler.maxErrors)
+		}
+	}
+}
+
+func TestHandlerAdd(t *testing.T) {
+	sink := &memorySink{}
+	handler := NewHandler(context.Background(), &protos.DeadLetterConfig{MaxErrors: 2}, sink, "flow", "dest", 10)
+
+	failure := errors.New("value out of range")
+	records := []model.Record{
+		&model.InsertRecord{
+			DestinationTableName: "users",
+			CheckPointID:         1,
+			Items:                model.RecordItems{"id": {Value: 1}},
+		},
+		&model.DeleteRecord{SourceTableName: "public.users", DestinationTableName: "users", CheckPointID: 2},
+	}
+	for _, record := range records {
+		if err := handler.Add(record, failure); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// records that fail again in a retried part of the batch are only counted once.
+	if err := handler.Add(records[0], failure); err != nil {
+		t.Fatal(err)
+	}
+	if handler.NumErrors() != 2 || len(sink.letters) != 2 {
+		t.Fatalf("expected 2 dead letters, got %d", len(sink.letters))
+	}
+
+	letter := sink.letters[0]
+	if letter.TableName != "users" || letter.LSN != 1 || letter.Record != `{"id":1}` ||
+		letter.Error != failure.Error() {
+		t.Errorf("unexpected dead letter %+v", letter)
+	}
+	if sink.letters[1].TableName != "users" {
+		t.Errorf("expected the destination table of the delete, got %s", sink.letters[1].TableName)
+	}
+
+	err := handler.Add(&model.InsertRecord{DestinationTableName: "users", CheckPointID: 3}, failure)
+	var applicationErr *temporal.ApplicationError
+	if !errors.As(err, &applicationErr) || !applicationErr.NonRetryable() ||
+		applicationErr.Type() != LimitExceededErrorType {
+		t.Errorf("expected a non retryable error once the limit is reached, got %v", err)
+	}
+}
+
+func TestHandlerSmallBatch(t *testing.T) {
+	// a percentage of a small batch is rounded up, one failed record doesn't halt the mirror.
+	failure := errors.New("value out of range")
+	for _, numRecords := range []int{1, 10, 99} {
+		sink := &memorySink{}
+		handler := NewHandler(context.Background(), &protos.DeadLetterConfig{MaxErrorPercent: 1}, sink,
+			"flow", "dest", numRecords)
+		if err := handler.Add(&model.InsertRecord{DestinationTableName: "users", CheckPointID: 1}, failure); err != nil {
+			t.Errorf("expected a failed record of a batch of %d records to be allowed, got %v", numRecords, err)
+		}
+		err := handler.Add(&model.InsertRecord{DestinationTableName: "users", CheckPointID: 2}, failure)
+		if err == nil {
+			t.Errorf("expected a second failed record of a batch of %d records to halt the mirror", numRecords)
+		}
+	}
+}
+
+func TestIsRecordError(t *testing.T) {
+	for _, tc := range []struct {
+		err      error
+		expected bool
+	}{
+		{err: model.NewRecordError(errors.New("failed to convert")), expected: true},
+		{err: fmt.Errorf("error syncing records: %w", &pgconn.PgError{Code: "22003"}), expected: true},
+		{err: fmt.Errorf("error applying records: %w", &pgconn.PgError{Code: "23505"}), expected: true},
+		{err: &pgconn.PgError{Code: "40001"}, expected: false},
+		{err: &gosnowflake.SnowflakeError{SQLState: "22018"}, expected: true},
+		{err: &gosnowflake.SnowflakeError{SQLState: "08001"}, expected: false},
+		{err: fmt.Errorf("failed to load: %w", &bigquery.Error{Reason: "invalid"}), expected: true},
+		{err: &bigquery.Error{Reason: "backendError"}, expected: false},
+		{err: errors.New("connection reset by peer"), expected: false},
+	} {
+		if IsRecordError(tc.err) != tc.expected {
+			t.Errorf("expected IsRecordError(%v) to be %v", tc.err, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+package utils
+
+import "github.com/PeerDB-io/peer-flow/generated/protos"
+
+// GetDestinations returns all destination peers of a mirror, starting with the primary destination.
+func GetDestinations(config *protos.FlowConnectionConfigs) []*protos.Peer {
+	destinations := make([]*protos.Peer, 0, 1+len(config.AdditionalDestinations))
+	destinations = append(destinations, config.Destination)
+	return append(destinations, config.AdditionalDestinations...)
+}
+
+// GetCheckpoint returns the checkpoint of a sync state, 0 if nothing has been synced yet.
+func GetCheckpoint(syncState *protos.LastSyncState) int64 {
+	if syncState == nil {
+		return 0
+	}
+	return syncState.Checkpoint
+}
@@ -0,0 +1,202 @@
+package envelope
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils/schemaregistry"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/linkedin/goavro/v2"
+)
+
+type avroSchema struct {
+	id    int
+	codec *goavro.Codec
+}
+
+// avroValueSchema returns the schema of the rows of a topic. All the fields are nullable with a null default,
+// so that schemas with added or dropped columns stay compatible with the previous versions.
+func avroValueSchema(columns []column) (map[string]interface{}, error) {
+	fields := make([]map[string]interface{}, 0, len(columns))
+	for _, c := range columns {
+		avroType, err := qvalue.GetAvroSchemaFromQValueKind(encodedKind(c.kind), true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Avro schema of column %s: %w", c.name, err)
+		}
+		fields = append(fields, map[string]interface{}{
+			"name":    c.name,
+			"type":    []interface{}{"null", avroType.AvroLogicalSchema},
+			"default": nil,
+		})
+	}
+
+	return map[string]interface{}{
+		"type":   "record",
+		"name":   valueMessage,
+		"fields": fields,
+	}, nil
+}
+
+// avroEnvelopeSchema returns the schema of Debezium change events, as produced by the Avro converter of Debezium.
+func avroEnvelopeSchema(valueSchema map[string]interface{}) map[string]interface{} {
+	sourceFields := []map[string]interface{}{
+		{"name": "version", "type": "string"},
+		{"name": "connector", "type": "string"},
+		{"name": "name", "type": "string"},
+		{"name": "ts_ms", "type": "long"},
+		{"name": "schema", "type": "string"},
+		{"name": "table", "type": "string"},
+		{"name": "txId", "type": "long"},
+		{"name": "lsn", "type": "long"},
+	}
+
+	return map[string]interface{}{
+		"type": "record",
+		"name": envelopeMessage,
+		"fields": []map[string]interface{}{
+			{"name": "before", "type": []interface{}{"null", valueSchema}, "default": nil},
+			{"name": "after", "type": []interface{}{"null", valueMessage}, "default": nil},
+			{"name": "source", "type": map[string]interface{}{
+				"type":   "record",
+				"name":   sourceMessage,
+				"fields": sourceFields,
+			}},
+			{"name": "op", "type": "string"},
+			{"name": "ts_ms", "type": "long"},
+		},
+	}
+}
+
+// avroSchemaFor returns the registered schema of the events of a topic with the given columns.
+func (e *Encoder) avroSchemaFor(ctx context.Context, topic string, columns []column) (*avroSchema, error) {
+	key := columnsKey(topic, columns)
+	if schema, ok := e.avroSchemas[key]; ok {
+		return schema, nil
+	}
+
+	definition, err := avroValueSchema(columns)
+	if err != nil {
+		return nil, err
+	}
+	if e.envelope == protos.EventEnvelope_EVENT_ENVELOPE_DEBEZIUM {
+		definition = avroEnvelopeSchema(definition)
+	}
+	schemaJSON, err := json.Marshal(definition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal Avro schema: %w", err)
+	}
+
+	codec, err := goavro.NewCodec(string(schemaJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Avro codec for topic %s: %w", topic, err)
+	}
+	id, err := e.registry.Register(ctx, subject(topic), schemaregistry.SchemaTypeAvro, string(schemaJSON))
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &avroSchema{id: id, codec: codec}
+	e.avroSchemas[key] = schema
+	return schema, nil
+}
+
+// avroRow returns the native Avro value of a row, columns the row doesn't have are null.
+func avroRow(columns []column, items model.RecordItems) (map[string]interface{}, error) {
+	row := make(map[string]interface{}, len(columns))
+	for _, c := range columns {
+		value, ok := items[c.name]
+		if !ok || value.Value == nil {
+			row[c.name] = nil
+			continue
+		}
+
+		kind := encodedKind(c.kind)
+		if kind == qvalue.QValueKindString {
+			value = qvalue.QValue{Kind: kind, Value: stringValue(value.Value)}
+		}
+		// there is no warehouse to convert the values for, times are already strings.
+		avroValue, err := qvalue.NewQValueAvroConverter(&value, 0, true).ToAvroValue()
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert column %s to Avro: %w", c.name, err)
+		}
+		row[c.name] = avroValue
+	}
+	return row, nil
+}
+
+func (e *Encoder) encodeAvro(
+	ctx context.Context,
+	record model.Record,
+	topic string,
+	tableSchema *protos.TableSchema,
+	flowJobName string,
+) ([]byte, error) {
+	images, err := newRowImages(record)
+	if err != nil {
+		return nil, err
+	}
+	columns := rowColumns(tableSchema, images.before, images.after)
+	schema, err := e.avroSchemaFor(ctx, topic, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var native map[string]interface{}
+	if e.envelope == protos.EventEnvelope_EVENT_ENVELOPE_DEBEZIUM {
+		native, err = avroChange(record, images, columns, flowJobName)
+	} else {
+		native, err = avroRow(columns, record.GetItems())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := schema.codec.BinaryFromNative(nil, native)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode record as Avro: %w", err)
+	}
+	return schemaregistry.Frame(schema.id, nil, payload), nil
+}
+
+// avroChange returns the native Avro value of the Debezium change event of a record.
+// Unchanged TOAST columns are null, the Avro converter of Debezium only has a placeholder for strings.
+func avroChange(
+	record model.Record,
+	images *rowImages,
+	columns []column,
+	flowJobName string,
+) (map[string]interface{}, error) {
+	change := map[string]interface{}{
+		"before": nil,
+		"after":  nil,
+		"op":     images.op,
+		"ts_ms":  time.Now().UnixMilli(),
+	}
+	for field, items := range map[string]model.RecordItems{"before": images.before, "after": images.after} {
+		if items == nil {
+			continue
+		}
+		row, err := avroRow(columns, items)
+		if err != nil {
+			return nil, err
+		}
+		change[field] = goavro.Union(valueMessage, row)
+	}
+
+	source := newSource(record, images, flowJobName)
+	change["source"] = map[string]interface{}{
+		"version":   source.Version,
+		"connector": source.Connector,
+		"name":      source.Name,
+		"ts_ms":     source.TsMs,
+		"schema":    source.Schema,
+		"table":     source.Table,
+		"txId":      int64(source.TxID),
+		"lsn":       source.LSN,
+	}
+	return change, nil
+}
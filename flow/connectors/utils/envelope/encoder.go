@@ -0,0 +1,170 @@
+package envelope
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils/schemaregistry"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/google/uuid"
+)
+
+// names of the records of Avro schemas, and of the messages of Protobuf schemas.
+const (
+	valueMessage    = "Value"
+	envelopeMessage = "Envelope"
+	sourceMessage   = "Source"
+)
+
+// Encoder encodes the events of records in an envelope and an encoding. Avro and Protobuf events are
+// in the wire format of the schema registry, the schema of the rows of each topic is registered under
+// the subject <topic>-value, and a new version is registered whenever the columns of the rows change.
+type Encoder struct {
+	envelope protos.EventEnvelope
+	encoding protos.EventEncoding
+	registry *schemaregistry.Client
+
+	avroSchemas  map[string]*avroSchema
+	protoSchemas map[string]*protoSchema
+}
+
+// NewEncoder creates an encoder, the registry is only needed by the Avro and Protobuf encodings.
+func NewEncoder(
+	envelope protos.EventEnvelope,
+	encoding protos.EventEncoding,
+	registry *schemaregistry.Client,
+) (*Encoder, error) {
+	if encoding != protos.EventEncoding_EVENT_ENCODING_JSON {
+		if registry == nil {
+			return nil, fmt.Errorf("the %s encoding requires a schema registry", encoding)
+		}
+		// CloudEvents are sent in the structured mode, which is JSON.
+		if envelope == protos.EventEnvelope_EVENT_ENVELOPE_CLOUDEVENTS {
+			return nil, fmt.Errorf("the CloudEvents envelope is not supported with the %s encoding", encoding)
+		}
+	}
+
+	return &Encoder{
+		envelope:     envelope,
+		encoding:     encoding,
+		registry:     registry,
+		avroSchemas:  make(map[string]*avroSchema),
+		protoSchemas: make(map[string]*protoSchema),
+	}, nil
+}
+
+// Encode returns the payload of the event of a record sent to a topic, tableSchema is the schema
+// of the table of the topic, or nil when it isn't known.
+func (e *Encoder) Encode(
+	ctx context.Context,
+	record model.Record,
+	topic string,
+	tableSchema *protos.TableSchema,
+	flowJobName string,
+) ([]byte, error) {
+	switch e.encoding {
+	case protos.EventEncoding_EVENT_ENCODING_JSON:
+		return Marshal(record, e.envelope, flowJobName)
+	case protos.EventEncoding_EVENT_ENCODING_AVRO:
+		return e.encodeAvro(ctx, record, topic, tableSchema, flowJobName)
+	case protos.EventEncoding_EVENT_ENCODING_PROTOBUF:
+		return e.encodeProtobuf(ctx, record, topic, tableSchema, flowJobName)
+	default:
+		return nil, fmt.Errorf("unsupported event encoding: %s", e.encoding)
+	}
+}
+
+// subject is the subject the schemas of a topic are registered under, following the TopicNameStrategy.
+func subject(topic string) string {
+	return topic + "-value"
+}
+
+// column is a column of the rows of a topic.
+type column struct {
+	name string
+	kind qvalue.QValueKind
+}
+
+// rowColumns returns the columns of the rows of a topic sorted by name, the columns of the table schema
+// along with the columns of the images of a record, whose kinds take precedence.
+func rowColumns(tableSchema *protos.TableSchema, images ...model.RecordItems) []column {
+	kinds := make(map[string]qvalue.QValueKind)
+	if tableSchema != nil {
+		for name, kind := range tableSchema.Columns {
+			kinds[name] = qvalue.QValueKind(kind)
+		}
+	}
+	for _, items := range images {
+		for name, value := range items {
+			kinds[name] = value.Kind
+		}
+	}
+
+	columns := make([]column, 0, len(kinds))
+	for name, kind := range kinds {
+		columns = append(columns, column{name: name, kind: kind})
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		return columns[i].name < columns[j].name
+	})
+	return columns
+}
+
+// columnsKey identifies the schema of a topic built for a set of columns.
+func columnsKey(topic string, columns []column) string {
+	var key strings.Builder
+	key.WriteString(topic)
+	for _, c := range columns {
+		key.WriteString("\x00")
+		key.WriteString(c.name)
+		key.WriteString(":")
+		key.WriteString(string(c.kind))
+	}
+	return key.String()
+}
+
+// encodedKind is the kind values of a kind are encoded as, types without a binary counterpart are strings.
+func encodedKind(kind qvalue.QValueKind) qvalue.QValueKind {
+	switch kind {
+	case qvalue.QValueKindInt16, qvalue.QValueKindInt32, qvalue.QValueKindInt64,
+		qvalue.QValueKindFloat32, qvalue.QValueKindFloat64, qvalue.QValueKindBoolean,
+		qvalue.QValueKindBytes, qvalue.QValueKindBit, qvalue.QValueKindNumeric,
+		qvalue.QValueKindUUID, qvalue.QValueKindJSON, qvalue.QValueKindString,
+		qvalue.QValueKindArrayInt32, qvalue.QValueKindArrayInt64, qvalue.QValueKindArrayFloat32,
+		qvalue.QValueKindArrayFloat64, qvalue.QValueKindArrayString:
+		return kind
+	default:
+		return qvalue.QValueKindString
+	}
+}
+
+// stringValue returns the string a value is encoded as, times are in RFC 3339.
+func stringValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case time.Time:
+		return v.UTC().Format(time.RFC3339Nano)
+	case *big.Rat:
+		// the denominator of a numeric is a power of ten, which has fewer digits than bits.
+		return v.FloatString(v.Denom().BitLen())
+	case [16]byte:
+		return uuid.UUID(v).String()
+	case uuid.UUID:
+		return v.String()
+	case fmt.Stringer:
+		return v.String()
+	default:
+		if encoded, err := json.Marshal(v); err == nil {
+			return string(encoded)
+		}
+		return fmt.Sprint(v)
+	}
+}
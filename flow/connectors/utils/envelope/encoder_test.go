@@ -0,0 +1,193 @@
+package envelope
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils/schemaregistry"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/linkedin/goavro/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// testRegistry is a schema registry that keeps the versions of the subjects in memory.
+type testRegistry struct {
+	mu       sync.Mutex
+	schemas  []string
+	subjects map[string][]int
+}
+
+func newTestRegistry(t *testing.T) (*testRegistry, *schemaregistry.Client) {
+	registry := &testRegistry{subjects: make(map[string][]int)}
+	server := httptest.NewServer(registry)
+	t.Cleanup(server.Close)
+	return registry, schemaregistry.NewClient(server.URL, "", "")
+}
+
+func (r *testRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	parts := strings.Split(strings.TrimPrefix(req.URL.Path, "/subjects/"), "/")
+	subject := parts[0]
+	versions := r.subjects[subject]
+	switch {
+	case req.Method == http.MethodGet && len(parts) == 3 && parts[2] == "latest":
+		if len(versions) == 0 {
+			http.NotFound(w, req)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"schema": r.schemas[versions[len(versions)-1]-1]})
+	case req.Method == http.MethodPost:
+		var body struct {
+			Schema string `json:"schema"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, id := range versions {
+			if r.schemas[id-1] == body.Schema {
+				_ = json.NewEncoder(w).Encode(map[string]int{"id": id})
+				return
+			}
+		}
+		r.schemas = append(r.schemas, body.Schema)
+		r.subjects[subject] = append(versions, len(r.schemas))
+		_ = json.NewEncoder(w).Encode(map[string]int{"id": len(r.schemas)})
+	default:
+		http.NotFound(w, req)
+	}
+}
+
+func (r *testRegistry) versions(subject string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.subjects[subject])
+}
+
+// unframe returns the schema id and the payload of an event in the wire format of the registry.
+func unframe(t *testing.T, event []byte, messageIndexes int) (int, []byte) {
+	if len(event) < 5+messageIndexes || event[0] != 0 {
+		t.Fatalf("event is not in the wire format: %v", event)
+	}
+	return int(binary.BigEndian.Uint32(event[1:5])), event[5+messageIndexes:]
+}
+
+func TestAvroEncoding(t *testing.T) {
+	registry, client := newTestRegistry(t)
+	encoder, err := NewEncoder(protos.EventEnvelope_EVENT_ENVELOPE_NONE,
+		protos.EventEncoding_EVENT_ENCODING_AVRO, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tableSchema := &protos.TableSchema{
+		Columns: map[string]string{"id": "int64", "name": "string", "created_at": "timestamp"},
+	}
+
+	createdAt := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	record := &model.InsertRecord{
+		Items: model.RecordItems{
+			"id":         qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(1)},
+			"name":       qvalue.QValue{Kind: qvalue.QValueKindString, Value: "alice"},
+			"created_at": qvalue.QValue{Kind: qvalue.QValueKindTimestamp, Value: createdAt},
+		},
+	}
+	event, err := encoder.Encode(context.Background(), record, "users", tableSchema, "users_flow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, payload := unframe(t, event, 0)
+	codec, err := goavro.NewCodec(registry.schemas[id-1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	native, _, err := codec.NativeFromBinary(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	row := native.(map[string]interface{})
+	if row["id"].(map[string]interface{})["long"] != int64(1) ||
+		row["created_at"].(map[string]interface{})["string"] != "2023-09-01T12:00:00Z" {
+		t.Errorf("unexpected row %v", row)
+	}
+
+	// a new column registers a new version of the schema.
+	tableSchema.Columns["email"] = "string"
+	if _, err := encoder.Encode(context.Background(), record, "users", tableSchema, "users_flow"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := encoder.Encode(context.Background(), record, "users", tableSchema, "users_flow"); err != nil {
+		t.Fatal(err)
+	}
+	if versions := registry.versions("users-value"); versions != 2 {
+		t.Errorf("expected 2 versions of the schema, got %d", versions)
+	}
+}
+
+func TestProtobufEncoding(t *testing.T) {
+	registry, client := newTestRegistry(t)
+	encoder, err := NewEncoder(protos.EventEnvelope_EVENT_ENVELOPE_DEBEZIUM,
+		protos.EventEncoding_EVENT_ENCODING_PROTOBUF, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tableSchema := &protos.TableSchema{Columns: map[string]string{"name": "string", "tags": "array_string"}}
+
+	record := &model.DeleteRecord{
+		SourceTableName: "public.users",
+		CheckPointID:    1000,
+		Items: model.RecordItems{
+			"name": qvalue.QValue{Kind: qvalue.QValueKindString, Value: "alice"},
+			"tags": qvalue.QValue{Kind: qvalue.QValueKindArrayString, Value: []string{"a", "b"}},
+		},
+	}
+	event, err := encoder.Encode(context.Background(), record, "users", tableSchema, "users_flow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, payload := unframe(t, event, 1)
+	schema := encoder.protoSchemas[columnsKey("users", rowColumns(tableSchema, record.Items))]
+	change := dynamicpb.NewMessage(schema.event)
+	if err := proto.Unmarshal(payload, change); err != nil {
+		t.Fatal(err)
+	}
+	before := change.Get(schema.event.Fields().ByName("before")).Message()
+	if name := before.Get(schema.value.Fields().ByName("name")).String(); name != "alice" {
+		t.Errorf("expected name alice, got %s", name)
+	}
+	if tags := before.Get(schema.value.Fields().ByName("tags")).List(); tags.Len() != 2 {
+		t.Errorf("expected 2 tags, got %d", tags.Len())
+	}
+	if op := change.Get(schema.event.Fields().ByName("op")).String(); op != OpDelete {
+		t.Errorf("expected op %s, got %s", OpDelete, op)
+	}
+
+	// the columns keep their field numbers when columns are added or dropped.
+	numbers, _ := registeredFieldNumbers(registry.schemas[0])
+	delete(tableSchema.Columns, "tags")
+	tableSchema.Columns["email"] = "string"
+	delete(record.Items, "tags")
+	if _, err := encoder.Encode(context.Background(), record, "users", tableSchema, "users_flow"); err != nil {
+		t.Fatal(err)
+	}
+	newNumbers, reserved := registeredFieldNumbers(registry.schemas[1])
+	if newNumbers["name"] != numbers["name"] || newNumbers["email"] != 3 {
+		t.Errorf("unexpected field numbers %v, previously %v", newNumbers, numbers)
+	}
+	if len(reserved) != 1 || reserved[0] != numbers["tags"] {
+		t.Errorf("expected the number of the dropped column to be reserved, got %v", reserved)
+	}
+}
@@ -0,0 +1,207 @@
+// Package envelope wraps the change records of CDC in the event formats of streaming destinations.
+package envelope
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/jackc/pglogrepl"
+)
+
+const (
+	OpCreate = "c"
+	OpUpdate = "u"
+	OpDelete = "d"
+
+	// debeziumUnavailableValue is the placeholder Debezium uses for unchanged TOAST columns,
+	// whose values are not part of the change.
+	debeziumUnavailableValue = "__debezium_unavailable_value"
+	cloudEventsSpecVersion   = "1.0"
+	cloudEventsTypePrefix    = "io.peerdb.cdc."
+	sourceConnector          = "postgresql"
+	sourceVersion            = "peerdb"
+)
+
+// Source is where a change came from.
+type Source struct {
+	Version   string `json:"version"`
+	Connector string `json:"connector"`
+	// Name is the name of the flow that replicated the change.
+	Name   string `json:"name"`
+	TsMs   int64  `json:"ts_ms"`
+	Schema string `json:"schema"`
+	Table  string `json:"table"`
+	TxID   uint32 `json:"txId"`
+	LSN    int64  `json:"lsn"`
+}
+
+// Change is the payload of a Debezium change event, see
+// https://debezium.io/documentation/reference/stable/connectors/postgresql.html
+type Change struct {
+	Before map[string]interface{} `json:"before"`
+	After  map[string]interface{} `json:"after"`
+	Source Source                 `json:"source"`
+	Op     string                 `json:"op"`
+	TsMs   int64                  `json:"ts_ms"`
+}
+
+// CloudEvent is a CloudEvents event in the structured JSON format, https://cloudevents.io
+type CloudEvent struct {
+	SpecVersion     string `json:"specversion"`
+	ID              string `json:"id"`
+	Source          string `json:"source"`
+	Type            string `json:"type"`
+	Subject         string `json:"subject"`
+	Time            string `json:"time"`
+	DataContentType string `json:"datacontenttype"`
+	Data            Change `json:"data"`
+}
+
+// Marshal returns the JSON payload of the event of a record in the given envelope.
+func Marshal(record model.Record, envelope protos.EventEnvelope, flowJobName string) ([]byte, error) {
+	if envelope == protos.EventEnvelope_EVENT_ENVELOPE_NONE {
+		items, err := record.GetItems().ToJSONMap()
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(items)
+	}
+
+	change, err := NewChange(record, flowJobName)
+	if err != nil {
+		return nil, err
+	}
+
+	switch envelope {
+	case protos.EventEnvelope_EVENT_ENVELOPE_DEBEZIUM:
+		return json.Marshal(change)
+	case protos.EventEnvelope_EVENT_ENVELOPE_CLOUDEVENTS:
+		return json.Marshal(newCloudEvent(change, record))
+	default:
+		return nil, fmt.Errorf("unsupported event envelope: %s", envelope)
+	}
+}
+
+// NewChange returns the change of a record, with the before and after images of the row.
+func NewChange(record model.Record, flowJobName string) (*Change, error) {
+	images, err := newRowImages(record)
+	if err != nil {
+		return nil, err
+	}
+
+	change := &Change{
+		Op:     images.op,
+		Source: newSource(record, images, flowJobName),
+		TsMs:   time.Now().UnixMilli(),
+	}
+	if images.before != nil {
+		change.Before, err = images.before.ToJSONMap()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if images.after != nil {
+		change.After, err = images.after.ToJSONMap()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for column := range images.unchangedToastColumns {
+		if _, ok := change.After[column]; !ok {
+			change.After[column] = debeziumUnavailableValue
+		}
+	}
+	return change, nil
+}
+
+// rowImages are the images of the row changed by a record.
+type rowImages struct {
+	op                    string
+	before                model.RecordItems
+	after                 model.RecordItems
+	sourceTableName       string
+	transactionID         uint32
+	unchangedToastColumns map[string]bool
+}
+
+func newRowImages(record model.Record) (*rowImages, error) {
+	switch r := record.(type) {
+	case *model.InsertRecord:
+		return &rowImages{
+			op:                    OpCreate,
+			after:                 r.Items,
+			sourceTableName:       r.SourceTableName,
+			transactionID:         r.TransactionID,
+			unchangedToastColumns: r.UnchangedToastColumns,
+		}, nil
+	case *model.UpdateRecord:
+		images := &rowImages{
+			op:                    OpUpdate,
+			after:                 r.NewItems,
+			sourceTableName:       r.SourceTableName,
+			transactionID:         r.TransactionID,
+			unchangedToastColumns: r.UnchangedToastColumns,
+		}
+		// the old image is only sent for tables with REPLICA IDENTITY FULL, or when the key changed.
+		if len(r.OldItems) > 0 {
+			images.before = r.OldItems
+		}
+		return images, nil
+	case *model.DeleteRecord:
+		return &rowImages{
+			op:              OpDelete,
+			before:          r.Items,
+			sourceTableName: r.SourceTableName,
+			transactionID:   r.TransactionID,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported record type %T", record)
+	}
+}
+
+func newSource(record model.Record, images *rowImages, flowJobName string) Source {
+	schema, table := "", images.sourceTableName
+	if i := strings.Index(images.sourceTableName, "."); i >= 0 {
+		schema, table = images.sourceTableName[:i], images.sourceTableName[i+1:]
+	}
+	return Source{
+		Version:   sourceVersion,
+		Connector: sourceConnector,
+		Name:      flowJobName,
+		TsMs:      record.GetCommitTime().UnixMilli(),
+		Schema:    schema,
+		Table:     table,
+		TxID:      images.transactionID,
+		LSN:       record.GetCheckPointID(),
+	}
+}
+
+func newCloudEvent(change *Change, record model.Record) *CloudEvent {
+	opTypes := map[string]string{
+		OpCreate: "insert",
+		OpUpdate: "update",
+		OpDelete: "delete",
+	}
+
+	subject := change.Source.Table
+	if change.Source.Schema != "" {
+		subject = change.Source.Schema + "." + change.Source.Table
+	}
+
+	return &CloudEvent{
+		SpecVersion: cloudEventsSpecVersion,
+		// the LSN of a change is unique within the source database.
+		ID:              pglogrepl.LSN(record.GetCheckPointID()).String(),
+		Source:          fmt.Sprintf("/peerdb/%s", change.Source.Name),
+		Type:            cloudEventsTypePrefix + opTypes[change.Op],
+		Subject:         subject,
+		Time:            record.GetCommitTime().UTC().Format(time.RFC3339Nano),
+		DataContentType: "application/json",
+		Data:            *change,
+	}
+}
@@ -0,0 +1,88 @@
+package envelope
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+)
+
+func TestDebeziumEnvelope(t *testing.T) {
+	commitTime := time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC)
+	record := &model.UpdateRecord{
+		SourceTableName: "public.users",
+		CheckPointID:    1000,
+		CommitTime:      commitTime,
+		TransactionID:   42,
+		OldItems: model.RecordItems{
+			"id": qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(1)},
+		},
+		NewItems: model.RecordItems{
+			"id":   qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(1)},
+			"name": qvalue.QValue{Kind: qvalue.QValueKindString, Value: "alice"},
+		},
+		UnchangedToastColumns: map[string]bool{"bio": true},
+	}
+
+	payload, err := Marshal(record, protos.EventEnvelope_EVENT_ENVELOPE_DEBEZIUM, "users_flow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var change Change
+	if err := json.Unmarshal(payload, &change); err != nil {
+		t.Fatal(err)
+	}
+	if change.Op != OpUpdate {
+		t.Errorf("expected op %s, got %s", OpUpdate, change.Op)
+	}
+	if change.Before["id"] != float64(1) || change.After["name"] != "alice" {
+		t.Errorf("unexpected before %v and after %v", change.Before, change.After)
+	}
+	if change.After["bio"] != debeziumUnavailableValue {
+		t.Errorf("expected the unchanged toast column to be unavailable, got %v", change.After["bio"])
+	}
+	expectedSource := Source{
+		Version:   sourceVersion,
+		Connector: sourceConnector,
+		Name:      "users_flow",
+		TsMs:      commitTime.UnixMilli(),
+		Schema:    "public",
+		Table:     "users",
+		TxID:      42,
+		LSN:       1000,
+	}
+	if change.Source != expectedSource {
+		t.Errorf("unexpected source %+v", change.Source)
+	}
+}
+
+func TestCloudEventsEnvelope(t *testing.T) {
+	record := &model.DeleteRecord{
+		SourceTableName: "public.users",
+		CheckPointID:    0x16B3748,
+		CommitTime:      time.Date(2023, 9, 1, 12, 0, 0, 0, time.UTC),
+		Items: model.RecordItems{
+			"id": qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(1)},
+		},
+	}
+
+	payload, err := Marshal(record, protos.EventEnvelope_EVENT_ENVELOPE_CLOUDEVENTS, "users_flow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var event CloudEvent
+	if err := json.Unmarshal(payload, &event); err != nil {
+		t.Fatal(err)
+	}
+	if event.ID != "0/16B3748" || event.Type != "io.peerdb.cdc.delete" || event.Subject != "public.users" {
+		t.Errorf("unexpected event %+v", event)
+	}
+	if event.Data.Op != OpDelete || event.Data.After != nil || event.Data.Before["id"] != float64(1) {
+		t.Errorf("unexpected change %+v", event.Data)
+	}
+}
@@ -0,0 +1,485 @@
+package envelope
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils/schemaregistry"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	protoPackage = "peerdb"
+	// the first message of the schema is the message of the events.
+	protoEventMessageIndex = 0
+)
+
+var (
+	protoFieldPattern    = regexp.MustCompile(`^\s*(?:optional |repeated )?[\w.]+ (\w+) = (\d+);`)
+	protoReservedPattern = regexp.MustCompile(`^\s*reserved ([\d, ]+);`)
+)
+
+type protoSchema struct {
+	id      int
+	event   protoreflect.MessageDescriptor
+	value   protoreflect.MessageDescriptor
+	source  protoreflect.MessageDescriptor
+	columns []column
+}
+
+type protoField struct {
+	name     string
+	number   int32
+	kind     descriptorpb.FieldDescriptorProto_Type
+	typeName string
+	repeated bool
+	optional bool
+}
+
+type protoMessage struct {
+	name     string
+	fields   []protoField
+	reserved []int32
+}
+
+// protoFieldType returns the type and whether the field is repeated, for the values of a kind.
+func protoFieldType(kind qvalue.QValueKind) (descriptorpb.FieldDescriptorProto_Type, bool) {
+	switch encodedKind(kind) {
+	case qvalue.QValueKindInt16, qvalue.QValueKindInt32, qvalue.QValueKindInt64:
+		return descriptorpb.FieldDescriptorProto_TYPE_INT64, false
+	case qvalue.QValueKindFloat32:
+		return descriptorpb.FieldDescriptorProto_TYPE_FLOAT, false
+	case qvalue.QValueKindFloat64:
+		return descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, false
+	case qvalue.QValueKindBoolean:
+		return descriptorpb.FieldDescriptorProto_TYPE_BOOL, false
+	case qvalue.QValueKindBytes, qvalue.QValueKindBit:
+		return descriptorpb.FieldDescriptorProto_TYPE_BYTES, false
+	case qvalue.QValueKindArrayInt32:
+		return descriptorpb.FieldDescriptorProto_TYPE_INT32, true
+	case qvalue.QValueKindArrayInt64:
+		return descriptorpb.FieldDescriptorProto_TYPE_INT64, true
+	case qvalue.QValueKindArrayFloat32:
+		return descriptorpb.FieldDescriptorProto_TYPE_FLOAT, true
+	case qvalue.QValueKindArrayFloat64:
+		return descriptorpb.FieldDescriptorProto_TYPE_DOUBLE, true
+	case qvalue.QValueKindArrayString:
+		return descriptorpb.FieldDescriptorProto_TYPE_STRING, true
+	default:
+		// numerics are decimal strings, and times are in RFC 3339.
+		return descriptorpb.FieldDescriptorProto_TYPE_STRING, false
+	}
+}
+
+// registeredFieldNumbers returns the field numbers of the columns in the Value message of a registered schema,
+// along with the numbers that are reserved.
+func registeredFieldNumbers(schema string) (map[string]int32, []int32) {
+	numbers := make(map[string]int32)
+	var reserved []int32
+
+	inValue := false
+	for _, line := range strings.Split(schema, "\n") {
+		switch {
+		case strings.HasPrefix(line, "message "+valueMessage+" "):
+			inValue = true
+		case inValue && strings.HasPrefix(line, "}"):
+			inValue = false
+		case inValue:
+			if match := protoFieldPattern.FindStringSubmatch(line); match != nil {
+				number, err := strconv.ParseInt(match[2], 10, 32)
+				if err == nil {
+					numbers[match[1]] = int32(number)
+				}
+			} else if match := protoReservedPattern.FindStringSubmatch(line); match != nil {
+				for _, n := range strings.Split(match[1], ",") {
+					number, err := strconv.ParseInt(strings.TrimSpace(n), 10, 32)
+					if err == nil {
+						reserved = append(reserved, int32(number))
+					}
+				}
+			}
+		}
+	}
+	return numbers, reserved
+}
+
+// protoValueMessage returns the message of the rows of a topic. Columns keep the field numbers they have
+// in the previous version of the schema, new columns get unused numbers and the numbers of dropped
+// columns are reserved, so that consumers can keep reading events with an older version of the schema.
+func protoValueMessage(columns []column, previous map[string]int32, previousReserved []int32) protoMessage {
+	message := protoMessage{name: valueMessage}
+
+	maxNumber := int32(0)
+	for _, number := range previousReserved {
+		if number > maxNumber {
+			maxNumber = number
+		}
+	}
+	for _, number := range previous {
+		if number > maxNumber {
+			maxNumber = number
+		}
+	}
+
+	current := make(map[string]bool, len(columns))
+	for _, c := range columns {
+		current[c.name] = true
+		number, ok := previous[c.name]
+		if !ok {
+			maxNumber++
+			number = maxNumber
+		}
+		kind, repeated := protoFieldType(c.kind)
+		message.fields = append(message.fields, protoField{
+			name:     c.name,
+			number:   number,
+			kind:     kind,
+			repeated: repeated,
+			optional: !repeated,
+		})
+	}
+
+	message.reserved = append(message.reserved, previousReserved...)
+	for name, number := range previous {
+		if !current[name] {
+			message.reserved = append(message.reserved, number)
+		}
+	}
+	sort.Slice(message.reserved, func(i, j int) bool {
+		return message.reserved[i] < message.reserved[j]
+	})
+	return message
+}
+
+// protoEnvelopeMessages returns the messages of Debezium change events.
+func protoEnvelopeMessages() []protoMessage {
+	valueType := "." + protoPackage + "." + valueMessage
+	sourceType := "." + protoPackage + "." + sourceMessage
+	return []protoMessage{
+		{
+			name: envelopeMessage,
+			fields: []protoField{
+				{name: "before", number: 1, kind: descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, typeName: valueType},
+				{name: "after", number: 2, kind: descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, typeName: valueType},
+				{name: "source", number: 3, kind: descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, typeName: sourceType},
+				{name: "op", number: 4, kind: descriptorpb.FieldDescriptorProto_TYPE_STRING},
+				{name: "ts_ms", number: 5, kind: descriptorpb.FieldDescriptorProto_TYPE_INT64},
+			},
+		},
+		{
+			name: sourceMessage,
+			fields: []protoField{
+				{name: "version", number: 1, kind: descriptorpb.FieldDescriptorProto_TYPE_STRING},
+				{name: "connector", number: 2, kind: descriptorpb.FieldDescriptorProto_TYPE_STRING},
+				{name: "name", number: 3, kind: descriptorpb.FieldDescriptorProto_TYPE_STRING},
+				{name: "ts_ms", number: 4, kind: descriptorpb.FieldDescriptorProto_TYPE_INT64},
+				{name: "schema", number: 5, kind: descriptorpb.FieldDescriptorProto_TYPE_STRING},
+				{name: "table", number: 6, kind: descriptorpb.FieldDescriptorProto_TYPE_STRING},
+				{name: "tx_id", number: 7, kind: descriptorpb.FieldDescriptorProto_TYPE_INT64},
+				{name: "lsn", number: 8, kind: descriptorpb.FieldDescriptorProto_TYPE_INT64},
+			},
+		},
+	}
+}
+
+// protoSchemaText returns the .proto definition of the messages, which is what the registry stores.
+func protoSchemaText(messages []protoMessage) string {
+	var text strings.Builder
+	fmt.Fprintf(&text, "syntax = \"proto3\";\n\npackage %s;\n", protoPackage)
+	for _, message := range messages {
+		fmt.Fprintf(&text, "\nmessage %s {\n", message.name)
+		for _, field := range message.fields {
+			typeName := strings.TrimPrefix(field.typeName, "."+protoPackage+".")
+			if field.typeName == "" {
+				typeName = strings.ToLower(strings.TrimPrefix(field.kind.String(), "TYPE_"))
+			}
+			label := ""
+			if field.repeated {
+				label = "repeated "
+			} else if field.optional {
+				label = "optional "
+			}
+			fmt.Fprintf(&text, "  %s%s %s = %d;\n", label, typeName, field.name, field.number)
+		}
+		if len(message.reserved) > 0 {
+			numbers := make([]string, 0, len(message.reserved))
+			for _, number := range message.reserved {
+				numbers = append(numbers, strconv.Itoa(int(number)))
+			}
+			fmt.Fprintf(&text, "  reserved %s;\n", strings.Join(numbers, ", "))
+		}
+		text.WriteString("}\n")
+	}
+	return text.String()
+}
+
+// protoFileDescriptor returns the descriptor of the messages, which is used to encode the events.
+func protoFileDescriptor(topic string, messages []protoMessage) (protoreflect.FileDescriptor, error) {
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String(topic + ".proto"),
+		Package: proto.String(protoPackage),
+		Syntax:  proto.String("proto3"),
+	}
+
+	for _, message := range messages {
+		descriptor := &descriptorpb.DescriptorProto{Name: proto.String(message.name)}
+		for _, field := range message.fields {
+			label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+			if field.repeated {
+				label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+			}
+			fieldDescriptor := &descriptorpb.FieldDescriptorProto{
+				Name:     proto.String(field.name),
+				JsonName: proto.String(field.name),
+				Number:   proto.Int32(field.number),
+				Label:    label.Enum(),
+				Type:     field.kind.Enum(),
+			}
+			if field.typeName != "" {
+				fieldDescriptor.TypeName = proto.String(field.typeName)
+			}
+			// optional fields of proto3 are in a synthetic oneof of their own.
+			if field.optional {
+				fieldDescriptor.Proto3Optional = proto.Bool(true)
+				fieldDescriptor.OneofIndex = proto.Int32(int32(len(descriptor.OneofDecl)))
+				descriptor.OneofDecl = append(descriptor.OneofDecl,
+					&descriptorpb.OneofDescriptorProto{Name: proto.String("_" + field.name)})
+			}
+			descriptor.Field = append(descriptor.Field, fieldDescriptor)
+		}
+		for _, number := range message.reserved {
+			descriptor.ReservedRange = append(descriptor.ReservedRange,
+				&descriptorpb.DescriptorProto_ReservedRange{Start: proto.Int32(number), End: proto.Int32(number + 1)})
+		}
+		file.MessageType = append(file.MessageType, descriptor)
+	}
+
+	return protodesc.NewFile(file, new(protoregistry.Files))
+}
+
+// protoSchemaFor returns the registered schema of the events of a topic with the given columns.
+func (e *Encoder) protoSchemaFor(ctx context.Context, topic string, columns []column) (*protoSchema, error) {
+	key := columnsKey(topic, columns)
+	if schema, ok := e.protoSchemas[key]; ok {
+		return schema, nil
+	}
+
+	latest, err := e.registry.LatestSchema(ctx, subject(topic))
+	if err != nil {
+		return nil, err
+	}
+	previous, previousReserved := registeredFieldNumbers(latest)
+
+	messages := []protoMessage{protoValueMessage(columns, previous, previousReserved)}
+	if e.envelope == protos.EventEnvelope_EVENT_ENVELOPE_DEBEZIUM {
+		envelopeMessages := protoEnvelopeMessages()
+		messages = []protoMessage{envelopeMessages[0], messages[0], envelopeMessages[1]}
+	}
+
+	file, err := protoFileDescriptor(topic, messages)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build Protobuf schema for topic %s: %w", topic, err)
+	}
+	id, err := e.registry.Register(ctx, subject(topic), schemaregistry.SchemaTypeProtobuf, protoSchemaText(messages))
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &protoSchema{
+		id:      id,
+		event:   file.Messages().Get(protoEventMessageIndex),
+		value:   file.Messages().ByName(valueMessage),
+		source:  file.Messages().ByName(sourceMessage),
+		columns: columns,
+	}
+	e.protoSchemas[key] = schema
+	return schema, nil
+}
+
+// protoValue returns the Protobuf value of a value of a column, for a field of the given kind.
+func protoValue(value interface{}, kind protoreflect.Kind) (protoreflect.Value, error) {
+	switch kind {
+	case protoreflect.Int64Kind:
+		switch v := value.(type) {
+		case int16:
+			return protoreflect.ValueOfInt64(int64(v)), nil
+		case int32:
+			return protoreflect.ValueOfInt64(int64(v)), nil
+		case int64:
+			return protoreflect.ValueOfInt64(v), nil
+		}
+	case protoreflect.Int32Kind:
+		if v, ok := value.(int32); ok {
+			return protoreflect.ValueOfInt32(v), nil
+		}
+	case protoreflect.FloatKind:
+		if v, ok := value.(float32); ok {
+			return protoreflect.ValueOfFloat32(v), nil
+		}
+	case protoreflect.DoubleKind:
+		switch v := value.(type) {
+		case float32:
+			return protoreflect.ValueOfFloat64(float64(v)), nil
+		case float64:
+			return protoreflect.ValueOfFloat64(v), nil
+		}
+	case protoreflect.BoolKind:
+		if v, ok := value.(bool); ok {
+			return protoreflect.ValueOfBool(v), nil
+		}
+	case protoreflect.BytesKind:
+		if v, ok := value.([]byte); ok {
+			return protoreflect.ValueOfBytes(v), nil
+		}
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(stringValue(value)), nil
+	}
+	return protoreflect.Value{}, fmt.Errorf("unexpected value %v of type %T for a %s field", value, value, kind)
+}
+
+// setProtoList appends the elements of an array value to a repeated field.
+func setProtoList(list protoreflect.List, value interface{}, kind protoreflect.Kind) error {
+	var elements []interface{}
+	switch v := value.(type) {
+	case []int32:
+		for _, e := range v {
+			elements = append(elements, e)
+		}
+	case []int64:
+		for _, e := range v {
+			elements = append(elements, e)
+		}
+	case []float32:
+		for _, e := range v {
+			elements = append(elements, e)
+		}
+	case []float64:
+		for _, e := range v {
+			elements = append(elements, e)
+		}
+	case []string:
+		for _, e := range v {
+			elements = append(elements, e)
+		}
+	default:
+		return fmt.Errorf("unexpected array value %v of type %T", value, value)
+	}
+
+	for _, element := range elements {
+		protoElement, err := protoValue(element, kind)
+		if err != nil {
+			return err
+		}
+		list.Append(protoElement)
+	}
+	return nil
+}
+
+// protoRow returns the Value message of a row, columns the row doesn't have are not set.
+func protoRow(schema *protoSchema, items model.RecordItems) (*dynamicpb.Message, error) {
+	row := dynamicpb.NewMessage(schema.value)
+	for _, c := range schema.columns {
+		value, ok := items[c.name]
+		if !ok || value.Value == nil {
+			continue
+		}
+
+		field := schema.value.Fields().ByName(protoreflect.Name(c.name))
+		if field.IsList() {
+			if err := setProtoList(row.Mutable(field).List(), value.Value, field.Kind()); err != nil {
+				return nil, fmt.Errorf("failed to convert column %s to Protobuf: %w", c.name, err)
+			}
+			continue
+		}
+		protoVal, err := protoValue(value.Value, field.Kind())
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert column %s to Protobuf: %w", c.name, err)
+		}
+		row.Set(field, protoVal)
+	}
+	return row, nil
+}
+
+func (e *Encoder) encodeProtobuf(
+	ctx context.Context,
+	record model.Record,
+	topic string,
+	tableSchema *protos.TableSchema,
+	flowJobName string,
+) ([]byte, error) {
+	images, err := newRowImages(record)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := e.protoSchemaFor(ctx, topic, rowColumns(tableSchema, images.before, images.after))
+	if err != nil {
+		return nil, err
+	}
+
+	var event *dynamicpb.Message
+	if e.envelope == protos.EventEnvelope_EVENT_ENVELOPE_DEBEZIUM {
+		event, err = protoChange(schema, record, images, flowJobName)
+	} else {
+		event, err = protoRow(schema, record.GetItems())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode record as Protobuf: %w", err)
+	}
+	return schemaregistry.Frame(schema.id, []int{protoEventMessageIndex}, payload), nil
+}
+
+// protoChange returns the Debezium change event of a record, unchanged TOAST columns are not set.
+func protoChange(
+	schema *protoSchema,
+	record model.Record,
+	images *rowImages,
+	flowJobName string,
+) (*dynamicpb.Message, error) {
+	change := dynamicpb.NewMessage(schema.event)
+	fields := schema.event.Fields()
+	for name, items := range map[protoreflect.Name]model.RecordItems{"before": images.before, "after": images.after} {
+		if items == nil {
+			continue
+		}
+		row, err := protoRow(schema, items)
+		if err != nil {
+			return nil, err
+		}
+		change.Set(fields.ByName(name), protoreflect.ValueOfMessage(row))
+	}
+
+	source := newSource(record, images, flowJobName)
+	sourceRow := dynamicpb.NewMessage(schema.source)
+	sourceFields := schema.source.Fields()
+	sourceRow.Set(sourceFields.ByName("version"), protoreflect.ValueOfString(source.Version))
+	sourceRow.Set(sourceFields.ByName("connector"), protoreflect.ValueOfString(source.Connector))
+	sourceRow.Set(sourceFields.ByName("name"), protoreflect.ValueOfString(source.Name))
+	sourceRow.Set(sourceFields.ByName("ts_ms"), protoreflect.ValueOfInt64(source.TsMs))
+	sourceRow.Set(sourceFields.ByName("schema"), protoreflect.ValueOfString(source.Schema))
+	sourceRow.Set(sourceFields.ByName("table"), protoreflect.ValueOfString(source.Table))
+	sourceRow.Set(sourceFields.ByName("tx_id"), protoreflect.ValueOfInt64(int64(source.TxID)))
+	sourceRow.Set(sourceFields.ByName("lsn"), protoreflect.ValueOfInt64(source.LSN))
+
+	change.Set(fields.ByName("source"), protoreflect.ValueOfMessage(sourceRow))
+	change.Set(fields.ByName("op"), protoreflect.ValueOfString(images.op))
+	change.Set(fields.ByName("ts_ms"), protoreflect.ValueOfInt64(time.Now().UnixMilli()))
+	return change, nil
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// HistoryToastDialect is how a warehouse resolves unchanged TOAST columns in history mode.
+type HistoryToastDialect struct {
+	QuoteIdentifier func(string) string
+	// UnchangedCondition returns the condition that a change left the TOAST column unchanged.
+	UnchangedCondition func(columnName string) string
+	// the rows of the batch are grouped by GroupPartitionBy when counting changes, and by
+	// ValuePartitionBy when picking values, both are the primary key as the rows select it.
+	GroupPartitionBy string
+	ValuePartitionBy string
+	OrderBy          string
+	// prefixes of the versions of the batch and of the version that was current before the batch.
+	VersionPrefix string
+	CurrentPrefix string
+}
+
+// HistoryToastSQL holds the window expressions that resolve unchanged TOAST columns in history mode.
+// Versions since the last change of a TOAST column share a toast group and take the value of that
+// change, group 0 means no version of the batch changed it and the current version has its value.
+type HistoryToastSQL struct {
+	// select list entries, each starting with a comma, computing the toast groups of the versions.
+	GroupsSQL string
+	// select list entries, each starting with a comma, computing the values of the toast groups,
+	// they can only be selected from rows that have the toast groups.
+	ValuesSQL string
+	// expression of each TOAST column in the versions to insert.
+	Resolved map[string]string
+}
+
+// GenerateHistoryToastSQL generates the expressions resolving the given TOAST columns.
+func GenerateHistoryToastSQL(toastColumns map[string]struct{}, dialect *HistoryToastDialect) *HistoryToastSQL {
+	columnNames := make([]string, 0, len(toastColumns))
+	for columnName := range toastColumns {
+		columnNames = append(columnNames, columnName)
+	}
+	sort.Strings(columnNames)
+
+	groups := make([]string, 0, len(columnNames))
+	values := make([]string, 0, len(columnNames))
+	resolved := make(map[string]string, len(columnNames))
+	for i, columnName := range columnNames {
+		quotedColumnName := dialect.QuoteIdentifier(columnName)
+		group := fmt.Sprintf("_peerdb_toast_group_%d", i)
+		value := fmt.Sprintf("_peerdb_toast_value_%d", i)
+		groups = append(groups, fmt.Sprintf(
+			",SUM(CASE WHEN %s THEN 0 ELSE 1 END) OVER (PARTITION BY %s ORDER BY %s) AS %s",
+			dialect.UnchangedCondition(columnName), dialect.GroupPartitionBy, dialect.OrderBy, group))
+		values = append(values, fmt.Sprintf(",FIRST_VALUE(%s) OVER (PARTITION BY %s,%s ORDER BY %s) AS %s",
+			quotedColumnName, dialect.ValuePartitionBy, group, dialect.OrderBy, value))
+		resolved[columnName] = fmt.Sprintf("CASE WHEN %s%s=0 THEN %s%s ELSE %s%s END",
+			dialect.VersionPrefix, group, dialect.CurrentPrefix, quotedColumnName, dialect.VersionPrefix, value)
+	}
+	return &HistoryToastSQL{
+		GroupsSQL: strings.Join(groups, ""),
+		ValuesSQL: strings.Join(values, ""),
+		Resolved:  resolved,
+	}
+}
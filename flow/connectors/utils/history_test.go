@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateHistoryToastSQL(t *testing.T) {
+	toastSQL := GenerateHistoryToastSQL(map[string]struct{}{"b": {}, "a": {}}, &HistoryToastDialect{
+		QuoteIdentifier: QuoteIdentifier,
+		UnchangedCondition: func(columnName string) string {
+			return "unchanged_" + columnName
+		},
+		GroupPartitionBy: "pk_cast",
+		ValuePartitionBy: "pk",
+		OrderBy:          "ts",
+		VersionPrefix:    "v.",
+		CurrentPrefix:    "c.",
+	})
+
+	expectedGroups := `,SUM(CASE WHEN unchanged_a THEN 0 ELSE 1 END) OVER (PARTITION BY pk_cast ORDER BY ts)` +
+		` AS _peerdb_toast_group_0` +
+		`,SUM(CASE WHEN unchanged_b THEN 0 ELSE 1 END) OVER (PARTITION BY pk_cast ORDER BY ts)` +
+		` AS _peerdb_toast_group_1`
+	if toastSQL.GroupsSQL != expectedGroups {
+		t.Errorf("expected groups %s, got %s", expectedGroups, toastSQL.GroupsSQL)
+	}
+	expectedValue := `,FIRST_VALUE("b") OVER (PARTITION BY pk,_peerdb_toast_group_1 ORDER BY ts)` +
+		` AS _peerdb_toast_value_1`
+	if !strings.HasSuffix(toastSQL.ValuesSQL, expectedValue) {
+		t.Errorf("expected values ending with %s, got %s", expectedValue, toastSQL.ValuesSQL)
+	}
+	expectedResolved := `CASE WHEN v._peerdb_toast_group_0=0 THEN c."a" ELSE v._peerdb_toast_value_0 END`
+	if toastSQL.Resolved["a"] != expectedResolved {
+		t.Errorf("expected %s, got %s", expectedResolved, toastSQL.Resolved["a"])
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	utils "github.com/PeerDB-io/peer-flow/connectors/utils/partition"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"github.com/jackc/pglogrepl"
 	"github.com/jackc/pgx/v5"
@@ -86,6 +87,25 @@ func (c *CatalogMirrorMonitor) UpdateLatestLSNAtTargetForCDCFlow(ctx context.Con
 	return nil
 }
 
+// UpdateLatestLSNAtTargetForCDCFlowDestination records the progress of one of the additional
+// destinations of a fanned out mirror, cdc_flows only tracks the primary destination.
+func (c *CatalogMirrorMonitor) UpdateLatestLSNAtTargetForCDCFlowDestination(ctx context.Context,
+	flowJobName string, destination string, latestLSNAtTarget pglogrepl.LSN, batchID int64) error {
+	if c == nil || c.catalogConn == nil {
+		return nil
+	}
+
+	_, err := c.catalogConn.Exec(ctx,
+		`INSERT INTO peerdb_stats.cdc_flow_destinations(flow_name,destination,latest_lsn_at_target,latest_batch_id)
+		 VALUES($1,$2,$3,$4) ON CONFLICT(flow_name,destination) DO UPDATE SET
+		 latest_lsn_at_target=EXCLUDED.latest_lsn_at_target,latest_batch_id=EXCLUDED.latest_batch_id`,
+		flowJobName, destination, uint64(latestLSNAtTarget), batchID)
+	if err != nil {
+		return fmt.Errorf("error while updating destination %s in cdc_flow_destinations: %w", destination, err)
+	}
+	return nil
+}
+
 func (c *CatalogMirrorMonitor) AddCDCBatchForFlow(ctx context.Context, flowJobName string,
 	batchInfo CDCBatchInfo) error {
 	if c == nil || c.catalogConn == nil {
@@ -217,6 +237,17 @@ func (c *CatalogMirrorMonitor) AddPartitionToQRepRun(ctx context.Context, flowJo
 			return fmt.Errorf("unable to encode TID as string: %w", err)
 		}
 		rangeEnd = rangeEndValue.(string)
+	case *protos.PartitionRange_StringRange:
+		rangeStart = x.StringRange.Start
+		rangeEnd = x.StringRange.End
+	case *protos.PartitionRange_TupleRange:
+		rangeStart = utils.FormatPartitionValues(x.TupleRange.Start)
+		rangeEnd = utils.FormatPartitionValues(x.TupleRange.End)
+	case *protos.PartitionRange_ObjectRange:
+		if len(x.ObjectRange.Keys) > 0 {
+			rangeStart = x.ObjectRange.Keys[0]
+			rangeEnd = x.ObjectRange.Keys[len(x.ObjectRange.Keys)-1]
+		}
 	default:
 		return fmt.Errorf("unknown range type: %v", x)
 	}
@@ -261,3 +292,22 @@ func (c *CatalogMirrorMonitor) UpdateEndTimeForPartition(ctx context.Context, ru
 	}
 	return nil
 }
+
+// AddDeadLetter records a record of a flow that failed to be written to a destination, a record that fails
+// again replaces the previous entry.
+func (c *CatalogMirrorMonitor) AddDeadLetter(ctx context.Context, flowJobName string, destination string,
+	tableName string, lsn pglogrepl.LSN, record string, errMessage string, failedAt time.Time) error {
+	if c == nil || c.catalogConn == nil {
+		return nil
+	}
+
+	_, err := c.catalogConn.Exec(ctx,
+		`INSERT INTO peerdb_stats.dead_letters(flow_name,destination,table_name,lsn,record,error,failed_at)
+		 VALUES($1,$2,$3,$4,$5,$6,$7) ON CONFLICT(flow_name,destination,lsn)
+		 DO UPDATE SET table_name=$3,record=$5,error=$6,failed_at=$7`,
+		flowJobName, destination, tableName, uint64(lsn), record, errMessage, failedAt)
+	if err != nil {
+		return fmt.Errorf("error while inserting record into dead_letters: %w", err)
+	}
+	return nil
+}
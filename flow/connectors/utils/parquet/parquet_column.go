@@ -0,0 +1,297 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"github.com/google/uuid"
+)
+
+// parquetColumn buffers the values of a column, with their definition and repetition levels,
+// until they are written to the column writer of the current row group.
+type parquetColumn struct {
+	kind      qvalue.QValueKind
+	defLevels []int16
+	repLevels []int16
+
+	bools          []bool
+	int32s         []int32
+	int64s         []int64
+	float32s       []float32
+	float64s       []float64
+	byteArrays     []parquet.ByteArray
+	fixedLenArrays []parquet.FixedLenByteArray
+}
+
+func newParquetColumn(kind qvalue.QValueKind) *parquetColumn {
+	return &parquetColumn{kind: kind}
+}
+
+// append buffers a value of the column. A NULL has definition level 0, for lists an empty list has
+// definition level 1 and every element definition level 2, the first element starts a new row.
+func (c *parquetColumn) append(value interface{}) error {
+	if !qvalue.QValueKindIsArray(c.kind) {
+		if value == nil {
+			c.defLevels = append(c.defLevels, 0)
+			return nil
+		}
+		c.defLevels = append(c.defLevels, 1)
+		return c.appendValue(c.kind, value)
+	}
+
+	if value == nil {
+		c.defLevels = append(c.defLevels, 0)
+		c.repLevels = append(c.repLevels, 0)
+		return nil
+	}
+	elements, err := arrayElements(value)
+	if err != nil {
+		return err
+	}
+	if len(elements) == 0 {
+		c.defLevels = append(c.defLevels, 1)
+		c.repLevels = append(c.repLevels, 0)
+		return nil
+	}
+	elementKind := arrayElementKind(c.kind)
+	for i, element := range elements {
+		c.defLevels = append(c.defLevels, 2)
+		if i == 0 {
+			c.repLevels = append(c.repLevels, 0)
+		} else {
+			c.repLevels = append(c.repLevels, 1)
+		}
+		err = c.appendValue(elementKind, element)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *parquetColumn) appendValue(kind qvalue.QValueKind, value interface{}) error {
+	switch kind {
+	case qvalue.QValueKindBoolean:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("invalid boolean value %v", value)
+		}
+		c.bools = append(c.bools, v)
+	case qvalue.QValueKindInt16, qvalue.QValueKindInt32:
+		v, err := toInt64(value)
+		if err != nil {
+			return err
+		}
+		c.int32s = append(c.int32s, int32(v))
+	case qvalue.QValueKindInt64:
+		v, err := toInt64(value)
+		if err != nil {
+			return err
+		}
+		c.int64s = append(c.int64s, v)
+	case qvalue.QValueKindFloat32:
+		switch v := value.(type) {
+		case float32:
+			c.float32s = append(c.float32s, v)
+		case float64:
+			c.float32s = append(c.float32s, float32(v))
+		default:
+			return fmt.Errorf("invalid float32 value %v", value)
+		}
+	case qvalue.QValueKindFloat64:
+		switch v := value.(type) {
+		case float64:
+			c.float64s = append(c.float64s, v)
+		case float32:
+			c.float64s = append(c.float64s, float64(v))
+		default:
+			return fmt.Errorf("invalid float64 value %v", value)
+		}
+	case qvalue.QValueKindString, qvalue.QValueKindHStore, qvalue.QValueKindInvalid, qvalue.QValueKindJSON:
+		c.byteArrays = append(c.byteArrays, parquet.ByteArray(fmt.Sprint(value)))
+	case qvalue.QValueKindBytes, qvalue.QValueKindBit:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("invalid bytes value %v", value)
+		}
+		c.byteArrays = append(c.byteArrays, v)
+	case qvalue.QValueKindNumeric:
+		v, ok := value.(*big.Rat)
+		if !ok {
+			return fmt.Errorf("invalid numeric value: expected *big.Rat, got %T", value)
+		}
+		decimal, err := ratToDecimal(v)
+		if err != nil {
+			return err
+		}
+		c.fixedLenArrays = append(c.fixedLenArrays, decimal)
+	case qvalue.QValueKindUUID:
+		u, err := toUUID(value)
+		if err != nil {
+			return err
+		}
+		c.fixedLenArrays = append(c.fixedLenArrays, u[:])
+	case qvalue.QValueKindTimestamp, qvalue.QValueKindTimestampTZ:
+		t, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("invalid timestamp value %v", value)
+		}
+		c.int64s = append(c.int64s, t.UnixMicro())
+	case qvalue.QValueKindDate:
+		t, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("invalid date value %v", value)
+		}
+		seconds := t.Unix()
+		days := seconds / 86400
+		if seconds%86400 < 0 {
+			days--
+		}
+		c.int32s = append(c.int32s, int32(days))
+	case qvalue.QValueKindTime, qvalue.QValueKindTimeTZ:
+		t, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("invalid time value %v", value)
+		}
+		if kind == qvalue.QValueKindTimeTZ {
+			t = t.UTC()
+		}
+		micros := int64(t.Hour())*int64(time.Hour/time.Microsecond) +
+			int64(t.Minute())*int64(time.Minute/time.Microsecond) +
+			int64(t.Second())*int64(time.Second/time.Microsecond) + int64(t.Nanosecond()/1000)
+		c.int64s = append(c.int64s, micros)
+	default:
+		return fmt.Errorf("unsupported QValueKind for parquet: %s", kind)
+	}
+	return nil
+}
+
+// flush writes the buffered values to the column writer of the current row group.
+func (c *parquetColumn) flush(columnWriter file.ColumnChunkWriter) error {
+	if len(c.defLevels) == 0 {
+		return nil
+	}
+
+	// repetition levels are only set for list columns
+	var repLevels []int16
+	if qvalue.QValueKindIsArray(c.kind) {
+		repLevels = c.repLevels
+	}
+
+	var err error
+	switch w := columnWriter.(type) {
+	case *file.BooleanColumnChunkWriter:
+		_, err = w.WriteBatch(c.bools, c.defLevels, repLevels)
+	case *file.Int32ColumnChunkWriter:
+		_, err = w.WriteBatch(c.int32s, c.defLevels, repLevels)
+	case *file.Int64ColumnChunkWriter:
+		_, err = w.WriteBatch(c.int64s, c.defLevels, repLevels)
+	case *file.Float32ColumnChunkWriter:
+		_, err = w.WriteBatch(c.float32s, c.defLevels, repLevels)
+	case *file.Float64ColumnChunkWriter:
+		_, err = w.WriteBatch(c.float64s, c.defLevels, repLevels)
+	case *file.ByteArrayColumnChunkWriter:
+		_, err = w.WriteBatch(c.byteArrays, c.defLevels, repLevels)
+	case *file.FixedLenByteArrayColumnChunkWriter:
+		_, err = w.WriteBatch(c.fixedLenArrays, c.defLevels, repLevels)
+	default:
+		err = fmt.Errorf("unexpected column writer %T", columnWriter)
+	}
+	if err != nil {
+		return err
+	}
+
+	c.defLevels = c.defLevels[:0]
+	c.repLevels = c.repLevels[:0]
+	c.bools = c.bools[:0]
+	c.int32s = c.int32s[:0]
+	c.int64s = c.int64s[:0]
+	c.float32s = c.float32s[:0]
+	c.float64s = c.float64s[:0]
+	c.byteArrays = c.byteArrays[:0]
+	c.fixedLenArrays = c.fixedLenArrays[:0]
+	return nil
+}
+
+func toInt64(value interface{}) (int64, error) {
+	switch v := value.(type) {
+	case int16:
+		return int64(v), nil
+	case int32:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	default:
+		return 0, fmt.Errorf("invalid integer value %v", value)
+	}
+}
+
+func toUUID(value interface{}) (uuid.UUID, error) {
+	switch v := value.(type) {
+	case [16]byte:
+		return v, nil
+	case uuid.UUID:
+		return v, nil
+	case string:
+		return uuid.Parse(v)
+	default:
+		return uuid.UUID{}, fmt.Errorf("invalid UUID value %v", value)
+	}
+}
+
+func arrayElements(value interface{}) ([]interface{}, error) {
+	var elements []interface{}
+	switch v := value.(type) {
+	case []float32:
+		for _, element := range v {
+			elements = append(elements, element)
+		}
+	case []float64:
+		for _, element := range v {
+			elements = append(elements, element)
+		}
+	case []int32:
+		for _, element := range v {
+			elements = append(elements, element)
+		}
+	case []int64:
+		for _, element := range v {
+			elements = append(elements, element)
+		}
+	case []string:
+		for _, element := range v {
+			elements = append(elements, element)
+		}
+	default:
+		return nil, fmt.Errorf("invalid array value %v", value)
+	}
+	return elements, nil
+}
+
+// ratToDecimal returns the unscaled value of a numeric, rounded half away from zero,
+// as the big-endian two's complement bytes parquet uses for decimals.
+func ratToDecimal(r *big.Rat) (parquet.FixedLenByteArray, error) {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(numericScale), nil)
+	scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(scale))
+	unscaled, remainder := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(remainder), big.NewInt(2)).Cmp(scaled.Denom()) >= 0 {
+		unscaled.Add(unscaled, big.NewInt(int64(scaled.Sign())))
+	}
+	if unscaled.BitLen() >= fixedLenByteArrayLength*8 {
+		return nil, fmt.Errorf("numeric value %s does not fit DECIMAL(%d, %d)",
+			r.FloatString(numericScale), numericPrecision, numericScale)
+	}
+
+	if unscaled.Sign() < 0 {
+		unscaled.Add(unscaled, new(big.Int).Lsh(big.NewInt(1), fixedLenByteArrayLength*8))
+	}
+	decimal := make([]byte, fixedLenByteArrayLength)
+	unscaled.FillBytes(decimal)
+	return decimal, nil
+}
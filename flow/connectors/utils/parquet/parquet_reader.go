@@ -0,0 +1,293 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/apache/arrow/go/v12/arrow"
+	"github.com/apache/arrow/go/v12/arrow/array"
+	"github.com/apache/arrow/go/v12/arrow/memory"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"github.com/apache/arrow/go/v12/parquet/pqarrow"
+	"github.com/apache/arrow/go/v12/parquet/schema"
+	"github.com/google/uuid"
+)
+
+// rows are decoded from the file in batches of readBatchSize.
+const readBatchSize = 1024
+
+// PeerDBParquetReader reads the rows of a Parquet file as QRecords.
+// Columns of primitive and logical types are read as the matching kind, lists of
+// integers, floats and strings as arrays, other nested columns are read as JSON.
+type PeerDBParquetReader struct {
+	fileReader   *file.Reader
+	recordReader pqarrow.RecordReader
+	schema       *model.QRecordSchema
+
+	// batch being read and the next row of it.
+	batch arrow.Record
+	row   int
+}
+
+func NewPeerDBParquetReader(ctx context.Context, r parquet.ReaderAtSeeker) (*PeerDBParquetReader, error) {
+	fileReader, err := file.NewParquetReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open parquet file: %w", err)
+	}
+
+	arrowReader, err := pqarrow.NewFileReader(fileReader, pqarrow.ArrowReadProperties{
+		BatchSize: readBatchSize,
+	}, memory.DefaultAllocator)
+	if err != nil {
+		fileReader.Close()
+		return nil, fmt.Errorf("failed to create arrow reader: %w", err)
+	}
+
+	arrowSchema, err := arrowReader.Schema()
+	if err != nil {
+		fileReader.Close()
+		return nil, fmt.Errorf("failed to get schema of parquet file: %w", err)
+	}
+
+	root := fileReader.MetaData().Schema.Root()
+	qFields := make([]*model.QField, 0, len(arrowSchema.Fields()))
+	for i, field := range arrowSchema.Fields() {
+		qFields = append(qFields, &model.QField{
+			Name:     field.Name,
+			Type:     arrowTypeToQValueKind(field.Type, root.Field(i).LogicalType()),
+			Nullable: field.Nullable,
+		})
+	}
+
+	recordReader, err := arrowReader.GetRecordReader(ctx, nil, nil)
+	if err != nil {
+		fileReader.Close()
+		return nil, fmt.Errorf("failed to create record reader: %w", err)
+	}
+
+	return &PeerDBParquetReader{
+		fileReader:   fileReader,
+		recordReader: recordReader,
+		schema:       model.NewQRecordSchema(qFields),
+	}, nil
+}
+
+// Schema returns the schema of the records, derived from the schema of the file.
+func (p *PeerDBParquetReader) Schema() *model.QRecordSchema {
+	return p.schema
+}
+
+// Next returns the next row of the file, io.EOF once all rows are read.
+func (p *PeerDBParquetReader) Next() (*model.QRecord, error) {
+	for p.batch == nil || p.row >= int(p.batch.NumRows()) {
+		if !p.recordReader.Next() {
+			if err := p.recordReader.Err(); err != nil && err != io.EOF {
+				return nil, fmt.Errorf("failed to read parquet file: %w", err)
+			}
+			return nil, io.EOF
+		}
+		p.batch = p.recordReader.Record()
+		p.row = 0
+	}
+
+	entries := make([]qvalue.QValue, 0, len(p.schema.Fields))
+	for i, field := range p.schema.Fields {
+		value, err := arrowValueToQValue(field.Type, p.batch.Column(i), p.row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read column %s: %w", field.Name, err)
+		}
+		entries = append(entries, value)
+	}
+	p.row++
+
+	return &model.QRecord{
+		NumEntries: len(entries),
+		Entries:    entries,
+	}, nil
+}
+
+func (p *PeerDBParquetReader) Close() error {
+	p.recordReader.Release()
+	return p.fileReader.Close()
+}
+
+// arrowTypeToQValueKind returns the kind of a column, given its arrow type and its logical type in the file.
+func arrowTypeToQValueKind(dataType arrow.DataType, logicalType schema.LogicalType) qvalue.QValueKind {
+	switch dataType.ID() {
+	case arrow.BOOL:
+		return qvalue.QValueKindBoolean
+	case arrow.INT8, arrow.INT16, arrow.INT32, arrow.UINT8, arrow.UINT16:
+		return qvalue.QValueKindInt32
+	case arrow.INT64, arrow.UINT32, arrow.UINT64:
+		return qvalue.QValueKindInt64
+	case arrow.FLOAT32:
+		return qvalue.QValueKindFloat32
+	case arrow.FLOAT64:
+		return qvalue.QValueKindFloat64
+	case arrow.STRING, arrow.LARGE_STRING:
+		if logicalType != nil && logicalType.Equals(schema.JSONLogicalType{}) {
+			return qvalue.QValueKindJSON
+		}
+		return qvalue.QValueKindString
+	case arrow.BINARY, arrow.LARGE_BINARY:
+		return qvalue.QValueKindBytes
+	case arrow.FIXED_SIZE_BINARY:
+		if logicalType != nil && logicalType.Equals(schema.UUIDLogicalType{}) {
+			return qvalue.QValueKindUUID
+		}
+		return qvalue.QValueKindBytes
+	case arrow.TIMESTAMP:
+		if dataType.(*arrow.TimestampType).TimeZone != "" {
+			return qvalue.QValueKindTimestampTZ
+		}
+		return qvalue.QValueKindTimestamp
+	case arrow.DATE32, arrow.DATE64:
+		return qvalue.QValueKindDate
+	case arrow.TIME32, arrow.TIME64:
+		return qvalue.QValueKindTime
+	case arrow.DECIMAL128:
+		return qvalue.QValueKindNumeric
+	case arrow.LIST:
+		switch dataType.(*arrow.ListType).Elem().ID() {
+		case arrow.INT32:
+			return qvalue.QValueKindArrayInt32
+		case arrow.INT64:
+			return qvalue.QValueKindArrayInt64
+		case arrow.FLOAT32:
+			return qvalue.QValueKindArrayFloat32
+		case arrow.FLOAT64:
+			return qvalue.QValueKindArrayFloat64
+		case arrow.STRING:
+			return qvalue.QValueKindArrayString
+		}
+		return qvalue.QValueKindJSON
+	default:
+		return qvalue.QValueKindJSON
+	}
+}
+
+func arrowValueToQValue(kind qvalue.QValueKind, column arrow.Array, row int) (qvalue.QValue, error) {
+	if column.IsNull(row) {
+		return qvalue.QValue{Kind: kind, Value: nil}, nil
+	}
+
+	var value interface{}
+	switch arr := column.(type) {
+	case *array.Boolean:
+		value = arr.Value(row)
+	case *array.Int8:
+		value = int32(arr.Value(row))
+	case *array.Int16:
+		value = int32(arr.Value(row))
+	case *array.Int32:
+		value = arr.Value(row)
+	case *array.Uint8:
+		value = int32(arr.Value(row))
+	case *array.Uint16:
+		value = int32(arr.Value(row))
+	case *array.Int64:
+		value = arr.Value(row)
+	case *array.Uint32:
+		value = int64(arr.Value(row))
+	case *array.Uint64:
+		value = int64(arr.Value(row))
+	case *array.Float32:
+		value = arr.Value(row)
+	case *array.Float64:
+		value = arr.Value(row)
+	case *array.String:
+		value = arr.Value(row)
+	case *array.LargeString:
+		value = arr.Value(row)
+	case *array.Binary:
+		value = append([]byte(nil), arr.Value(row)...)
+	case *array.LargeBinary:
+		value = append([]byte(nil), arr.Value(row)...)
+	case *array.FixedSizeBinary:
+		if kind == qvalue.QValueKindUUID {
+			id, err := uuid.FromBytes(arr.Value(row))
+			if err != nil {
+				return qvalue.QValue{}, fmt.Errorf("failed to read uuid: %w", err)
+			}
+			value = id.String()
+		} else {
+			value = append([]byte(nil), arr.Value(row)...)
+		}
+	case *array.Timestamp:
+		value = arr.Value(row).ToTime(arr.DataType().(*arrow.TimestampType).Unit)
+	case *array.Date32:
+		value = arr.Value(row).ToTime()
+	case *array.Date64:
+		value = arr.Value(row).ToTime()
+	case *array.Time32:
+		value = arr.Value(row).ToTime(arr.DataType().(*arrow.Time32Type).Unit)
+	case *array.Time64:
+		value = arr.Value(row).ToTime(arr.DataType().(*arrow.Time64Type).Unit)
+	case *array.Decimal128:
+		scale := arr.DataType().(*arrow.Decimal128Type).Scale
+		denom := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+		value = new(big.Rat).SetFrac(arr.Value(row).BigInt(), denom)
+	case *array.List:
+		if kind != qvalue.QValueKindJSON {
+			return listToQValue(kind, arr, row)
+		}
+	}
+
+	if kind == qvalue.QValueKindJSON {
+		if s, ok := value.(string); ok {
+			return qvalue.QValue{Kind: kind, Value: s}, nil
+		}
+		jsonValue, err := json.Marshal(column.GetOneForMarshal(row))
+		if err != nil {
+			return qvalue.QValue{}, fmt.Errorf("failed to convert value to JSON: %w", err)
+		}
+		return qvalue.QValue{Kind: kind, Value: string(jsonValue)}, nil
+	}
+	if value == nil {
+		return qvalue.QValue{}, fmt.Errorf("unsupported arrow type %s", column.DataType())
+	}
+	return qvalue.QValue{Kind: kind, Value: value}, nil
+}
+
+// listToQValue reads a list of integers, floats or strings as an array, null elements are not kept.
+func listToQValue(kind qvalue.QValueKind, arr *array.List, row int) (qvalue.QValue, error) {
+	start, end := arr.ValueOffsets(row)
+	values := arr.ListValues()
+
+	var value interface{}
+	switch elements := values.(type) {
+	case *array.Int32:
+		value = listElements(elements, start, end, elements.Value)
+	case *array.Int64:
+		value = listElements(elements, start, end, elements.Value)
+	case *array.Float32:
+		value = listElements(elements, start, end, elements.Value)
+	case *array.Float64:
+		value = listElements(elements, start, end, elements.Value)
+	case *array.String:
+		value = listElements(elements, start, end, elements.Value)
+	default:
+		return qvalue.QValue{}, fmt.Errorf("unsupported list element type %s", values.DataType())
+	}
+	return qvalue.QValue{Kind: kind, Value: value}, nil
+}
+
+func listElements[T int32 | int64 | float32 | float64 | string](
+	elements arrow.Array,
+	start, end int64,
+	elementAt func(int) T,
+) []T {
+	result := make([]T, 0, end-start)
+	for i := int(start); i < int(end); i++ {
+		if !elements.IsNull(i) {
+			result = append(result, elementAt(i))
+		}
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadParquet(t *testing.T) {
+	const numRows = 2500
+	stream := generateRecords(numRows)
+
+	var buf bytes.Buffer
+	writer := NewPeerDBParquetWriter(nil, stream, compress.Codecs.Zstd, 1000)
+	_, err := writer.WriteParquet(&buf)
+	require.NoError(t, err)
+
+	reader, err := NewPeerDBParquetReader(context.Background(), bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	kinds := make([]qvalue.QValueKind, 0)
+	for _, field := range reader.Schema().Fields {
+		kinds = append(kinds, field.Type)
+	}
+	require.Equal(t, []qvalue.QValueKind{
+		qvalue.QValueKindInt64,
+		qvalue.QValueKindNumeric,
+		qvalue.QValueKindString,
+		qvalue.QValueKindUUID,
+		qvalue.QValueKindTimestampTZ,
+		qvalue.QValueKindArrayString,
+	}, kinds)
+
+	numRead := 0
+	for {
+		record, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		require.Equal(t, int64(numRead), record.Entries[0].Value)
+		if numRead%2 == 1 {
+			require.Nil(t, record.Entries[1].Value)
+			require.Nil(t, record.Entries[5].Value)
+		} else {
+			// numerics are written with a scale of 9.
+			amount := record.Entries[1].Value.(*big.Rat)
+			require.Equal(t, big.NewRat(int64(numRead), 3).FloatString(9), amount.FloatString(9))
+			require.Equal(t, "name", record.Entries[2].Value)
+			require.Len(t, record.Entries[3].Value, 36)
+			require.IsType(t, time.Time{}, record.Entries[4].Value)
+			require.Equal(t, []string{"a", "b"}, record.Entries[5].Value)
+		}
+		numRead++
+	}
+	require.Equal(t, numRows, numRead)
+}
@@ -0,0 +1,141 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/apache/arrow/go/v12/parquet/schema"
+)
+
+const (
+	// numeric values are written as DECIMAL(38, 9), the same precision and scale as in Avro.
+	numericPrecision = 38
+	numericScale     = 9
+	// 16 bytes hold the unscaled value of any DECIMAL(38, _) and a UUID.
+	fixedLenByteArrayLength = 16
+)
+
+// GetParquetCompression returns the parquet codec for a compression option, snappy when not set.
+func GetParquetCompression(compression string) (compress.Compression, error) {
+	switch strings.ToLower(compression) {
+	case "", "snappy":
+		return compress.Codecs.Snappy, nil
+	case "zstd":
+		return compress.Codecs.Zstd, nil
+	case "gzip":
+		return compress.Codecs.Gzip, nil
+	case "none":
+		return compress.Codecs.Uncompressed, nil
+	default:
+		return compress.Codecs.Uncompressed, fmt.Errorf("unsupported parquet compression: %s", compression)
+	}
+}
+
+// GetParquetSchema returns the parquet schema for a QRecordSchema. Every column is optional,
+// arrays are written as LIST columns of required elements.
+func GetParquetSchema(qSchema *model.QRecordSchema) (*schema.GroupNode, error) {
+	fields := make(schema.FieldList, 0, len(qSchema.Fields))
+	for _, field := range qSchema.Fields {
+		node, err := qValueKindToParquetNode(field.Name, field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map column %s to parquet: %w", field.Name, err)
+		}
+		fields = append(fields, node)
+	}
+
+	return schema.NewGroupNode("schema", parquet.Repetitions.Required, fields, -1)
+}
+
+func qValueKindToParquetNode(name string, kind qvalue.QValueKind) (schema.Node, error) {
+	if !qvalue.QValueKindIsArray(kind) {
+		return qValueKindToPrimitiveNode(name, parquet.Repetitions.Optional, kind)
+	}
+
+	element, err := qValueKindToPrimitiveNode("element", parquet.Repetitions.Required, arrayElementKind(kind))
+	if err != nil {
+		return nil, err
+	}
+	list, err := schema.NewGroupNode("list", parquet.Repetitions.Repeated, schema.FieldList{element}, -1)
+	if err != nil {
+		return nil, err
+	}
+	return schema.NewGroupNodeLogical(name, parquet.Repetitions.Optional, schema.FieldList{list},
+		schema.NewListLogicalType(), -1)
+}
+
+func qValueKindToPrimitiveNode(
+	name string,
+	repetition parquet.Repetition,
+	kind qvalue.QValueKind,
+) (*schema.PrimitiveNode, error) {
+	switch kind {
+	case qvalue.QValueKindBoolean:
+		return schema.NewBooleanNode(name, repetition, -1), nil
+	case qvalue.QValueKindInt16:
+		return schema.NewPrimitiveNodeLogical(name, repetition, schema.NewIntLogicalType(16, true),
+			parquet.Types.Int32, -1, -1)
+	case qvalue.QValueKindInt32:
+		return schema.NewPrimitiveNodeLogical(name, repetition, schema.NewIntLogicalType(32, true),
+			parquet.Types.Int32, -1, -1)
+	case qvalue.QValueKindInt64:
+		return schema.NewInt64Node(name, repetition, -1), nil
+	case qvalue.QValueKindFloat32:
+		return schema.NewFloat32Node(name, repetition, -1), nil
+	case qvalue.QValueKindFloat64:
+		return schema.NewFloat64Node(name, repetition, -1), nil
+	case qvalue.QValueKindString, qvalue.QValueKindHStore, qvalue.QValueKindInvalid:
+		return schema.NewPrimitiveNodeLogical(name, repetition, schema.StringLogicalType{},
+			parquet.Types.ByteArray, -1, -1)
+	case qvalue.QValueKindJSON:
+		return schema.NewPrimitiveNodeLogical(name, repetition, schema.JSONLogicalType{},
+			parquet.Types.ByteArray, -1, -1)
+	case qvalue.QValueKindBytes, qvalue.QValueKindBit:
+		return schema.NewByteArrayNode(name, repetition, -1), nil
+	case qvalue.QValueKindNumeric:
+		return schema.NewPrimitiveNodeLogical(name, repetition,
+			schema.NewDecimalLogicalType(numericPrecision, numericScale),
+			parquet.Types.FixedLenByteArray, fixedLenByteArrayLength, -1)
+	case qvalue.QValueKindUUID:
+		return schema.NewPrimitiveNodeLogical(name, repetition, schema.UUIDLogicalType{},
+			parquet.Types.FixedLenByteArray, fixedLenByteArrayLength, -1)
+	case qvalue.QValueKindTimestamp:
+		return schema.NewPrimitiveNodeLogical(name, repetition,
+			schema.NewTimestampLogicalType(false, schema.TimeUnitMicros), parquet.Types.Int64, -1, -1)
+	case qvalue.QValueKindTimestampTZ:
+		return schema.NewPrimitiveNodeLogical(name, repetition,
+			schema.NewTimestampLogicalType(true, schema.TimeUnitMicros), parquet.Types.Int64, -1, -1)
+	case qvalue.QValueKindDate:
+		return schema.NewPrimitiveNodeLogical(name, repetition, schema.DateLogicalType{},
+			parquet.Types.Int32, -1, -1)
+	case qvalue.QValueKindTime:
+		return schema.NewPrimitiveNodeLogical(name, repetition,
+			schema.NewTimeLogicalType(false, schema.TimeUnitMicros), parquet.Types.Int64, -1, -1)
+	case qvalue.QValueKindTimeTZ:
+		return schema.NewPrimitiveNodeLogical(name, repetition,
+			schema.NewTimeLogicalType(true, schema.TimeUnitMicros), parquet.Types.Int64, -1, -1)
+	default:
+		return nil, fmt.Errorf("unsupported QValueKind for parquet: %s", kind)
+	}
+}
+
+// arrayElementKind returns the kind of the elements of an array kind.
+func arrayElementKind(kind qvalue.QValueKind) qvalue.QValueKind {
+	switch kind {
+	case qvalue.QValueKindArrayFloat32:
+		return qvalue.QValueKindFloat32
+	case qvalue.QValueKindArrayFloat64:
+		return qvalue.QValueKindFloat64
+	case qvalue.QValueKindArrayInt32:
+		return qvalue.QValueKindInt32
+	case qvalue.QValueKindArrayInt64:
+		return qvalue.QValueKindInt64
+	case qvalue.QValueKindArrayString:
+		return qvalue.QValueKindString
+	default:
+		return qvalue.QValueKindInvalid
+	}
+}
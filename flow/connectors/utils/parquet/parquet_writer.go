@@ -0,0 +1,209 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"go.temporal.io/sdk/activity"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultRowGroupSize is the number of rows per row group when not configured.
+	DefaultRowGroupSize = 100000
+	// buffered values are handed to the column writers every writeBatchSize rows.
+	writeBatchSize = 1024
+)
+
+type PeerDBParquetWriter struct {
+	ctx          context.Context
+	stream       *model.QRecordStream
+	compression  compress.Compression
+	rowGroupSize int
+}
+
+func NewPeerDBParquetWriter(
+	ctx context.Context,
+	stream *model.QRecordStream,
+	compression compress.Compression,
+	rowGroupSize int,
+) *PeerDBParquetWriter {
+	if rowGroupSize <= 0 {
+		rowGroupSize = DefaultRowGroupSize
+	}
+	return &PeerDBParquetWriter{
+		ctx:          ctx,
+		stream:       stream,
+		compression:  compression,
+		rowGroupSize: rowGroupSize,
+	}
+}
+
+func (p *PeerDBParquetWriter) WriteParquet(w io.Writer) (int, error) {
+	qSchema, err := p.stream.Schema()
+	if err != nil {
+		log.Errorf("failed to get schema from stream: %v", err)
+		return 0, fmt.Errorf("failed to get schema from stream: %w", err)
+	}
+
+	parquetSchema, err := GetParquetSchema(qSchema)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get parquet schema: %w", err)
+	}
+
+	writer := file.NewParquetWriter(w, parquetSchema, file.WithWriterProps(parquet.NewWriterProperties(
+		parquet.WithCompression(p.compression),
+		parquet.WithCreatedBy("peerdb"),
+	)))
+
+	columns := make([]*parquetColumn, 0, len(qSchema.Fields))
+	for _, field := range qSchema.Fields {
+		columns = append(columns, newParquetColumn(field.Type))
+	}
+
+	var rowGroup file.BufferedRowGroupWriter
+	flushColumns := func() error {
+		for i, column := range columns {
+			columnWriter, err := rowGroup.Column(i)
+			if err != nil {
+				return fmt.Errorf("failed to get writer for column %d: %w", i, err)
+			}
+			err = column.flush(columnWriter)
+			if err != nil {
+				return fmt.Errorf("failed to write column %s: %w", qSchema.Fields[i].Name, err)
+			}
+		}
+		return nil
+	}
+
+	numRows := 0
+	rowsInGroup := 0
+	const heartBeatNumRows = 10000
+	for qRecordOrErr := range p.stream.Records {
+		if qRecordOrErr.Err != nil {
+			log.Errorf("[parquet] failed to get record from stream: %v", qRecordOrErr.Err)
+			return 0, fmt.Errorf("[parquet] failed to get record from stream: %w", qRecordOrErr.Err)
+		}
+
+		qRecord := qRecordOrErr.Record
+		if len(qRecord.Entries) != len(columns) {
+			return 0, fmt.Errorf("record has %d entries, schema has %d columns", len(qRecord.Entries), len(columns))
+		}
+		for i, entry := range qRecord.Entries {
+			err = columns[i].append(entry.Value)
+			if err != nil {
+				return 0, fmt.Errorf("failed to convert column %s to parquet: %w", qSchema.Fields[i].Name, err)
+			}
+		}
+
+		if rowGroup == nil {
+			rowGroup = writer.AppendBufferedRowGroup()
+		}
+		numRows++
+		rowsInGroup++
+
+		if rowsInGroup%writeBatchSize == 0 || rowsInGroup == p.rowGroupSize {
+			err = flushColumns()
+			if err != nil {
+				return 0, err
+			}
+		}
+		if rowsInGroup == p.rowGroupSize {
+			err = rowGroup.Close()
+			if err != nil {
+				return 0, fmt.Errorf("failed to close row group: %w", err)
+			}
+			rowGroup = nil
+			rowsInGroup = 0
+		}
+
+		if numRows%heartBeatNumRows == 0 {
+			log.Infof("written %d rows to parquet", numRows)
+			msg := fmt.Sprintf("written %d rows to parquet", numRows)
+			if p.ctx != nil {
+				activity.RecordHeartbeat(p.ctx, msg)
+			}
+		}
+	}
+
+	if rowGroup != nil {
+		err = flushColumns()
+		if err != nil {
+			return 0, err
+		}
+		err = rowGroup.Close()
+		if err != nil {
+			return 0, fmt.Errorf("failed to close row group: %w", err)
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return 0, fmt.Errorf("failed to close parquet writer: %w", err)
+	}
+
+	if p.ctx != nil {
+		msg := fmt.Sprintf("written all: %d rows to parquet", numRows)
+		activity.RecordHeartbeat(p.ctx, msg)
+	}
+
+	return numRows, nil
+}
+
+func (p *PeerDBParquetWriter) WriteRecordsToS3(
+	bucketName, key string,
+	connConfig *protos.S3ConnectionConfig,
+) (int, error) {
+	r, w := io.Pipe()
+	numRowsWritten := make(chan int, 1)
+	go func() {
+		numRows, err := p.WriteParquet(w)
+		if err != nil {
+			w.CloseWithError(err)
+			return
+		}
+		numRowsWritten <- numRows
+		w.Close()
+	}()
+
+	s3svc, err := utils.CreateS3Client(connConfig)
+	if err != nil {
+		log.Errorf("failed to create S3 client: %v", err)
+		return 0, fmt.Errorf("failed to create S3 client: %w", err)
+	}
+
+	uploader := s3manager.NewUploaderWithClient(s3svc)
+	result, err := uploader.Upload(&s3manager.UploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(key),
+		Body:   r,
+	})
+	if err != nil {
+		log.Errorf("failed to upload file: %v", err)
+		return 0, fmt.Errorf("failed to upload file: %w", err)
+	}
+
+	log.Infof("file uploaded to, %s", result.Location)
+
+	return <-numRowsWritten, nil
+}
+
+func (p *PeerDBParquetWriter) WriteRecordsToParquetFile(filePath string) (int, error) {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create file: %w", err)
+	}
+	defer file.Close()
+	return p.WriteParquet(file)
+}
@@ -0,0 +1,87 @@
+package utils
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/model"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"github.com/apache/arrow/go/v12/parquet/schema"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func generateRecords(numRows int) *model.QRecordStream {
+	qSchema := model.NewQRecordSchema([]*model.QField{
+		{Name: "id", Type: qvalue.QValueKindInt64, Nullable: false},
+		{Name: "amount", Type: qvalue.QValueKindNumeric, Nullable: true},
+		{Name: "name", Type: qvalue.QValueKindString, Nullable: true},
+		{Name: "uid", Type: qvalue.QValueKindUUID, Nullable: true},
+		{Name: "created_at", Type: qvalue.QValueKindTimestampTZ, Nullable: true},
+		{Name: "tags", Type: qvalue.QValueKindArrayString, Nullable: true},
+	})
+
+	stream := model.NewQRecordStream(numRows)
+	_ = stream.SetSchema(qSchema)
+	for i := 0; i < numRows; i++ {
+		entries := []qvalue.QValue{
+			{Kind: qvalue.QValueKindInt64, Value: int64(i)},
+			{Kind: qvalue.QValueKindNumeric, Value: big.NewRat(int64(i), 3)},
+			{Kind: qvalue.QValueKindString, Value: "name"},
+			{Kind: qvalue.QValueKindUUID, Value: uuid.New()},
+			{Kind: qvalue.QValueKindTimestampTZ, Value: time.Now()},
+			{Kind: qvalue.QValueKindArrayString, Value: []string{"a", "b"}},
+		}
+		// every other row has NULLs for the nullable columns
+		if i%2 == 1 {
+			for j := 1; j < len(entries); j++ {
+				entries[j].Value = nil
+			}
+		}
+		stream.Records <- &model.QRecordOrError{
+			Record: &model.QRecord{NumEntries: len(entries), Entries: entries},
+		}
+	}
+	close(stream.Records)
+	return stream
+}
+
+func TestWriteParquet(t *testing.T) {
+	const numRows = 2500
+	stream := generateRecords(numRows)
+
+	var buf bytes.Buffer
+	writer := NewPeerDBParquetWriter(nil, stream, compress.Codecs.Snappy, 1000)
+	numWritten, err := writer.WriteParquet(&buf)
+	require.NoError(t, err)
+	require.Equal(t, numRows, numWritten)
+
+	reader, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	defer reader.Close()
+
+	require.Equal(t, int64(numRows), reader.NumRows())
+	require.Equal(t, 3, reader.NumRowGroups())
+
+	parquetSchema := reader.MetaData().Schema
+	require.Equal(t, 6, parquetSchema.Root().NumFields())
+	require.IsType(t, &schema.DecimalLogicalType{}, parquetSchema.Column(1).LogicalType())
+	require.IsType(t, schema.UUIDLogicalType{}, parquetSchema.Column(3).LogicalType())
+	require.IsType(t, &schema.TimestampLogicalType{}, parquetSchema.Column(4).LogicalType())
+}
+
+func TestRatToDecimal(t *testing.T) {
+	decimal, err := ratToDecimal(big.NewRat(-1, 1))
+	require.NoError(t, err)
+	unscaled := new(big.Int).SetBytes(decimal)
+	unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), fixedLenByteArrayLength*8))
+	require.Equal(t, "-1000000000", unscaled.String())
+
+	decimal, err = ratToDecimal(big.NewRat(1, 3))
+	require.NoError(t, err)
+	require.Equal(t, "333333333", new(big.Int).SetBytes(decimal).String())
+}
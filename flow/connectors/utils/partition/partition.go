@@ -2,6 +2,7 @@ package utils
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/PeerDB-io/peer-flow/generated/protos"
@@ -38,6 +39,12 @@ func compareValues(prevEnd interface{}, start interface{}) int {
 		} else {
 			return 0
 		}
+	case string:
+		// strings are ordered by the collation of the column, only equality can be checked here.
+		if prevEnd.(string) == v {
+			return 0
+		}
+		return -1
 	case pgtype.TID:
 		pe := prevEnd.(pgtype.TID)
 		if pe.BlockNumber < v.BlockNumber {
@@ -101,6 +108,20 @@ func createTimePartition(start time.Time, end time.Time) *protos.QRepPartition {
 	}
 }
 
+func createStringPartition(start string, end string) *protos.QRepPartition {
+	return &protos.QRepPartition{
+		PartitionId: uuid.New().String(),
+		Range: &protos.PartitionRange{
+			Range: &protos.PartitionRange_StringRange{
+				StringRange: &protos.StringPartitionRange{
+					Start: start,
+					End:   end,
+				},
+			},
+		},
+	}
+}
+
 func createTIDPartition(start pgtype.TID, end pgtype.TID) *protos.QRepPartition {
 	startTuple := &protos.TID{
 		BlockNumber:  start.BlockNumber,
@@ -140,6 +161,14 @@ func NewPartitionHelper() *PartitionHelper {
 func (p *PartitionHelper) AddPartition(start interface{}, end interface{}) error {
 	log.Debugf("adding partition - start: %v, end: %v", start, end)
 
+	// uuids are partitioned by their text form, which has the same order as their bytes
+	if v, ok := start.([16]byte); ok {
+		start = uuid.UUID(v).String()
+	}
+	if v, ok := end.([16]byte); ok {
+		end = uuid.UUID(v).String()
+	}
+
 	// Skip partition if it's fully contained within the previous one
 	// If it's not fully contained but overlaps, adjust the start
 	if p.prevEnd != nil {
@@ -152,6 +181,12 @@ func (p *PartitionHelper) AddPartition(start interface{}, end interface{}) error
 				log.Debugf("fully contained within previous partition: start: %v, end: %v", p.prevStart, p.prevEnd)
 				return nil
 			}
+			// the start of a string range can't be adjusted past the previous end, extend the previous partition
+			if v, ok := end.(string); ok {
+				p.partitions[len(p.partitions)-1].Range.GetStringRange().End = v
+				p.prevEnd = v
+				return nil
+			}
 			// If end is greater than prevEnd, adjust the start
 			start = adjustStartValue(p.prevEnd, start)
 		}
@@ -170,6 +205,10 @@ func (p *PartitionHelper) AddPartition(start interface{}, end interface{}) error
 		p.partitions = append(p.partitions, createTimePartition(v, end.(time.Time)))
 		p.prevStart = v
 		p.prevEnd = end
+	case string:
+		p.partitions = append(p.partitions, createStringPartition(v, end.(string)))
+		p.prevStart = v
+		p.prevEnd = end
 	case pgtype.TID:
 		p.partitions = append(p.partitions, createTIDPartition(v, end.(pgtype.TID)))
 		p.prevStart = v
@@ -184,3 +223,40 @@ func (p *PartitionHelper) AddPartition(start interface{}, end interface{}) error
 func (p *PartitionHelper) GetPartitions() []*protos.QRepPartition {
 	return p.partitions
 }
+
+// SplitUUIDRange splits the range of uuids between start and end into numPartitions ranges of equal width,
+// as if splitting by their hex prefix. The ranges are returned as pairs of inclusive bounds.
+func SplitUUIDRange(start uuid.UUID, end uuid.UUID, numPartitions int) [][2]uuid.UUID {
+	startInt := new(big.Int).SetBytes(start[:])
+	endInt := new(big.Int).SetBytes(end[:])
+	if numPartitions < 1 || startInt.Cmp(endInt) > 0 {
+		return nil
+	}
+
+	// there can't be more partitions than uuids in the range
+	width := new(big.Int).Sub(endInt, startInt)
+	width.Add(width, big.NewInt(1))
+	if width.Cmp(big.NewInt(int64(numPartitions))) < 0 {
+		numPartitions = int(width.Int64())
+	}
+
+	ranges := make([][2]uuid.UUID, 0, numPartitions)
+	partitionStart := startInt
+	for i := 1; i <= numPartitions; i++ {
+		// start + width * i / numPartitions is the start of the next partition
+		nextStart := new(big.Int).Mul(width, big.NewInt(int64(i)))
+		nextStart.Quo(nextStart, big.NewInt(int64(numPartitions)))
+		nextStart.Add(nextStart, startInt)
+		partitionEnd := new(big.Int).Sub(nextStart, big.NewInt(1))
+
+		ranges = append(ranges, [2]uuid.UUID{bigIntToUUID(partitionStart), bigIntToUUID(partitionEnd)})
+		partitionStart = nextStart
+	}
+	return ranges
+}
+
+func bigIntToUUID(n *big.Int) uuid.UUID {
+	var id uuid.UUID
+	n.FillBytes(id[:])
+	return id
+}
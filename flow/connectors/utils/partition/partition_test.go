@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSplitUUIDRange(t *testing.T) {
+	start := uuid.MustParse("00000000-0000-0000-0000-000000000000")
+	end := uuid.MustParse("ffffffff-ffff-ffff-ffff-ffffffffffff")
+
+	ranges := SplitUUIDRange(start, end, 4)
+	expected := [][2]string{
+		{"00000000-0000-0000-0000-000000000000", "3fffffff-ffff-ffff-ffff-ffffffffffff"},
+		{"40000000-0000-0000-0000-000000000000", "7fffffff-ffff-ffff-ffff-ffffffffffff"},
+		{"80000000-0000-0000-0000-000000000000", "bfffffff-ffff-ffff-ffff-ffffffffffff"},
+		{"c0000000-0000-0000-0000-000000000000", "ffffffff-ffff-ffff-ffff-ffffffffffff"},
+	}
+	if len(ranges) != len(expected) {
+		t.Fatalf("expected %d ranges, got %d", len(expected), len(ranges))
+	}
+	for i, r := range ranges {
+		if r[0].String() != expected[i][0] || r[1].String() != expected[i][1] {
+			t.Errorf("unexpected range %d: %v - %v", i, r[0], r[1])
+		}
+	}
+
+	// a range narrower than the number of partitions has one partition per uuid
+	narrowEnd := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+	if ranges := SplitUUIDRange(start, narrowEnd, 4); len(ranges) != 2 {
+		t.Errorf("expected 2 ranges, got %d", len(ranges))
+	}
+}
+
+func TestAddPartitionString(t *testing.T) {
+	helper := NewPartitionHelper()
+	bounds := [][2]interface{}{
+		{"apple", "banana"},
+		// starts at the end of the previous partition, extends it
+		{"banana", "cherry"},
+		{"date", "fig"},
+		// uuids are added as their text form
+		{[16]byte{1}, [16]byte{2}},
+	}
+	for _, b := range bounds {
+		if err := helper.AddPartition(b[0], b[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	partitions := helper.GetPartitions()
+	expected := [][2]string{
+		{"apple", "cherry"},
+		{"date", "fig"},
+		{uuid.UUID([16]byte{1}).String(), uuid.UUID([16]byte{2}).String()},
+	}
+	if len(partitions) != len(expected) {
+		t.Fatalf("expected %d partitions, got %d", len(expected), len(partitions))
+	}
+	for i, p := range partitions {
+		r := p.Range.GetStringRange()
+		if r.Start != expected[i][0] || r.End != expected[i][1] {
+			t.Errorf("unexpected partition %d: %s - %s", i, r.Start, r.End)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"text/template"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+// PartitionsQuery returns a query for the first and last values of numPartitions buckets of rows of the table
+// ordered by a single column, see TuplePartitionsQuery for composite watermarks.
+func PartitionsQuery(quotedColumn string, table string, whereClause string, numPartitions int64) string {
+	return fmt.Sprintf(
+		`SELECT bucket_v, MIN(v_from) AS start_v, MAX(v_from) AS end_v
+		FROM (
+			SELECT NTILE(%[1]d) OVER (ORDER BY %[2]s) AS bucket_v, %[2]s AS v_from
+			FROM %[3]s %[4]s
+		) AS subquery
+		GROUP BY bucket_v
+		ORDER BY start_v`,
+		numPartitions,
+		quotedColumn,
+		table,
+		whereClause,
+	)
+}
+
+// PartitionRangeEnd returns the end of an integer or timestamp partition, the next partitions start after it.
+func PartitionRangeEnd(partition *protos.QRepPartition) (interface{}, error) {
+	switch x := partition.Range.Range.(type) {
+	case *protos.PartitionRange_IntRange:
+		return x.IntRange.End, nil
+	case *protos.PartitionRange_TimestampRange:
+		return x.TimestampRange.End.AsTime(), nil
+	default:
+		return nil, fmt.Errorf("unsupported range type: %T", x)
+	}
+}
+
+// TemplatePullQuery templates {{.start}} and {{.end}} of a query with the given placeholders, and returns
+// the bounds of an integer or timestamp partition to bind to them. The bounds are nil for full table
+// partitions, whose query is run as is.
+func TemplatePullQuery(query string,
+	partition *protos.QRepPartition,
+	startPlaceholder string,
+	endPlaceholder string,
+) (string, interface{}, interface{}, error) {
+	tmpl, err := template.New("query").Parse(query)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to parse query: %w", err)
+	}
+
+	buf := new(bytes.Buffer)
+	err = tmpl.Execute(buf, map[string]interface{}{
+		"start": startPlaceholder,
+		"end":   endPlaceholder,
+	})
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("failed to template query: %w", err)
+	}
+	templated := buf.String()
+
+	if partition.FullTablePartition {
+		return templated, nil, nil, nil
+	}
+
+	switch x := partition.Range.Range.(type) {
+	case *protos.PartitionRange_IntRange:
+		return templated, x.IntRange.Start, x.IntRange.End, nil
+	case *protos.PartitionRange_TimestampRange:
+		return templated, x.TimestampRange.Start.AsTime(), x.TimestampRange.End.AsTime(), nil
+	default:
+		return "", nil, nil, fmt.Errorf("unsupported range type: %T", x)
+	}
+}
@@ -0,0 +1,53 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTemplatePullQuery(t *testing.T) {
+	query := "SELECT * FROM t WHERE updated_at BETWEEN {{.start}} AND {{.end}}"
+	start := time.Date(2023, time.June, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	partition := &protos.QRepPartition{
+		Range: &protos.PartitionRange{
+			Range: &protos.PartitionRange_TimestampRange{
+				TimestampRange: &protos.TimestampPartitionRange{
+					Start: timestamppb.New(start),
+					End:   timestamppb.New(end),
+				},
+			},
+		},
+	}
+
+	templated, rangeStart, rangeEnd, err := TemplatePullQuery(query, partition, ":startRange", ":endRange")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if templated != "SELECT * FROM t WHERE updated_at BETWEEN :startRange AND :endRange" {
+		t.Errorf("unexpected query: %s", templated)
+	}
+	if rangeStart != start || rangeEnd != end {
+		t.Errorf("unexpected bounds: %v, %v", rangeStart, rangeEnd)
+	}
+
+	rangeEnd, err = PartitionRangeEnd(partition)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rangeEnd != end {
+		t.Errorf("unexpected end: %v", rangeEnd)
+	}
+
+	_, rangeStart, rangeEnd, err = TemplatePullQuery(query,
+		&protos.QRepPartition{FullTablePartition: true}, ":startRange", ":endRange")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rangeStart != nil || rangeEnd != nil {
+		t.Errorf("expected no bounds for full table partition, got %v, %v", rangeStart, rangeEnd)
+	}
+}
@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// WatermarkColumns splits the watermark column of a config into the columns of a composite watermark.
+func WatermarkColumns(watermarkColumn string) []string {
+	var columns []string
+	for _, column := range strings.Split(watermarkColumn, ",") {
+		column = strings.TrimSpace(column)
+		if column != "" {
+			columns = append(columns, column)
+		}
+	}
+	return columns
+}
+
+// QuoteColumns quotes the columns of a watermark as identifiers of databases using double quotes.
+func QuoteColumns(columns []string) []string {
+	quoted := make([]string, 0, len(columns))
+	for _, column := range columns {
+		quoted = append(quoted, fmt.Sprintf("\"%s\"", column))
+	}
+	return quoted
+}
+
+// TupleComparison returns a predicate comparing the columns to the params as tuples, for databases
+// without row value comparison. op is one of <, <=, > and >=, columns are compared in order and
+// only the last column is compared with the equality of op, e.g. for > and (a, b):
+//
+//	(a > p1 OR (a = p1 AND b > p2))
+func TupleComparison(columns []string, op string, params []string) string {
+	strictOp := strings.TrimSuffix(op, "=")
+	predicate := fmt.Sprintf("%s %s %s", columns[len(columns)-1], op, params[len(params)-1])
+	for i := len(columns) - 2; i >= 0; i-- {
+		predicate = fmt.Sprintf("(%[1]s %[2]s %[3]s OR (%[1]s = %[3]s AND %[4]s))",
+			columns[i], strictOp, params[i], predicate)
+	}
+	if len(columns) == 1 {
+		return "(" + predicate + ")"
+	}
+	return predicate
+}
+
+// TuplePartitionsQuery returns a query for the first and last rows of numPartitions buckets of rows of the table
+// ordered by the columns, along the lines of the NTILE queries for single columns. The columns of the first row of a
+// bucket are followed by the columns of its last row.
+func TuplePartitionsQuery(quotedColumns []string, table string, whereClause string, numPartitions int64) string {
+	columnList := strings.Join(quotedColumns, ", ")
+	startColumns := make([]string, 0, len(quotedColumns))
+	endColumns := make([]string, 0, len(quotedColumns))
+	for _, column := range quotedColumns {
+		startColumns = append(startColumns, "s."+column)
+		endColumns = append(endColumns, "e."+column)
+	}
+
+	return fmt.Sprintf(
+		`WITH numbered AS (
+			SELECT %[2]s, NTILE(%[1]d) OVER (ORDER BY %[2]s) AS _peerdb_bucket,
+				ROW_NUMBER() OVER (ORDER BY %[2]s) AS _peerdb_row
+			FROM %[3]s %[4]s
+		), bounds AS (
+			SELECT _peerdb_bucket, MIN(_peerdb_row) AS start_row, MAX(_peerdb_row) AS end_row
+			FROM numbered GROUP BY _peerdb_bucket
+		)
+		SELECT %[5]s, %[6]s FROM bounds
+		JOIN numbered s ON s._peerdb_row = bounds.start_row
+		JOIN numbered e ON e._peerdb_row = bounds.end_row
+		ORDER BY bounds._peerdb_bucket`,
+		numPartitions,
+		columnList,
+		table,
+		whereClause,
+		strings.Join(startColumns, ", "),
+		strings.Join(endColumns, ", "),
+	)
+}
+
+func createTuplePartition(start []*protos.PartitionValue, end []*protos.PartitionValue) *protos.QRepPartition {
+	return &protos.QRepPartition{
+		PartitionId: uuid.New().String(),
+		Range: &protos.PartitionRange{
+			Range: &protos.PartitionRange_TupleRange{
+				TupleRange: &protos.TuplePartitionRange{
+					Start: start,
+					End:   end,
+				},
+			},
+		},
+	}
+}
+
+// AddTuplePartition adds a partition of a composite watermark. The tuples of the watermark are assumed to be
+// unique, as the ranges of the partitions returned by an NTILE query don't overlap then.
+func (p *PartitionHelper) AddTuplePartition(start []interface{}, end []interface{}) error {
+	startValues, err := ToPartitionValues(start)
+	if err != nil {
+		return err
+	}
+	endValues, err := ToPartitionValues(end)
+	if err != nil {
+		return err
+	}
+
+	p.partitions = append(p.partitions, createTuplePartition(startValues, endValues))
+	p.prevStart = start
+	p.prevEnd = end
+	return nil
+}
+
+// ToPartitionValues converts the values of the columns of a composite watermark to PartitionValues.
+func ToPartitionValues(values []interface{}) ([]*protos.PartitionValue, error) {
+	partitionValues := make([]*protos.PartitionValue, 0, len(values))
+	for _, value := range values {
+		switch v := value.(type) {
+		case int16:
+			value = int64(v)
+		case int32:
+			value = int64(v)
+		case [16]byte:
+			value = uuid.UUID(v).String()
+		}
+
+		partitionValue := &protos.PartitionValue{}
+		switch v := value.(type) {
+		case int64:
+			partitionValue.Type = protos.PartitionValueType_PARTITION_VALUE_TYPE_INT
+			partitionValue.IntValue = v
+		case time.Time:
+			partitionValue.Type = protos.PartitionValueType_PARTITION_VALUE_TYPE_TIMESTAMP
+			partitionValue.TimestampValue = timestamppb.New(v)
+		case string:
+			partitionValue.Type = protos.PartitionValueType_PARTITION_VALUE_TYPE_STRING
+			partitionValue.StringValue = v
+		default:
+			return nil, fmt.Errorf("unsupported type %T for watermark column", value)
+		}
+		partitionValues = append(partitionValues, partitionValue)
+	}
+	return partitionValues, nil
+}
+
+// FromPartitionValues converts PartitionValues back to values that can be passed as query params.
+func FromPartitionValues(partitionValues []*protos.PartitionValue) []interface{} {
+	values := make([]interface{}, 0, len(partitionValues))
+	for _, partitionValue := range partitionValues {
+		switch partitionValue.Type {
+		case protos.PartitionValueType_PARTITION_VALUE_TYPE_INT:
+			values = append(values, partitionValue.IntValue)
+		case protos.PartitionValueType_PARTITION_VALUE_TYPE_TIMESTAMP:
+			values = append(values, partitionValue.TimestampValue.AsTime())
+		case protos.PartitionValueType_PARTITION_VALUE_TYPE_STRING:
+			values = append(values, partitionValue.StringValue)
+		}
+	}
+	return values
+}
+
+// FormatPartitionValues formats the values of a composite watermark as a tuple, e.g. (2023-01-01 00:00:00, 42).
+func FormatPartitionValues(partitionValues []*protos.PartitionValue) string {
+	formatted := make([]string, 0, len(partitionValues))
+	for _, value := range FromPartitionValues(partitionValues) {
+		formatted = append(formatted, fmt.Sprint(value))
+	}
+	return "(" + strings.Join(formatted, ", ") + ")"
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWatermarkColumns(t *testing.T) {
+	columns := WatermarkColumns(" updated_at, id ,")
+	if !reflect.DeepEqual(columns, []string{"updated_at", "id"}) {
+		t.Errorf("unexpected columns: %v", columns)
+	}
+}
+
+func TestQuoteColumns(t *testing.T) {
+	quoted := QuoteColumns([]string{"updated_at", "id"})
+	if !reflect.DeepEqual(quoted, []string{`"updated_at"`, `"id"`}) {
+		t.Errorf("unexpected columns: %v", quoted)
+	}
+}
+
+func TestTupleComparison(t *testing.T) {
+	testCases := []struct {
+		columns  []string
+		op       string
+		expected string
+	}{
+		{
+			columns:  []string{"a"},
+			op:       ">",
+			expected: "(a > :p0)",
+		},
+		{
+			columns:  []string{"a", "b"},
+			op:       ">=",
+			expected: "(a > :p0 OR (a = :p0 AND b >= :p1))",
+		},
+		{
+			columns:  []string{"a", "b", "c"},
+			op:       "<=",
+			expected: "(a < :p0 OR (a = :p0 AND (b < :p1 OR (b = :p1 AND c <= :p2))))",
+		},
+	}
+
+	for _, tc := range testCases {
+		params := []string{":p0", ":p1", ":p2"}[:len(tc.columns)]
+		actual := TupleComparison(tc.columns, tc.op, params)
+		if actual != tc.expected {
+			t.Errorf("expected %q, got %q", tc.expected, actual)
+		}
+	}
+}
+
+func TestPartitionValuesRoundTrip(t *testing.T) {
+	ts := time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)
+	values, err := ToPartitionValues([]interface{}{ts, int32(42), "key"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actual := FromPartitionValues(values)
+	expected := []interface{}{ts, int64(42), "key"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	if _, err := ToPartitionValues([]interface{}{1.5}); err == nil {
+		t.Error("expected an error for a float watermark value")
+	}
+}
@@ -0,0 +1,169 @@
+// Package schemaregistry is a client for Confluent compatible schema registries.
+package schemaregistry
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	SchemaTypeAvro     = "AVRO"
+	SchemaTypeProtobuf = "PROTOBUF"
+
+	contentType = "application/vnd.schemaregistry.v1+json"
+	// magicByte starts every message in the wire format of the schema registry.
+	magicByte = 0
+)
+
+// Client registers schemas in a schema registry, the ids of the registered schemas are cached.
+type Client struct {
+	url        string
+	username   string
+	password   string
+	httpClient *http.Client
+
+	mu  sync.Mutex
+	ids map[string]int
+}
+
+// NewClient creates a client for the schema registry at the given url,
+// basic auth is used when a username is set.
+func NewClient(registryURL string, username string, password string) *Client {
+	return &Client{
+		url:        registryURL,
+		username:   username,
+		password:   password,
+		httpClient: &http.Client{Timeout: time.Minute},
+		ids:        make(map[string]int),
+	}
+}
+
+type registerRequest struct {
+	Schema     string `json:"schema"`
+	SchemaType string `json:"schemaType,omitempty"`
+}
+
+type registerResponse struct {
+	ID int `json:"id"`
+}
+
+type schemaResponse struct {
+	Schema string `json:"schema"`
+}
+
+// Register registers a schema under a subject and returns its id. A schema that differs from the latest
+// version of the subject is registered as a new version, registering the same schema again returns its id.
+func (c *Client) Register(ctx context.Context, subject string, schemaType string, schema string) (int, error) {
+	cacheKey := subject + "\x00" + schema
+	c.mu.Lock()
+	id, ok := c.ids[cacheKey]
+	c.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	request := registerRequest{Schema: schema}
+	// AVRO is the default schema type of the registry.
+	if schemaType != SchemaTypeAvro {
+		request.SchemaType = schemaType
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal schema: %w", err)
+	}
+
+	var response registerResponse
+	path := fmt.Sprintf("/subjects/%s/versions", url.PathEscape(subject))
+	found, err := c.do(ctx, http.MethodPost, path, body, &response)
+	if err != nil {
+		return 0, fmt.Errorf("failed to register schema for subject %s: %w", subject, err)
+	}
+	if !found {
+		return 0, fmt.Errorf("failed to register schema for subject %s: not found", subject)
+	}
+
+	c.mu.Lock()
+	c.ids[cacheKey] = response.ID
+	c.mu.Unlock()
+	return response.ID, nil
+}
+
+// LatestSchema returns the latest schema registered under a subject, or an empty string when there is none.
+func (c *Client) LatestSchema(ctx context.Context, subject string) (string, error) {
+	var response schemaResponse
+	path := fmt.Sprintf("/subjects/%s/versions/latest", url.PathEscape(subject))
+	found, err := c.do(ctx, http.MethodGet, path, nil, &response)
+	if err != nil {
+		return "", fmt.Errorf("failed to get latest schema for subject %s: %w", subject, err)
+	}
+	if !found {
+		return "", nil
+	}
+	return response.Schema, nil
+}
+
+// do sends a request to the registry and decodes the response, it returns false when the resource is not found.
+func (c *Client) do(ctx context.Context, method string, path string, body []byte, response interface{}) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Accept", contentType)
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return false, fmt.Errorf("failed to read response: %w", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("schema registry returned %s: %s", resp.Status, string(respBody))
+	}
+
+	if err := json.Unmarshal(respBody, response); err != nil {
+		return false, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return true, nil
+}
+
+// Frame prefixes a payload with the magic byte and schema id of the wire format of the registry.
+// Protobuf payloads are also prefixed with the indexes of their message in the schema, see
+// https://docs.confluent.io/platform/current/schema-registry/fundamentals/serdes-develop/index.html#wire-format
+func Frame(schemaID int, messageIndexes []int, payload []byte) []byte {
+	framed := make([]byte, 5, 5+len(payload)+binary.MaxVarintLen64*(len(messageIndexes)+1))
+	framed[0] = magicByte
+	binary.BigEndian.PutUint32(framed[1:5], uint32(schemaID))
+
+	if messageIndexes != nil {
+		// the indexes of the first message, [0], are written as a single 0.
+		if len(messageIndexes) == 1 && messageIndexes[0] == 0 {
+			framed = binary.AppendVarint(framed, 0)
+		} else {
+			framed = binary.AppendVarint(framed, int64(len(messageIndexes)))
+			for _, index := range messageIndexes {
+				framed = binary.AppendVarint(framed, int64(index))
+			}
+		}
+	}
+
+	return append(framed, payload...)
+}
@@ -0,0 +1,93 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+// system columns maintained on normalized tables, see protos.SystemColumns.
+const (
+	SyncedAtColumnName        = "_peerdb_synced_at"
+	IsDeletedColumnName       = "_peerdb_is_deleted"
+	SourceLSNColumnName       = "_peerdb_source_lsn"
+	CommitTimestampColumnName = "_peerdb_commit_ts"
+)
+
+// GetSystemColumnNames returns the system columns a normalized table has, in a fixed order.
+func GetSystemColumnNames(softDelete bool, systemColumns *protos.SystemColumns) []string {
+	columnNames := make([]string, 0, 4)
+	if systemColumns.GetSyncedAt() {
+		columnNames = append(columnNames, SyncedAtColumnName)
+	}
+	if softDelete {
+		columnNames = append(columnNames, IsDeletedColumnName)
+	}
+	if systemColumns.GetSourceLsn() {
+		columnNames = append(columnNames, SourceLSNColumnName)
+	}
+	if systemColumns.GetCommitTs() {
+		columnNames = append(columnNames, CommitTimestampColumnName)
+	}
+	return columnNames
+}
+
+// SystemColumnDialect is how a warehouse quotes the system columns and computes their values.
+type SystemColumnDialect struct {
+	QuoteIdentifier  func(string) string
+	CurrentTimestamp string
+	// expressions of the source LSN and commit timestamp of the change being normalized.
+	SourceLSN       string
+	CommitTimestamp string
+	// separator between a column and its value in a SET clause.
+	SetSeparator string
+}
+
+// GenerateSystemColumnValues returns the quoted system columns of a normalized table and the values
+// a normalize sets them to, deleted is set for soft deleted rows.
+func GenerateSystemColumnValues(softDelete bool, systemColumns *protos.SystemColumns,
+	dialect *SystemColumnDialect, deleted bool) ([]string, []string) {
+	columnNames := GetSystemColumnNames(softDelete, systemColumns)
+	quotedColumnNames := make([]string, 0, len(columnNames))
+	values := make([]string, 0, len(columnNames))
+	for _, columnName := range columnNames {
+		quotedColumnNames = append(quotedColumnNames, dialect.QuoteIdentifier(columnName))
+		switch columnName {
+		case SyncedAtColumnName:
+			values = append(values, dialect.CurrentTimestamp)
+		case IsDeletedColumnName:
+			values = append(values, strings.ToUpper(strconv.FormatBool(deleted)))
+		case SourceLSNColumnName:
+			values = append(values, dialect.SourceLSN)
+		case CommitTimestampColumnName:
+			values = append(values, dialect.CommitTimestamp)
+		}
+	}
+	return quotedColumnNames, values
+}
+
+// GenerateSystemColumnSets returns the SET clauses of the system columns for a normalize.
+func GenerateSystemColumnSets(softDelete bool, systemColumns *protos.SystemColumns,
+	dialect *SystemColumnDialect, deleted bool) []string {
+	columnNames, values := GenerateSystemColumnValues(softDelete, systemColumns, dialect, deleted)
+	sets := make([]string, 0, len(columnNames))
+	for i, columnName := range columnNames {
+		sets = append(sets, fmt.Sprintf("%s%s%s", columnName, dialect.SetSeparator, values[i]))
+	}
+	return sets
+}
+
+// history columns of normalized tables in history mode, every version of a row is valid
+// from _peerdb_valid_from until _peerdb_valid_to, which is NULL for the current version.
+const (
+	ValidFromColumnName = "_peerdb_valid_from"
+	ValidToColumnName   = "_peerdb_valid_to"
+	IsCurrentColumnName = "_peerdb_is_current"
+)
+
+// GetHistoryColumnNames returns the history columns a normalized table has in history mode.
+func GetHistoryColumnNames() []string {
+	return []string{ValidFromColumnName, ValidToColumnName, IsCurrentColumnName}
+}
@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+func TestGenerateSystemColumnSets(t *testing.T) {
+	dialect := &SystemColumnDialect{
+		QuoteIdentifier:  QuoteIdentifier,
+		CurrentTimestamp: "now()",
+		SourceLSN:        "src.lsn",
+		CommitTimestamp:  "src.ts",
+		SetSeparator:     "=",
+	}
+	systemColumns := &protos.SystemColumns{
+		SyncedAt:  true,
+		SourceLsn: true,
+		CommitTs:  true,
+	}
+
+	sets := GenerateSystemColumnSets(true, systemColumns, dialect, true)
+	expected := []string{
+		`"_peerdb_synced_at"=now()`,
+		`"_peerdb_is_deleted"=TRUE`,
+		`"_peerdb_source_lsn"=src.lsn`,
+		`"_peerdb_commit_ts"=src.ts`,
+	}
+	if !reflect.DeepEqual(sets, expected) {
+		t.Errorf("expected %v, got %v", expected, sets)
+	}
+
+	columnNames, values := GenerateSystemColumnValues(false, nil, dialect, false)
+	if len(columnNames) != 0 || len(values) != 0 {
+		t.Errorf("expected no system columns, got %v", columnNames)
+	}
+}
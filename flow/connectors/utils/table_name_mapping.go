@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+// DefaultFanInSourceColumn is the column that holds the source table for fan-in mirrors,
+// used when FlowConnectionConfigs.FanInSourceColumn is not set.
+const DefaultFanInSourceColumn = "_peerdb_source_table"
+
+// GetFanInSourceColumn returns the source identifier column for a fan-in mirror,
+// or an empty string if the mirror is not in fan-in mode.
+func GetFanInSourceColumn(config *protos.FlowConnectionConfigs) string {
+	if !config.FanIn {
+		return ""
+	}
+	if config.FanInSourceColumn != "" {
+		return config.FanInSourceColumn
+	}
+	return DefaultFanInSourceColumn
+}
+
+// RewriteTableNameMapping applies the destination table name template or regex rewrite
+// of the flow to every source table in the table name mapping.
+// It also errors if multiple source tables end up in one destination table without fan-in.
+func RewriteTableNameMapping(config *protos.FlowConnectionConfigs) (map[string]string, error) {
+	if config.DestinationTableNameTemplate != "" && config.DestinationTableNameRegex != "" {
+		return nil, fmt.Errorf("only one of destination table name template and regex can be set")
+	}
+
+	var rewrite func(string) (string, error)
+	if config.DestinationTableNameTemplate != "" {
+		tmpl, err := template.New("destination_table_name").Option("missingkey=error").
+			Parse(config.DestinationTableNameTemplate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid destination table name template: %w", err)
+		}
+		rewrite = func(sourceTable string) (string, error) {
+			schema, table, found := strings.Cut(sourceTable, ".")
+			if !found {
+				return "", fmt.Errorf("source table identifier is invalid: %s", sourceTable)
+			}
+			var sb strings.Builder
+			err := tmpl.Execute(&sb, map[string]string{
+				"schema": schema,
+				"table":  table,
+			})
+			if err != nil {
+				return "", fmt.Errorf("failed to execute destination table name template for %s: %w",
+					sourceTable, err)
+			}
+			return sb.String(), nil
+		}
+	} else if config.DestinationTableNameRegex != "" {
+		re, err := regexp.Compile(config.DestinationTableNameRegex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid destination table name regex: %w", err)
+		}
+		rewrite = func(sourceTable string) (string, error) {
+			if !re.MatchString(sourceTable) {
+				return "", fmt.Errorf("source table %s does not match destination table name regex %s",
+					sourceTable, config.DestinationTableNameRegex)
+			}
+			return re.ReplaceAllString(sourceTable, config.DestinationTableNameReplacement), nil
+		}
+	}
+
+	sourceTables := make([]string, 0, len(config.TableNameMapping))
+	for sourceTable := range config.TableNameMapping {
+		sourceTables = append(sourceTables, sourceTable)
+	}
+	sort.Strings(sourceTables)
+
+	tableNameMapping := make(map[string]string, len(sourceTables))
+	destinationSources := make(map[string]string)
+	for _, sourceTable := range sourceTables {
+		destinationTable := config.TableNameMapping[sourceTable]
+		if rewrite != nil {
+			var err error
+			destinationTable, err = rewrite(sourceTable)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if destinationTable == "" {
+			return nil, fmt.Errorf("no destination table for source table %s", sourceTable)
+		}
+
+		if otherSource, ok := destinationSources[destinationTable]; ok && !config.FanIn {
+			return nil, fmt.Errorf("source tables %s and %s both map to destination table %s, enable fan-in to allow this",
+				otherSource, sourceTable, destinationTable)
+		}
+		destinationSources[destinationTable] = sourceTable
+		tableNameMapping[sourceTable] = destinationTable
+	}
+
+	return tableNameMapping, nil
+}
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+)
+
+func TestRewriteTableNameMapping(t *testing.T) {
+	tableNameMapping := map[string]string{
+		"public.users":   "users",
+		"public.orders":  "orders",
+		"tenant1.events": "events",
+	}
+
+	testCases := []struct {
+		name        string
+		config      *protos.FlowConnectionConfigs
+		expected    map[string]string
+		expectError bool
+	}{
+		{
+			name:     "no rewrite",
+			config:   &protos.FlowConnectionConfigs{},
+			expected: tableNameMapping,
+		},
+		{
+			name: "template",
+			config: &protos.FlowConnectionConfigs{
+				DestinationTableNameTemplate: "{{.schema}}_{{.table}}",
+			},
+			expected: map[string]string{
+				"public.users":   "public_users",
+				"public.orders":  "public_orders",
+				"tenant1.events": "tenant1_events",
+			},
+		},
+		{
+			name: "regex",
+			config: &protos.FlowConnectionConfigs{
+				DestinationTableNameRegex:       `^(\w+)\.(\w+)$`,
+				DestinationTableNameReplacement: "raw.${1}__${2}",
+			},
+			expected: map[string]string{
+				"public.users":   "raw.public__users",
+				"public.orders":  "raw.public__orders",
+				"tenant1.events": "raw.tenant1__events",
+			},
+		},
+		{
+			name: "template and regex",
+			config: &protos.FlowConnectionConfigs{
+				DestinationTableNameTemplate: "{{.table}}",
+				DestinationTableNameRegex:    ".*",
+			},
+			expectError: true,
+		},
+		{
+			name: "template with unknown key",
+			config: &protos.FlowConnectionConfigs{
+				DestinationTableNameTemplate: "{{.database}}_{{.table}}",
+			},
+			expectError: true,
+		},
+		{
+			name: "regex does not match",
+			config: &protos.FlowConnectionConfigs{
+				DestinationTableNameRegex: `^public\.`,
+			},
+			expectError: true,
+		},
+		{
+			name: "tables collide without fan-in",
+			config: &protos.FlowConnectionConfigs{
+				DestinationTableNameTemplate: "all_rows",
+			},
+			expectError: true,
+		},
+		{
+			name: "tables collide with fan-in",
+			config: &protos.FlowConnectionConfigs{
+				DestinationTableNameTemplate: "all_rows",
+				FanIn:                        true,
+			},
+			expected: map[string]string{
+				"public.users":   "all_rows",
+				"public.orders":  "all_rows",
+				"tenant1.events": "all_rows",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.config.TableNameMapping = tableNameMapping
+		actual, err := RewriteTableNameMapping(tc.config)
+		if tc.expectError {
+			if err == nil {
+				t.Errorf("%s: expected an error, got mapping %v", tc.name, actual)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(actual, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, actual)
+		}
+	}
+}
+
+func TestQuoteLiteral(t *testing.T) {
+	testCases := map[string]string{
+		"public.users":    "'public.users'",
+		"public.o'brien":  "'public.o''brien'",
+		"public.''quoted": "'public.''''quoted'",
+	}
+	for literal, expected := range testCases {
+		if actual := QuoteLiteral(literal); actual != expected {
+			t.Errorf("QuoteLiteral(%q) = %s, expected %s", literal, actual, expected)
+		}
+	}
+}
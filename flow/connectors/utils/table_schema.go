@@ -0,0 +1,12 @@
+package utils
+
+import "github.com/PeerDB-io/peer-flow/generated/protos"
+
+// GetPrimaryKeyColumns returns the columns that make up the primary key of a normalized table.
+// For fan-in tables the source identifier column comes first, followed by the primary key column.
+func GetPrimaryKeyColumns(tableSchema *protos.TableSchema) []string {
+	if tableSchema.SourceIdentifierColumn != "" {
+		return []string{tableSchema.SourceIdentifierColumn, tableSchema.PrimaryKeyColumn}
+	}
+	return []string{tableSchema.PrimaryKeyColumn}
+}
@@ -0,0 +1,102 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	peerflow "github.com/PeerDB-io/peer-flow/workflows"
+)
+
+// countPGExceptRows counts the rows of selector from srcTable that are missing in dstTable.
+func (s *E2EPeerFlowTestSuite) countPGExceptRows(srcTable string, dstTable string, selector string) int64 {
+	var count int64
+	err := s.pool.QueryRow(context.Background(), fmt.Sprintf(
+		"SELECT COUNT(*) FROM (SELECT %s FROM %s EXCEPT SELECT %s FROM %s) diff",
+		selector, srcTable, selector, dstTable)).Scan(&count)
+	s.NoError(err)
+	return count
+}
+
+func (s *E2EPeerFlowTestSuite) Test_Direct_Apply_PG() {
+	env := s.NewTestWorkflowEnvironment()
+	registerWorkflowsAndActivities(env)
+
+	srcTableName := "e2e_test.test_direct_apply_pg"
+	dstTableName := "e2e_test.test_direct_apply_pg_dst"
+	_, err := s.pool.Exec(context.Background(), `
+		CREATE TABLE e2e_test.test_direct_apply_pg (
+			id SERIAL PRIMARY KEY,
+			t1 text,
+			t2 text,
+			k int
+		);CREATE OR REPLACE FUNCTION random_string( int ) RETURNS TEXT as $$
+		SELECT string_agg(substring('0123456789bcdfghjkmnpqrstvwxyz',
+		round(random() * 30)::integer, 1), '') FROM generate_series(1, $1);
+		$$ language sql;
+	`)
+	s.NoError(err)
+
+	connectionGen := FlowConnectionGenerationConfig{
+		FlowJobName:      "test_direct_apply_pg",
+		TableNameMapping: map[string]string{srcTableName: dstTableName},
+		PostgresPort:     postgresPort,
+		Destination:      GeneratePostgresPeer(postgresPort),
+	}
+
+	flowConnConfig, err := connectionGen.GenerateFlowConnectionConfigs()
+	s.NoError(err)
+	flowConnConfig.DirectApply = true
+
+	limits := peerflow.PeerFlowLimits{
+		TotalSyncFlows: 1,
+		MaxBatchSize:   100,
+	}
+
+	// in a separate goroutine, wait for PeerFlowStatusQuery to finish setup
+	// and execute a transaction with inserts, updates touching toast columns and deletes
+	go func() {
+		s.SetupPeerFlowStatusQuery(env, connectionGen)
+		_, err = s.pool.Exec(context.Background(), `
+			BEGIN;
+			INSERT INTO e2e_test.test_direct_apply_pg(t1,t2,k) SELECT random_string(9000),random_string(9000),
+			1 FROM generate_series(1,4);
+			UPDATE e2e_test.test_direct_apply_pg SET k=102 WHERE id=1;
+			UPDATE e2e_test.test_direct_apply_pg SET t1='dummy' WHERE id=2;
+			DELETE FROM e2e_test.test_direct_apply_pg WHERE id=3;
+			END;
+		`)
+		s.NoError(err)
+		// a separate transaction, so the updates leave the toast columns unchanged.
+		_, err = s.pool.Exec(context.Background(), `
+			UPDATE e2e_test.test_direct_apply_pg SET k=2 WHERE id=4;
+			DELETE FROM e2e_test.test_direct_apply_pg WHERE id=1;
+		`)
+		s.NoError(err)
+		fmt.Println("Executed inserts, updates and deletes")
+	}()
+
+	env.ExecuteWorkflow(peerflow.PeerFlowWorkflowWithConfig, flowConnConfig, &limits, nil)
+
+	// Verify workflow completes without error
+	s.True(env.IsWorkflowCompleted())
+	err = env.GetWorkflowError()
+
+	// allow only continue as new error
+	s.Error(err)
+	s.Contains(err.Error(), "continue as new")
+
+	selector := "id,t1,t2,k"
+	s.Equal(int64(0), s.countPGExceptRows(srcTableName, dstTableName, selector))
+	s.Equal(int64(0), s.countPGExceptRows(dstTableName, srcTableName, selector))
+
+	// the records were normalized in the sync transaction, so both checkpoints are at the same batch.
+	var syncBatchID, normalizeBatchID int64
+	err = s.pool.QueryRow(context.Background(), `SELECT sync_batch_id,normalize_batch_id
+		FROM _peerdb_internal.peerdb_mirror_jobs WHERE mirror_job_name=$1`,
+		connectionGen.FlowJobName).Scan(&syncBatchID, &normalizeBatchID)
+	s.NoError(err)
+	s.Greater(syncBatchID, int64(0))
+	s.Equal(syncBatchID, normalizeBatchID)
+
+	env.AssertExpectations(s.T())
+}
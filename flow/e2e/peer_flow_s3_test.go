@@ -7,6 +7,7 @@ import (
 
 	"github.com/PeerDB-io/peer-flow/generated/protos"
 	util "github.com/PeerDB-io/peer-flow/utils"
+	peerflow "github.com/PeerDB-io/peer-flow/workflows"
 	"github.com/stretchr/testify/require"
 )
 
@@ -151,3 +152,98 @@ func (s *E2EPeerFlowTestSuite) Test_Complete_QRep_Flow_S3_CTID() {
 
 	env.AssertExpectations(s.T())
 }
+
+// Test_Fan_Out_Flow_S3 runs a mirror with two S3 destinations, checks that both receive the
+// changes and that dropping the mirror cleans up both destinations.
+func (s *E2EPeerFlowTestSuite) Test_Fan_Out_Flow_S3() {
+	if s.s3Helper == nil {
+		s.T().Skip("Skipping S3 test")
+	}
+
+	env := s.NewTestWorkflowEnvironment()
+	registerWorkflowsAndActivities(env)
+
+	_, err := s.pool.Exec(context.Background(), `
+		CREATE TABLE e2e_test.test_fan_out_s3 (
+			id SERIAL PRIMARY KEY,
+			key TEXT NOT NULL,
+			value TEXT NOT NULL
+		);
+	`)
+	s.NoError(err)
+
+	firstDestination := s.s3Helper.GetPeerWithPrefix("test_s3_peer_fan_out_1", "fan_out_1")
+	secondDestination := s.s3Helper.GetPeerWithPrefix("test_s3_peer_fan_out_2", "fan_out_2")
+	connectionGen := FlowConnectionGenerationConfig{
+		FlowJobName:      "test_fan_out_flow_s3",
+		TableNameMapping: map[string]string{"e2e_test.test_fan_out_s3": "test_fan_out_s3"},
+		PostgresPort:     postgresPort,
+		Destination:      firstDestination,
+	}
+
+	flowConnConfig, err := connectionGen.GenerateFlowConnectionConfigs()
+	s.NoError(err)
+	flowConnConfig.AdditionalDestinations = []*protos.Peer{secondDestination}
+
+	limits := peerflow.PeerFlowLimits{
+		TotalSyncFlows: 2,
+		MaxBatchSize:   100,
+	}
+
+	// in a separate goroutine, wait for PeerFlowStatusQuery to finish setup
+	// and then insert 10 rows into the source table
+	go func() {
+		s.SetupPeerFlowStatusQuery(env, connectionGen)
+		for i := 0; i < 10; i++ {
+			testKey := fmt.Sprintf("test_key_%d", i)
+			testValue := fmt.Sprintf("test_value_%d", i)
+			_, err = s.pool.Exec(context.Background(), `
+			INSERT INTO e2e_test.test_fan_out_s3 (key, value) VALUES ($1, $2)
+		`, testKey, testValue)
+			s.NoError(err)
+		}
+		fmt.Println("Inserted 10 rows into the source table")
+	}()
+
+	env.ExecuteWorkflow(peerflow.PeerFlowWorkflowWithConfig, flowConnConfig, &limits, nil)
+
+	// Verify workflow completes without error
+	s.True(env.IsWorkflowCompleted())
+	err = env.GetWorkflowError()
+
+	// allow only continue as new error
+	s.Error(err)
+	s.Contains(err.Error(), "continue as new")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	for _, subPrefix := range []string{"fan_out_1", "fan_out_2"} {
+		files, err := s.s3Helper.ListFiles(ctx, fmt.Sprintf("%s/%s/%s/", prefixName, subPrefix,
+			connectionGen.FlowJobName))
+		require.NoError(s.T(), err)
+		require.NotEmpty(s.T(), files, "expected change files for destination %s", subPrefix)
+	}
+
+	dropEnv := s.NewTestWorkflowEnvironment()
+	registerWorkflowsAndActivities(dropEnv)
+	dropEnv.ExecuteWorkflow(peerflow.DropFlowWorkflow, &protos.ShutdownRequest{
+		FlowJobName:                connectionGen.FlowJobName,
+		SourcePeer:                 flowConnConfig.Source,
+		DestinationPeer:            firstDestination,
+		AdditionalDestinationPeers: []*protos.Peer{secondDestination},
+	})
+	s.True(dropEnv.IsWorkflowCompleted())
+	s.NoError(dropEnv.GetWorkflowError())
+
+	// dropping the mirror removes the manifest of both destinations
+	for _, subPrefix := range []string{"fan_out_1", "fan_out_2"} {
+		files, err := s.s3Helper.ListFiles(ctx, fmt.Sprintf("%s/%s/%s/_peerdb_manifest.json", prefixName,
+			subPrefix, connectionGen.FlowJobName))
+		require.NoError(s.T(), err)
+		require.Empty(s.T(), files, "expected the manifest of destination %s to be removed", subPrefix)
+	}
+
+	env.AssertExpectations(s.T())
+	dropEnv.AssertExpectations(s.T())
+}
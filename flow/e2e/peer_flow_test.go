@@ -280,6 +280,7 @@ func registerWorkflowsAndActivities(env *testsuite.TestWorkflowEnvironment) {
 	env.RegisterWorkflow(peerflow.NormalizeFlowWorkflow)
 	env.RegisterWorkflow(peerflow.QRepFlowWorkflow)
 	env.RegisterWorkflow(peerflow.QRepPartitionWorkflow)
+	env.RegisterWorkflow(peerflow.DropFlowWorkflow)
 	env.RegisterActivity(&activities.FetchConfigActivity{})
 	env.RegisterActivity(&activities.FlowableActivity{})
 	env.RegisterActivity(&activities.SnapshotActivity{})
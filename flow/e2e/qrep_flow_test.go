@@ -621,5 +621,5 @@ func runQrepFlowWorkflow(env *testsuite.TestWorkflowEnvironment, config *protos.
 		Range:       nil,
 	}
 	numPartitionsProcessed := 0
-	env.ExecuteWorkflow(peerflow.QRepFlowWorkflow, config, lastPartition, numPartitionsProcessed)
+	env.ExecuteWorkflow(peerflow.QRepFlowWorkflow, config, lastPartition, numPartitionsProcessed, nil, nil)
 }
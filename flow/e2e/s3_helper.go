@@ -3,6 +3,7 @@ package e2e
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
@@ -22,8 +23,21 @@ type S3TestHelper struct {
 	s3Config *protos.S3Config
 }
 
+// NewS3TestHelper creates a helper for the test bucket, on a local MinIO when
+// TEST_S3_ENDPOINT is set and on AWS through the AWS_* environment variables otherwise.
 func NewS3TestHelper() (*S3TestHelper, error) {
-	client, err := utils.CreateS3Client()
+	var connConfig *protos.S3ConnectionConfig
+	if endpoint := os.Getenv("TEST_S3_ENDPOINT"); endpoint != "" {
+		connConfig = &protos.S3ConnectionConfig{
+			AccessKeyId:     os.Getenv("TEST_S3_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("TEST_S3_SECRET_ACCESS_KEY"),
+			Region:          "us-east-1",
+			Endpoint:        endpoint,
+			ForcePathStyle:  true,
+		}
+	}
+
+	client, err := utils.CreateS3Client(connConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +45,8 @@ func NewS3TestHelper() (*S3TestHelper, error) {
 	return &S3TestHelper{
 		client,
 		&protos.S3Config{
-			Url: fmt.Sprintf("s3://%s/%s", bucketName, prefixName),
+			Url:        fmt.Sprintf("s3://%s/%s", bucketName, prefixName),
+			Connection: connConfig,
 		},
 	}, nil
 }
@@ -46,14 +61,37 @@ func (h *S3TestHelper) GetPeer() *protos.Peer {
 	}
 }
 
+// GetPeerWithPrefix returns a peer with its own name that writes under a sub-prefix of the test prefix,
+// so that several S3 peers can be used by one mirror.
+func (h *S3TestHelper) GetPeerWithPrefix(name string, subPrefix string) *protos.Peer {
+	return &protos.Peer{
+		Name: name,
+		Type: protos.DBType_S3,
+		Config: &protos.Peer_S3Config{
+			S3Config: &protos.S3Config{
+				Url:        fmt.Sprintf("%s/%s", h.s3Config.Url, subPrefix),
+				Connection: h.s3Config.Connection,
+			},
+		},
+	}
+}
+
 // List all files from the S3 bucket.
 // returns as a list of S3Objects.
 func (h *S3TestHelper) ListAllFiles(
 	ctx context.Context,
 	jobName string,
+) ([]*s3.Object, error) {
+	return h.ListFiles(ctx, fmt.Sprintf("%s/%s/", prefixName, jobName))
+}
+
+// ListFiles lists all files of the test bucket under a prefix.
+func (h *S3TestHelper) ListFiles(
+	ctx context.Context,
+	prefix string,
 ) ([]*s3.Object, error) {
 	Bucket := bucketName
-	Prefix := fmt.Sprintf("%s/%s/", prefixName, jobName)
+	Prefix := prefix
 	files, err := h.client.ListObjects(&s3.ListObjectsInput{
 		Bucket: &Bucket,
 		Prefix: &Prefix,
@@ -37,11 +37,26 @@ type Record interface {
 	GetTableName() string
 	// get columns and values for the record
 	GetItems() RecordItems
+	// GetCommitTime returns the commit time of the source transaction of the record.
+	GetCommitTime() time.Time
 }
 
 type RecordItems map[string]qvalue.QValue
 
 func (r RecordItems) ToJSON() (string, error) {
+	jsonStruct, err := r.ToJSONMap()
+	if err != nil {
+		return "", err
+	}
+	jsonBytes, err := json.Marshal(jsonStruct)
+	if err != nil {
+		return "", err
+	}
+	return string(jsonBytes), nil
+}
+
+// ToJSONMap converts the items to values that marshal to JSON like ToJSON, for embedding them in other JSON.
+func (r RecordItems) ToJSONMap() (map[string]interface{}, error) {
 	jsonStruct := make(map[string]interface{})
 	for k, v := range r {
 		var err error
@@ -56,7 +71,7 @@ func (r RecordItems) ToJSON() (string, error) {
 			qvalue.QValueKindTime, qvalue.QValueKindTimeTZ:
 			jsonStruct[k], err = v.GoTimeConvert()
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 		case qvalue.QValueKindNumeric:
 			bigRat := v.Value.(*big.Rat)
@@ -65,11 +80,7 @@ func (r RecordItems) ToJSON() (string, error) {
 			jsonStruct[k] = v.Value
 		}
 	}
-	jsonBytes, err := json.Marshal(jsonStruct)
-	if err != nil {
-		return "", err
-	}
-	return string(jsonBytes), nil
+	return jsonStruct, nil
 }
 
 type InsertRecord struct {
@@ -81,6 +92,10 @@ type InsertRecord struct {
 	CheckPointID int64
 	// CommitID is the ID of the commit corresponding to this record.
 	CommitID int64
+	// CommitTime is the commit time of the source transaction.
+	CommitTime time.Time
+	// TransactionID is the id of the source transaction.
+	TransactionID uint32
 	// Items is a map of column name to value.
 	Items RecordItems
 	// unchanged toast columns
@@ -100,11 +115,19 @@ func (r *InsertRecord) GetItems() RecordItems {
 	return r.Items
 }
 
+func (r *InsertRecord) GetCommitTime() time.Time {
+	return r.CommitTime
+}
+
 type UpdateRecord struct {
 	// Name of the source table
 	SourceTableName string
 	// CheckPointID is the ID of the record.
 	CheckPointID int64
+	// CommitTime is the commit time of the source transaction.
+	CommitTime time.Time
+	// TransactionID is the id of the source transaction.
+	TransactionID uint32
 	// Name of the destination table
 	DestinationTableName string
 	// OldItems is a map of column name to value.
@@ -129,6 +152,10 @@ func (r *UpdateRecord) GetItems() RecordItems {
 	return r.NewItems
 }
 
+func (r *UpdateRecord) GetCommitTime() time.Time {
+	return r.CommitTime
+}
+
 type DeleteRecord struct {
 	// Name of the source table
 	SourceTableName string
@@ -136,6 +163,10 @@ type DeleteRecord struct {
 	DestinationTableName string
 	// CheckPointID is the ID of the record.
 	CheckPointID int64
+	// CommitTime is the commit time of the source transaction.
+	CommitTime time.Time
+	// TransactionID is the id of the source transaction.
+	TransactionID uint32
 	// Items is a map of column name to value.
 	Items RecordItems
 	// unchanged toast columns
@@ -155,6 +186,10 @@ func (r *DeleteRecord) GetItems() RecordItems {
 	return r.Items
 }
 
+func (r *DeleteRecord) GetCommitTime() time.Time {
+	return r.CommitTime
+}
+
 type TableWithPkey struct {
 	TableName  string
 	PkeyColVal interface{}
@@ -171,6 +206,26 @@ type RecordBatch struct {
 	TablePKeyLastSeen map[TableWithPkey]int
 }
 
+// RecordsAfterCheckpoint returns a batch with only the records past the given checkpoint,
+// used when a destination has already synced part of the batch.
+func (r *RecordBatch) RecordsAfterCheckpoint(checkpoint int64) *RecordBatch {
+	if checkpoint < r.FirstCheckPointID {
+		return r
+	}
+
+	records := make([]Record, 0, len(r.Records))
+	for _, record := range r.Records {
+		if record.GetCheckPointID() > checkpoint {
+			records = append(records, record)
+		}
+	}
+	return &RecordBatch{
+		Records:           records,
+		FirstCheckPointID: r.FirstCheckPointID,
+		LastCheckPointID:  r.LastCheckPointID,
+	}
+}
+
 type SyncRecordsRequest struct {
 	Records *RecordBatch
 	// FlowJobName is the name of the flow job.
@@ -179,11 +234,53 @@ type SyncRecordsRequest struct {
 	SyncMode protos.QRepSyncMode
 	// Staging path for AVRO files in CDC
 	StagingPath string
+	// OutputFormat of the change files, for destinations that store files.
+	OutputFormat protos.QRepOutputFormat
+	// DirectApply applies the records to the normalized tables in the sync transaction,
+	// the normalize options below are only used then.
+	DirectApply   bool
+	SoftDelete    bool
+	SystemColumns *protos.SystemColumns
+	HistoryMode   bool
+	// DeadLetter is called with the records that fail to convert when the mirror has a dead letter sink,
+	// it returns an error once the mirror has too many failed records.
+	DeadLetter func(record Record, err error) error
+}
+
+// SkipFailedRecord returns nil when a record that failed to convert went to the dead letter sink of the mirror,
+// so that the batch goes on without it, and the error otherwise.
+func (r *SyncRecordsRequest) SkipFailedRecord(record Record, err error) error {
+	if r.DeadLetter == nil {
+		return err
+	}
+	return r.DeadLetter(record, err)
+}
+
+// RecordError is an error caused by the values of the records being synced rather than by the destination,
+// such as a value the destination can't hold, so syncing the same records again fails the same way.
+type RecordError struct {
+	Err error
+}
+
+func NewRecordError(err error) *RecordError {
+	return &RecordError{Err: err}
+}
+
+func (e *RecordError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RecordError) Unwrap() error {
+	return e.Err
 }
 
 type NormalizeRecordsRequest struct {
-	FlowJobName string
-	SoftDelete  bool
+	FlowJobName   string
+	SoftDelete    bool
+	SystemColumns *protos.SystemColumns
+	HistoryMode   bool
+	// BigQueryTableOptions has the partitioning of the normalized tables on BigQuery.
+	BigQueryTableOptions map[string]*protos.BigQueryTableOptions
 }
 
 type SyncResponse struct {
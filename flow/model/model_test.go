@@ -0,0 +1,29 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordsAfterCheckpoint(t *testing.T) {
+	batch := &RecordBatch{
+		Records: []Record{
+			&InsertRecord{CheckPointID: 10},
+			&UpdateRecord{CheckPointID: 20},
+			&DeleteRecord{CheckPointID: 30},
+		},
+		FirstCheckPointID: 10,
+		LastCheckPointID:  30,
+	}
+
+	assert.Equal(t, batch, batch.RecordsAfterCheckpoint(0))
+
+	filtered := batch.RecordsAfterCheckpoint(20)
+	assert.Len(t, filtered.Records, 1)
+	assert.Equal(t, int64(30), filtered.Records[0].GetCheckPointID())
+	assert.Equal(t, int64(10), filtered.FirstCheckPointID)
+	assert.Equal(t, int64(30), filtered.LastCheckPointID)
+
+	assert.Empty(t, batch.RecordsAfterCheckpoint(30).Records)
+}
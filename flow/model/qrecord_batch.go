@@ -75,6 +75,45 @@ func (q *QRecordBatch) ToQRecordStream(buffer int) (*QRecordStream, error) {
 	return stream, nil
 }
 
+// NewQRecordBatchFromStream collects the records that pull writes to a stream into a batch.
+// The stream is drained on errors, pull never blocks on a batch that isn't read anymore.
+func NewQRecordBatchFromStream(buffer int, pull func(stream *QRecordStream) error) (*QRecordBatch, error) {
+	stream := NewQRecordStream(buffer)
+	pullErr := make(chan error, 1)
+	go func() {
+		pullErr <- pull(stream)
+	}()
+	drain := func() {
+		//nolint:revive
+		for range stream.Records {
+		}
+		<-pullErr
+	}
+
+	schema, err := stream.Schema()
+	if err != nil {
+		drain()
+		return nil, fmt.Errorf("failed to get schema from stream: %w", err)
+	}
+	batch := &QRecordBatch{
+		NumRecords: 0,
+		Records:    make([]*QRecord, 0),
+		Schema:     schema,
+	}
+	for record := range stream.Records {
+		if record.Err != nil {
+			drain()
+			return nil, fmt.Errorf("failed to get record from stream: %w", record.Err)
+		}
+		batch.Records = append(batch.Records, record.Record)
+	}
+	if err := <-pullErr; err != nil {
+		return nil, err
+	}
+	batch.NumRecords = uint32(len(batch.Records))
+	return batch, nil
+}
+
 type QRecordBatchCopyFromSource struct {
 	numRecords    int
 	stream        *QRecordStream
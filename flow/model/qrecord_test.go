@@ -1,6 +1,7 @@
 package model
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
@@ -81,3 +82,35 @@ func TestEquals(t *testing.T) {
 		})
 	}
 }
+
+func TestNewQRecordBatchFromStream(t *testing.T) {
+	schema := NewQRecordSchema([]*QField{{Name: "id", Type: qvalue.QValueKindInt64}})
+	pull := func(numRecords int, pullErr error) func(stream *QRecordStream) error {
+		return func(stream *QRecordStream) error {
+			defer close(stream.Records)
+			_ = stream.SetSchema(schema)
+			for i := 0; i < numRecords; i++ {
+				record := NewQRecord(1)
+				record.Set(0, qvalue.QValue{Kind: qvalue.QValueKindInt64, Value: int64(i)})
+				stream.Records <- &QRecordOrError{Record: record}
+			}
+			if pullErr != nil {
+				stream.Records <- &QRecordOrError{Err: pullErr}
+			}
+			// records sent after an error have to be drained for pull to return.
+			for i := 0; i < numRecords; i++ {
+				stream.Records <- &QRecordOrError{Record: NewQRecord(1)}
+			}
+			return pullErr
+		}
+	}
+
+	batch, err := NewQRecordBatchFromStream(1, pull(3, nil))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(6), batch.NumRecords)
+	assert.Equal(t, schema, batch.Schema)
+
+	pullErr := errors.New("connection reset")
+	_, err = NewQRecordBatchFromStream(1, pull(3, pullErr))
+	assert.ErrorIs(t, err, pullErr)
+}
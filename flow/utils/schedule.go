@@ -0,0 +1,148 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron"
+)
+
+const minutesPerDay = 24 * 60
+
+// runWindow is a window of the day, in minutes since midnight UTC, that runs are allowed to start in.
+// The end is exclusive, and a window whose end is before its start wraps around midnight.
+type runWindow struct {
+	start int
+	end   int
+}
+
+func (w runWindow) contains(minute int) bool {
+	if w.start < w.end {
+		return minute >= w.start && minute < w.end
+	}
+	return minute >= w.start || minute < w.end
+}
+
+// Schedule decides when the runs of a QRep flow start, from a cron expression and run windows.
+type Schedule struct {
+	cron    cron.Schedule
+	windows []runWindow
+}
+
+// ParseSchedule parses a standard 5 field cron expression and comma separated run windows like
+// "01:00-05:00,22:00-23:30", both in UTC. Either can be empty.
+func ParseSchedule(cronSpec string, runWindows string) (*Schedule, error) {
+	schedule := &Schedule{}
+	if strings.TrimSpace(cronSpec) != "" {
+		cronSchedule, err := cron.ParseStandard(cronSpec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %s: %w", cronSpec, err)
+		}
+		schedule.cron = cronSchedule
+	}
+
+	for _, window := range strings.Split(runWindows, ",") {
+		window = strings.TrimSpace(window)
+		if window == "" {
+			continue
+		}
+		start, end, found := strings.Cut(window, "-")
+		if !found {
+			return nil, fmt.Errorf("invalid run window %s, expected HH:MM-HH:MM", window)
+		}
+		startMinute, err := parseMinuteOfDay(start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid run window %s: %w", window, err)
+		}
+		endMinute, err := parseMinuteOfDay(end)
+		if err != nil {
+			return nil, fmt.Errorf("invalid run window %s: %w", window, err)
+		}
+		if startMinute == endMinute {
+			return nil, fmt.Errorf("invalid run window %s, start and end are the same", window)
+		}
+		schedule.windows = append(schedule.windows, runWindow{start: startMinute, end: endMinute})
+	}
+
+	return schedule, nil
+}
+
+func parseMinuteOfDay(s string) (int, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %s: %w", s, err)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// HasRunWindows returns whether runs are limited to run windows.
+func (s *Schedule) HasRunWindows() bool {
+	return len(s.windows) > 0
+}
+
+// inWindow returns whether a run is allowed to start at t.
+func (s *Schedule) inWindow(t time.Time) bool {
+	if len(s.windows) == 0 {
+		return true
+	}
+	t = t.UTC()
+	minute := t.Hour()*60 + t.Minute()
+	for _, window := range s.windows {
+		if window.contains(minute) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextWindowStart returns the start of the first window after t.
+func (s *Schedule) nextWindowStart(t time.Time) time.Time {
+	t = t.UTC()
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	minute := t.Hour()*60 + t.Minute()
+
+	next := time.Time{}
+	for _, window := range s.windows {
+		startsIn := window.start - minute
+		if startsIn <= 0 {
+			startsIn += minutesPerDay
+		}
+		start := midnight.Add(time.Duration(minute+startsIn) * time.Minute)
+		if next.IsZero() || start.Before(next) {
+			next = start
+		}
+	}
+	return next
+}
+
+// Allowed returns the first time at or after t that a run is allowed to start at.
+func (s *Schedule) Allowed(t time.Time) time.Time {
+	if s.inWindow(t) {
+		return t
+	}
+	return s.nextWindowStart(t)
+}
+
+// Next returns when the run after a run that ended at t starts. Ticks of the cron expression
+// that passed while the previous run was running are skipped rather than caught up on,
+// and ticks outside of the run windows are skipped too. Without a cron expression the
+// next run starts after wait, at the first time that is allowed by the run windows.
+func (s *Schedule) Next(t time.Time, wait time.Duration) (time.Time, error) {
+	if s.cron == nil {
+		return s.Allowed(t.Add(wait)), nil
+	}
+
+	// the cron expression is evaluated in the location of the time it is given.
+	t = t.UTC()
+	next := s.cron.Next(t)
+	// a tick falls in a window within a year, unless the windows and the cron expression never match.
+	limit := t.AddDate(1, 0, 0)
+	for !s.inWindow(next) {
+		next = s.cron.Next(s.nextWindowStart(next).Add(-time.Second))
+		if next.IsZero() || next.After(limit) {
+			return time.Time{}, fmt.Errorf("no tick of the cron expression falls in the run windows")
+		}
+	}
+	return next, nil
+}
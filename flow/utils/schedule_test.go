@@ -0,0 +1,99 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func mustParseTime(t *testing.T, s string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func TestParseScheduleInvalid(t *testing.T) {
+	invalid := [][2]string{
+		{"not a cron", ""},
+		{"", "01:00"},
+		{"", "01:00-25:00"},
+		{"", "01:00-01:00"},
+	}
+	for _, spec := range invalid {
+		if _, err := ParseSchedule(spec[0], spec[1]); err == nil {
+			t.Errorf("expected an error for cron %q and run windows %q", spec[0], spec[1])
+		}
+	}
+}
+
+func TestScheduleAllowed(t *testing.T) {
+	schedule, err := ParseSchedule("", "01:00-05:00, 22:00-23:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := [][2]string{
+		// in a window
+		{"2023-09-01T02:30:00Z", "2023-09-01T02:30:00Z"},
+		// before the first window of the day
+		{"2023-09-01T00:15:00Z", "2023-09-01T01:00:00Z"},
+		// between windows
+		{"2023-09-01T12:00:00Z", "2023-09-01T22:00:00Z"},
+		// after the last window of the day
+		{"2023-09-01T23:00:00Z", "2023-09-02T01:00:00Z"},
+	}
+	for _, test := range tests {
+		allowed := schedule.Allowed(mustParseTime(t, test[0]))
+		if !allowed.Equal(mustParseTime(t, test[1])) {
+			t.Errorf("expected %s to be allowed at %s, got %s", test[0], test[1], allowed)
+		}
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	// a window that wraps around midnight
+	schedule, err := ParseSchedule("*/30 * * * *", "23:00-02:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := [][2]string{
+		{"2023-09-01T23:10:00Z", "2023-09-01T23:30:00Z"},
+		{"2023-09-01T01:45:00Z", "2023-09-01T23:00:00Z"},
+		// ticks that passed while the previous run was running are skipped
+		{"2023-09-02T00:59:00Z", "2023-09-02T01:00:00Z"},
+	}
+	for _, test := range tests {
+		next, err := schedule.Next(mustParseTime(t, test[0]), time.Minute)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !next.Equal(mustParseTime(t, test[1])) {
+			t.Errorf("expected the run after %s at %s, got %s", test[0], test[1], next)
+		}
+	}
+
+	// without a cron expression, runs are started after the wait
+	schedule, err = ParseSchedule("", "01:00-05:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	next, err := schedule.Next(mustParseTime(t, "2023-09-01T04:59:30Z"), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !next.Equal(mustParseTime(t, "2023-09-02T01:00:00Z")) {
+		t.Errorf("expected the next run the next day, got %s", next)
+	}
+
+	// ticks that never fall in a window
+	schedule, err = ParseSchedule("0 12 * * *", "01:00-05:00")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := schedule.Next(mustParseTime(t, "2023-09-01T00:00:00Z"), 0); err == nil {
+		t.Errorf("expected an error for a cron expression outside of the run windows")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/PeerDB-io/peer-flow/generated/protos"
+	util "github.com/PeerDB-io/peer-flow/utils"
 	"github.com/google/uuid"
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/sdk/log"
@@ -13,6 +14,29 @@ import (
 	"go.temporal.io/sdk/workflow"
 )
 
+const (
+	QRepFlowStatusQuery = "q-qrep-flow-status"
+
+	// change id of runs waiting for their schedule, instead of sleeping after the previous run.
+	qRepScheduleChangeID = "qrep-schedule"
+)
+
+// QRepFlowState is the state of a QRep flow that is carried over continue-as-new.
+type QRepFlowState struct {
+	// LastReconciledAt is when the keys of the destination were last reconciled with the source.
+	LastReconciledAt time.Time
+	// NextRunAt is when the next run of the flow starts, per the schedule of the flow.
+	NextRunAt time.Time
+}
+
+// QRepFlowStatus is the status of a QRep flow returned by the QRepFlowStatusQuery.
+type QRepFlowStatus struct {
+	NumPartitionsProcessed int
+	// Running is whether a run is in progress, otherwise the flow waits for NextRunAt.
+	Running   bool
+	NextRunAt time.Time
+}
+
 type QRepFlowExecution struct {
 	config          *protos.QRepConfig
 	flowExecutionID string
@@ -172,6 +196,60 @@ func (q *QRepFlowExecution) processPartitions(
 	return nil
 }
 
+// processPartitionsInRunWindows replicates the partitions one per child workflow, with at most
+// maxParallelWorkers at a time. A partition is only started while the schedule allows runs, the
+// partitions that are not started once the run window closes are returned for the next window.
+func (q *QRepFlowExecution) processPartitionsInRunWindows(
+	ctx workflow.Context,
+	maxParallelWorkers int,
+	partitions []*protos.QRepPartition,
+	schedule *util.Schedule,
+) ([]*protos.QRepPartition, error) {
+	running := 0
+	var childErr error
+	selector := workflow.NewSelector(ctx)
+	started := 0
+	for started < len(partitions) && childErr == nil {
+		if running == maxParallelWorkers {
+			selector.Select(ctx)
+			continue
+		}
+		now := workflow.Now(ctx)
+		if schedule.Allowed(now).After(now) {
+			q.logger.Info("run window closed, partitions left for the next window - ", len(partitions)-started)
+			break
+		}
+
+		batch := &protos.QRepPartitionBatch{
+			Partitions: partitions[started : started+1],
+			BatchId:    int32(started + 1),
+		}
+		future, err := q.startChildWorkflow(ctx, batch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start child workflow: %w", err)
+		}
+		selector.AddFuture(future, func(f workflow.Future) {
+			running--
+			if err := f.Get(ctx, nil); err != nil && childErr == nil {
+				childErr = fmt.Errorf("failed to wait for child workflow: %w", err)
+			}
+		})
+		running++
+		started++
+	}
+
+	// wait for the started child workflows to complete
+	for running > 0 {
+		selector.Select(ctx)
+	}
+	if childErr != nil {
+		return nil, childErr
+	}
+
+	q.logger.Info("partitions processed in run window - ", started)
+	return partitions[started:], nil
+}
+
 // For some targets we need to consolidate all the partitions from stages before
 // we proceed to next batch.
 func (q *QRepFlowExecution) consolidatePartitions(ctx workflow.Context) error {
@@ -200,20 +278,48 @@ func (q *QRepFlowExecution) consolidatePartitions(ctx workflow.Context) error {
 	return nil
 }
 
+// reconcileDeletes removes the rows of the destination whose keys were deleted at the source.
+func (q *QRepFlowExecution) reconcileDeletes(ctx workflow.Context) error {
+	q.logger.Info("reconciling deletes for qrep flow - ", q.config.FlowJobName)
+
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 24 * time.Hour,
+		HeartbeatTimeout:    10 * time.Minute,
+	})
+
+	var numRowsDeleted int64
+	if err := workflow.ExecuteActivity(ctx, flowable.ReconcileQRepDeletes, q.config,
+		q.runUUID).Get(ctx, &numRowsDeleted); err != nil {
+		return fmt.Errorf("failed to reconcile deletes: %w", err)
+	}
+
+	q.logger.Info("deletes reconciled - ", numRowsDeleted)
+	return nil
+}
+
 func QRepFlowWorkflow(
 	ctx workflow.Context,
 	config *protos.QRepConfig,
 	lastPartition *protos.QRepPartition,
 	numPartitionsProcessed int,
+	state *QRepFlowState,
+	pendingPartitions *protos.QRepPartitionBatch,
 ) error {
 	// The structure of this workflow is as follows:
 	//   1. Start the loop to continuously run the replication flow.
 	//   2. In the loop, query the source database to get the partitions to replicate.
 	//   3. For each partition, start a new workflow to replicate the partition.
 	//	 4. Wait for all the workflows to complete.
-	//   5. Sleep for a while and repeat the loop.
+	//   5. Wait for the next run allowed by the schedule and repeat the loop.
+	//      Runs never overlap, the ticks of the schedule that pass during a run are skipped.
+	//      Partitions are only started within run windows, the partitions left when a window
+	//      closes are replicated first in the next window.
 	logger := workflow.GetLogger(ctx)
 
+	if state == nil {
+		state = &QRepFlowState{}
+	}
+
 	maxParallelWorkers := 16
 	if config.MaxParallelWorkers > 0 {
 		maxParallelWorkers = int(config.MaxParallelWorkers)
@@ -252,6 +358,51 @@ func QRepFlowWorkflow(
 		return fmt.Errorf("failed to register query handler: %w", err)
 	}
 
+	running := false
+	err = workflow.SetQueryHandler(ctx, QRepFlowStatusQuery, func() (QRepFlowStatus, error) {
+		return QRepFlowStatus{
+			NumPartitionsProcessed: numPartitionsProcessed,
+			Running:                running,
+			NextRunAt:              state.NextRunAt,
+		}, nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set `%s` query handler: %w", QRepFlowStatusQuery, err)
+	}
+
+	schedule, err := util.ParseSchedule(config.ScheduleCron, config.RunWindows)
+	if err != nil {
+		return fmt.Errorf("invalid schedule: %w", err)
+	}
+	// runs started before schedules existed slept between runs instead of waiting for the next run.
+	scheduleVersion := workflow.GetVersion(ctx, qRepScheduleChangeID, workflow.DefaultVersion, 1)
+	if scheduleVersion != workflow.DefaultVersion && state.NextRunAt.IsZero() {
+		// the first run waits for the first tick of the cron expression too.
+		state.NextRunAt, err = schedule.Next(workflow.Now(ctx), 0)
+		if err != nil {
+			return fmt.Errorf("failed to schedule first run: %w", err)
+		}
+	}
+
+	// wait for the run to be due, unless the workflow is terminated in the meantime.
+	if wait := state.NextRunAt.Sub(workflow.Now(ctx)); scheduleVersion != workflow.DefaultVersion && wait > 0 {
+		logger.Info("waiting for next run", "Next Run At", state.NextRunAt)
+		waitSelector := workflow.NewSelector(ctx)
+		waitSelector.AddFuture(workflow.NewTimer(ctx, wait), func(_ workflow.Future) {})
+		waitSelector.AddReceive(signalChan, func(c workflow.ReceiveChannel, _ bool) {
+			var signal string
+			c.Receive(ctx, &signal)
+			logger.Info("Received signal to terminate workflow", "Signal", signal)
+			terminateWorkflow = true
+		})
+		waitSelector.Select(ctx)
+		if terminateWorkflow {
+			logger.Info("terminating workflow - ", config.FlowJobName)
+			return nil
+		}
+	}
+	running = true
+
 	// get qrep run uuid via side-effect
 	runUUIDSideEffect := workflow.SideEffect(ctx, func(ctx workflow.Context) interface{} {
 		return uuid.New().String()
@@ -270,33 +421,58 @@ func QRepFlowWorkflow(
 	}
 	q.logger.Info("metadata tables setup for peer flow - ", config.FlowJobName)
 
-	logger.Info("fetching partitions to replicate for peer flow - ", config.FlowJobName)
-	partitions, err := q.GetPartitions(ctx, lastPartition)
-	if err != nil {
-		return fmt.Errorf("failed to get partitions: %w", err)
+	var partitions []*protos.QRepPartition
+	if len(pendingPartitions.GetPartitions()) > 0 {
+		logger.Info("replicating partitions left by the previous run window - ", len(pendingPartitions.Partitions))
+		partitions = pendingPartitions.Partitions
+	} else {
+		logger.Info("fetching partitions to replicate for peer flow - ", config.FlowJobName)
+		partitionResult, err := q.GetPartitions(ctx, lastPartition)
+		if err != nil {
+			return fmt.Errorf("failed to get partitions: %w", err)
+		}
+		partitions = partitionResult.Partitions
+		if len(partitions) > 0 {
+			lastPartition = partitions[len(partitions)-1]
+		}
 	}
 
-	logger.Info("partitions to replicate - ", len(partitions.Partitions))
-	if err = q.processPartitions(ctx, maxParallelWorkers, partitions.Partitions); err != nil {
+	logger.Info("partitions to replicate - ", len(partitions))
+	var remainingPartitions []*protos.QRepPartition
+	if schedule.HasRunWindows() {
+		remainingPartitions, err = q.processPartitionsInRunWindows(ctx, maxParallelWorkers, partitions, schedule)
+		if err != nil {
+			return err
+		}
+	} else if err = q.processPartitions(ctx, maxParallelWorkers, partitions); err != nil {
 		return err
 	}
+	numProcessed := len(partitions) - len(remainingPartitions)
 
 	logger.Info("consolidating partitions for peer flow - ", config.FlowJobName)
 	if err = q.consolidatePartitions(ctx); err != nil {
 		return err
 	}
 
-	if config.InitialCopyOnly {
+	// reconciling reads every key of the source, it waits for a run that replicated all its partitions.
+	if config.DeleteMode != protos.QRepDeleteMode_QREP_DELETE_MODE_NONE && len(remainingPartitions) == 0 {
+		reconcileInterval := time.Duration(config.ReconcileIntervalSeconds) * time.Second
+		now := workflow.Now(ctx)
+		if now.Sub(state.LastReconciledAt) >= reconcileInterval {
+			if err = q.reconcileDeletes(ctx); err != nil {
+				return err
+			}
+			state.LastReconciledAt = now
+		}
+	}
+
+	if config.InitialCopyOnly && len(remainingPartitions) == 0 {
 		q.logger.Info("initial copy completed for peer flow - ", config.FlowJobName)
 		return nil
 	}
 
-	q.logger.Info("partitions processed - ", len(partitions.Partitions))
-	numPartitionsProcessed += len(partitions.Partitions)
-
-	if len(partitions.Partitions) > 0 {
-		lastPartition = partitions.Partitions[len(partitions.Partitions)-1]
-	}
+	q.logger.Info("partitions processed - ", numProcessed)
+	numPartitionsProcessed += numProcessed
 
 	s.AddDefault(func() {})
 
@@ -306,18 +482,36 @@ func QRepFlowWorkflow(
 		return nil
 	}
 
-	// sleep for a while and continue the workflow
-	err = workflow.Sleep(ctx, waitBetweenBatches)
-	if err != nil {
-		return fmt.Errorf("failed to sleep: %w", err)
+	if scheduleVersion == workflow.DefaultVersion {
+		err = workflow.Sleep(ctx, waitBetweenBatches)
+		if err != nil {
+			return fmt.Errorf("failed to sleep: %w", err)
+		}
+	} else if len(remainingPartitions) > 0 {
+		// the partitions left replicate at the start of the next window.
+		state.NextRunAt = schedule.Allowed(workflow.Now(ctx))
+	} else {
+		// the next run waits for its turn in the continued workflow.
+		state.NextRunAt, err = schedule.Next(workflow.Now(ctx), waitBetweenBatches)
+		if err != nil {
+			return fmt.Errorf("failed to schedule next run: %w", err)
+		}
 	}
+	running = false
 
 	workflow.GetLogger(ctx).Info("Continuing as new workflow",
 		"Last Partition", lastPartition,
-		"Number of Partitions Processed", numPartitionsProcessed)
+		"Number of Partitions Processed", numPartitionsProcessed,
+		"Next Run At", state.NextRunAt,
+		"Partitions Left", len(remainingPartitions))
 
 	// Continue the workflow with new state
-	return workflow.NewContinueAsNewError(ctx, QRepFlowWorkflow, config, lastPartition, numPartitionsProcessed)
+	var nextPendingPartitions *protos.QRepPartitionBatch
+	if len(remainingPartitions) > 0 {
+		nextPendingPartitions = &protos.QRepPartitionBatch{Partitions: remainingPartitions}
+	}
+	return workflow.NewContinueAsNewError(ctx, QRepFlowWorkflow, config, lastPartition,
+		numPartitionsProcessed, state, nextPendingPartitions)
 }
 
 // QRepPartitionWorkflow replicate a partition batch
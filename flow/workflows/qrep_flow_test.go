@@ -0,0 +1,116 @@
+package peerflow
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/PeerDB-io/peer-flow/activities"
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func testPartitions(n int) []*protos.QRepPartition {
+	partitions := make([]*protos.QRepPartition, 0, n)
+	for i := 1; i <= n; i++ {
+		partitions = append(partitions, &protos.QRepPartition{PartitionId: fmt.Sprintf("partition-%d", i)})
+	}
+	return partitions
+}
+
+// newRunWindowTestEnv returns a workflow environment starting at startTime, in which every
+// partition takes partitionDuration to replicate and the ids of the started partitions are recorded.
+func newRunWindowTestEnv(t *testing.T, startTime time.Time, partitionDuration time.Duration,
+	partitions []*protos.QRepPartition, startedPartitionIDs *[]string) *testsuite.TestWorkflowEnvironment {
+	t.Helper()
+	var suite testsuite.WorkflowTestSuite
+	env := suite.NewTestWorkflowEnvironment()
+	env.SetStartTime(startTime)
+	env.RegisterWorkflow(QRepFlowWorkflow)
+	env.RegisterWorkflow(QRepPartitionWorkflow)
+	env.RegisterActivity(&activities.FlowableActivity{})
+
+	env.OnActivity(flowable.SetupQRepMetadataTables, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(flowable.GetQRepPartitions, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+		&protos.QRepParitionResult{Partitions: partitions}, nil)
+	env.OnActivity(flowable.ConsolidateQRepPartitions, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	env.OnActivity(flowable.CleanupQRepFlow, mock.Anything, mock.Anything).Return(nil)
+	env.OnWorkflow(QRepPartitionWorkflow, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		After(partitionDuration).
+		Run(func(args mock.Arguments) {
+			for _, partition := range args.Get(2).(*protos.QRepPartitionBatch).Partitions {
+				*startedPartitionIDs = append(*startedPartitionIDs, partition.PartitionId)
+			}
+		}).
+		Return(nil)
+	return env
+}
+
+// continuedQRepFlowArgs returns the arguments the workflow continued as new with.
+func continuedQRepFlowArgs(t *testing.T, err error) (int, *QRepFlowState, *protos.QRepPartitionBatch) {
+	t.Helper()
+	var continueAsNewErr *workflow.ContinueAsNewError
+	require.True(t, errors.As(err, &continueAsNewErr), "expected continue as new, got %v", err)
+
+	var config *protos.QRepConfig
+	var lastPartition *protos.QRepPartition
+	var numPartitionsProcessed int
+	var state *QRepFlowState
+	var pendingPartitions *protos.QRepPartitionBatch
+	err = converter.GetDefaultDataConverter().FromPayloads(continueAsNewErr.Input, &config, &lastPartition,
+		&numPartitionsProcessed, &state, &pendingPartitions)
+	require.NoError(t, err)
+	return numPartitionsProcessed, state, pendingPartitions
+}
+
+func TestQRepFlowRunWindowClosesDuringRun(t *testing.T) {
+	startTime := time.Date(2023, 9, 1, 4, 0, 0, 0, time.UTC)
+	var startedPartitionIDs []string
+	env := newRunWindowTestEnv(t, startTime, 25*time.Minute, testPartitions(4), &startedPartitionIDs)
+
+	config := &protos.QRepConfig{
+		FlowJobName:        "test_run_window",
+		RunWindows:         "01:00-05:00",
+		MaxParallelWorkers: 1,
+	}
+	env.ExecuteWorkflow(QRepFlowWorkflow, config, nil, 0, nil, nil)
+	require.True(t, env.IsWorkflowCompleted())
+
+	// partitions start at 04:00, 04:25 and 04:50, the window is closed when the third one ends.
+	require.Equal(t, []string{"partition-1", "partition-2", "partition-3"}, startedPartitionIDs)
+	numPartitionsProcessed, state, pendingPartitions := continuedQRepFlowArgs(t, env.GetWorkflowError())
+	require.Equal(t, 3, numPartitionsProcessed)
+	require.Len(t, pendingPartitions.GetPartitions(), 1)
+	require.Equal(t, "partition-4", pendingPartitions.Partitions[0].PartitionId)
+	require.Equal(t, time.Date(2023, 9, 2, 1, 0, 0, 0, time.UTC), state.NextRunAt.UTC())
+}
+
+func TestQRepFlowRunWindowResumesPendingPartitions(t *testing.T) {
+	startTime := time.Date(2023, 9, 2, 0, 30, 0, 0, time.UTC)
+	var startedPartitionIDs []string
+	env := newRunWindowTestEnv(t, startTime, 25*time.Minute, testPartitions(2), &startedPartitionIDs)
+
+	config := &protos.QRepConfig{
+		FlowJobName:        "test_run_window",
+		RunWindows:         "01:00-05:00",
+		MaxParallelWorkers: 1,
+	}
+	state := &QRepFlowState{NextRunAt: time.Date(2023, 9, 2, 1, 0, 0, 0, time.UTC)}
+	pendingPartitions := &protos.QRepPartitionBatch{
+		Partitions: []*protos.QRepPartition{{PartitionId: "partition-pending"}},
+	}
+	env.ExecuteWorkflow(QRepFlowWorkflow, config, nil, 3, state, pendingPartitions)
+	require.True(t, env.IsWorkflowCompleted())
+
+	// the pending partition replicates when the window opens, before any new partitions are fetched.
+	require.Equal(t, []string{"partition-pending"}, startedPartitionIDs)
+	env.AssertNotCalled(t, "GetQRepPartitions", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	numPartitionsProcessed, _, nextPendingPartitions := continuedQRepFlowArgs(t, env.GetWorkflowError())
+	require.Equal(t, 4, numPartitionsProcessed)
+	require.Nil(t, nextPendingPartitions)
+}
@@ -6,8 +6,11 @@ import (
 	"time"
 
 	"github.com/PeerDB-io/peer-flow/activities"
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
 	"golang.org/x/exp/maps"
+	"google.golang.org/protobuf/proto"
 
 	"go.temporal.io/sdk/log"
 	"go.temporal.io/sdk/workflow"
@@ -68,23 +71,25 @@ func (s *SetupFlowExecution) checkConnectionsAndSetupMetadataTables(
 		return fmt.Errorf("failed to check source peer connection: %w", err)
 	}
 
-	// then check the destination peer connection
-	destConnStatusFuture := workflow.ExecuteActivity(ctx, flowable.CheckConnection, config.Destination)
-	var destConnStatus activities.CheckConnectionResult
-	if err := destConnStatusFuture.Get(ctx, &destConnStatus); err != nil {
-		return fmt.Errorf("failed to check destination peer connection: %w", err)
-	}
+	for _, destination := range utils.GetDestinations(config) {
+		// then check the destination peer connection
+		destConnStatusFuture := workflow.ExecuteActivity(ctx, flowable.CheckConnection, destination)
+		var destConnStatus activities.CheckConnectionResult
+		if err := destConnStatusFuture.Get(ctx, &destConnStatus); err != nil {
+			return fmt.Errorf("failed to check destination peer %s connection: %w", destination.Name, err)
+		}
 
-	s.logger.Info("ensuring metadata table exists - ", s.PeerFlowName)
+		s.logger.Info("ensuring metadata table exists - ", s.PeerFlowName, " on ", destination.Name)
 
-	// then setup the destination peer metadata tables
-	if destConnStatus.NeedsSetupMetadataTables {
-		fDst := workflow.ExecuteActivity(ctx, flowable.SetupMetadataTables, config.Destination)
-		if err := fDst.Get(ctx, nil); err != nil {
-			return fmt.Errorf("failed to setup destination peer metadata tables: %w", err)
+		// then setup the destination peer metadata tables
+		if destConnStatus.NeedsSetupMetadataTables {
+			fDst := workflow.ExecuteActivity(ctx, flowable.SetupMetadataTables, destination)
+			if err := fDst.Get(ctx, nil); err != nil {
+				return fmt.Errorf("failed to setup destination peer %s metadata tables: %w", destination.Name, err)
+			}
+		} else {
+			s.logger.Info("destination peer metadata tables already exist on ", destination.Name)
 		}
-	} else {
-		s.logger.Info("destination peer metadata tables already exist")
 	}
 
 	return nil
@@ -144,17 +149,19 @@ func (s *SetupFlowExecution) createRawTable(
 		StartToCloseTimeout: 5 * time.Minute,
 	})
 
-	// attempt to create the tables.
-	createRawTblInput := &protos.CreateRawTableInput{
-		PeerConnectionConfig: config.Destination,
-		FlowJobName:          s.PeerFlowName,
-		TableNameMapping:     config.TableNameMapping,
-		CdcSyncMode:          config.CdcSyncMode,
-	}
+	for _, destination := range utils.GetDestinations(config) {
+		// attempt to create the tables.
+		createRawTblInput := &protos.CreateRawTableInput{
+			PeerConnectionConfig: destination,
+			FlowJobName:          s.PeerFlowName,
+			TableNameMapping:     config.TableNameMapping,
+			CdcSyncMode:          config.CdcSyncMode,
+		}
 
-	rawTblFuture := workflow.ExecuteActivity(ctx, flowable.CreateRawTable, createRawTblInput)
-	if err := rawTblFuture.Get(ctx, nil); err != nil {
-		return fmt.Errorf("failed to create raw table: %w", err)
+		rawTblFuture := workflow.ExecuteActivity(ctx, flowable.CreateRawTable, createRawTblInput)
+		if err := rawTblFuture.Get(ctx, nil); err != nil {
+			return fmt.Errorf("failed to create raw table on %s: %w", destination.Name, err)
+		}
 	}
 
 	return nil
@@ -192,31 +199,90 @@ func (s *SetupFlowExecution) fetchTableSchemaAndSetupNormalizedTables(
 	sort.Strings(sortedSourceTables)
 
 	s.logger.Info("setting up normalized tables for peer flow - ", s.PeerFlowName)
+	fanInSourceColumn := utils.GetFanInSourceColumn(flowConnectionConfigs)
 	normalizedTableMapping := make(map[string]*protos.TableSchema)
 	for _, srcTableName := range sortedSourceTables {
 		tableSchema := tableNameSchemaMapping[srcTableName]
 		normalizedTableName := flowConnectionConfigs.TableNameMapping[srcTableName]
+		if fanInSourceColumn != "" {
+			fanInTableSchema, err := mergeFanInTableSchema(normalizedTableMapping[normalizedTableName],
+				tableSchema, fanInSourceColumn)
+			if err != nil {
+				return nil, fmt.Errorf("failed to fan-in %s into %s: %w", srcTableName, normalizedTableName, err)
+			}
+			tableSchema = fanInTableSchema
+		}
 		normalizedTableMapping[normalizedTableName] = tableSchema
 		s.logger.Info("normalized table schema: ", normalizedTableName, " -> ", tableSchema)
 	}
 
-	// now setup the normalized tables on the destination peer
-	setupConfig := &protos.SetupNormalizedTableBatchInput{
-		PeerConnectionConfig:   flowConnectionConfigs.Destination,
-		TableNameSchemaMapping: normalizedTableMapping,
-	}
+	// now setup the normalized tables on the destination peers
+	for _, destination := range utils.GetDestinations(flowConnectionConfigs) {
+		setupConfig := &protos.SetupNormalizedTableBatchInput{
+			PeerConnectionConfig:   destination,
+			TableNameSchemaMapping: normalizedTableMapping,
+			SoftDelete:             flowConnectionConfigs.SoftDelete,
+			SystemColumns:          flowConnectionConfigs.SystemColumns,
+			HistoryMode:            flowConnectionConfigs.HistoryMode,
+			SnowflakeTableOptions:  flowConnectionConfigs.SnowflakeTableOptions,
+			BigqueryTableOptions:   flowConnectionConfigs.BigqueryTableOptions,
+		}
 
-	future = workflow.ExecuteActivity(ctx, flowable.CreateNormalizedTable, setupConfig)
-	var createNormalizedTablesOutput *protos.SetupNormalizedTableBatchOutput
-	if err := future.Get(ctx, &createNormalizedTablesOutput); err != nil {
-		s.logger.Error("failed to create normalized tables: ", err)
-		return nil, fmt.Errorf("failed to create normalized tables: %w", err)
+		future = workflow.ExecuteActivity(ctx, flowable.CreateNormalizedTable, setupConfig)
+		var createNormalizedTablesOutput *protos.SetupNormalizedTableBatchOutput
+		if err := future.Get(ctx, &createNormalizedTablesOutput); err != nil {
+			s.logger.Error("failed to create normalized tables on ", destination.Name, ": ", err)
+			return nil, fmt.Errorf("failed to create normalized tables on %s: %w", destination.Name, err)
+		}
 	}
 
 	s.logger.Info("finished setting up normalized tables for peer flow - ", s.PeerFlowName)
 	return normalizedTableMapping, nil
 }
 
+// mergeFanInTableSchema combines the schema of a source table with the schema of the fan-in
+// destination table built so far. The source identifier column is added and becomes part of
+// the primary key, source tables sharing a destination must agree on the primary key and column types.
+func mergeFanInTableSchema(
+	existing *protos.TableSchema,
+	incoming *protos.TableSchema,
+	sourceIdentifierColumn string,
+) (*protos.TableSchema, error) {
+	if _, ok := incoming.Columns[sourceIdentifierColumn]; ok {
+		return nil, fmt.Errorf("source identifier column %s already exists in source table", sourceIdentifierColumn)
+	}
+
+	if existing == nil {
+		merged := proto.Clone(incoming).(*protos.TableSchema)
+		merged.Columns[sourceIdentifierColumn] = string(qvalue.QValueKindString)
+		merged.SourceIdentifierColumn = sourceIdentifierColumn
+		return merged, nil
+	}
+
+	if existing.PrimaryKeyColumn != incoming.PrimaryKeyColumn {
+		return nil, fmt.Errorf("primary key column %s does not match primary key column %s of other source tables",
+			incoming.PrimaryKeyColumn, existing.PrimaryKeyColumn)
+	}
+	for columnName, columnType := range incoming.Columns {
+		existingType, ok := existing.Columns[columnName]
+		if !ok {
+			existing.Columns[columnName] = columnType
+		} else if existingType != columnType {
+			return nil, fmt.Errorf("column %s has type %s, but %s in other source tables",
+				columnName, columnType, existingType)
+		}
+	}
+	for columnName, comment := range incoming.ColumnComments {
+		if _, ok := existing.ColumnComments[columnName]; !ok {
+			if existing.ColumnComments == nil {
+				existing.ColumnComments = make(map[string]string)
+			}
+			existing.ColumnComments[columnName] = comment
+		}
+	}
+	return existing, nil
+}
+
 // executeSetupFlow executes the setup flow.
 func (s *SetupFlowExecution) executeSetupFlow(
 	ctx workflow.Context,
@@ -256,6 +322,25 @@ func SetupFlowWorkflow(ctx workflow.Context,
 		Progress:     []string{},
 	}
 
+	// resolve destination table names before anything is created on the peers.
+	tableNameMapping, err := utils.RewriteTableNameMapping(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve destination table names: %w", err)
+	}
+	config.TableNameMapping = tableNameMapping
+
+	// every destination keeps its checkpoint under the mirror name, so they have to be distinct peers.
+	destinationNames := make(map[string]bool)
+	for _, destination := range utils.GetDestinations(config) {
+		if destinationNames[destination.Name] {
+			return nil, fmt.Errorf("destination peer %s is listed more than once", destination.Name)
+		}
+		destinationNames[destination.Name] = true
+		if config.DirectApply && destination.Type != protos.DBType_POSTGRES {
+			return nil, fmt.Errorf("direct apply is not supported for destination peer %s", destination.Name)
+		}
+	}
+
 	// create the setup flow execution
 	setupFlowExecution := NewSetupFlowExecution(ctx, setupFlowState)
 
@@ -0,0 +1,135 @@
+package peerflow
+
+import (
+	"testing"
+
+	"github.com/PeerDB-io/peer-flow/generated/protos"
+	"github.com/PeerDB-io/peer-flow/model/qvalue"
+)
+
+func TestMergeFanInTableSchema(t *testing.T) {
+	const sourceColumn = "_peerdb_source_table"
+	users := func() *protos.TableSchema {
+		return &protos.TableSchema{
+			TableIdentifier:  "tenant1.users",
+			PrimaryKeyColumn: "id",
+			Columns: map[string]string{
+				"id":   string(qvalue.QValueKindInt64),
+				"name": string(qvalue.QValueKindString),
+			},
+		}
+	}
+
+	testCases := []struct {
+		name            string
+		existing        *protos.TableSchema
+		incoming        *protos.TableSchema
+		expectedColumns map[string]string
+		expectError     bool
+	}{
+		{
+			name:     "first source table",
+			incoming: users(),
+			expectedColumns: map[string]string{
+				"id":         string(qvalue.QValueKindInt64),
+				"name":       string(qvalue.QValueKindString),
+				sourceColumn: string(qvalue.QValueKindString),
+			},
+		},
+		{
+			name: "extra column in later source table",
+			existing: func() *protos.TableSchema {
+				schema, _ := mergeFanInTableSchema(nil, users(), sourceColumn)
+				return schema
+			}(),
+			incoming: func() *protos.TableSchema {
+				schema := users()
+				schema.Columns["email"] = string(qvalue.QValueKindString)
+				return schema
+			}(),
+			expectedColumns: map[string]string{
+				"id":         string(qvalue.QValueKindInt64),
+				"name":       string(qvalue.QValueKindString),
+				"email":      string(qvalue.QValueKindString),
+				sourceColumn: string(qvalue.QValueKindString),
+			},
+		},
+		{
+			name: "conflicting column types",
+			existing: func() *protos.TableSchema {
+				schema, _ := mergeFanInTableSchema(nil, users(), sourceColumn)
+				return schema
+			}(),
+			incoming: func() *protos.TableSchema {
+				schema := users()
+				schema.Columns["name"] = string(qvalue.QValueKindJSON)
+				return schema
+			}(),
+			expectError: true,
+		},
+		{
+			name: "conflicting primary keys",
+			existing: func() *protos.TableSchema {
+				schema, _ := mergeFanInTableSchema(nil, users(), sourceColumn)
+				return schema
+			}(),
+			incoming: func() *protos.TableSchema {
+				schema := users()
+				schema.PrimaryKeyColumn = "name"
+				return schema
+			}(),
+			expectError: true,
+		},
+		{
+			name: "source identifier column in source table",
+			incoming: func() *protos.TableSchema {
+				schema := users()
+				schema.Columns[sourceColumn] = string(qvalue.QValueKindString)
+				return schema
+			}(),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		merged, err := mergeFanInTableSchema(tc.existing, tc.incoming, sourceColumn)
+		if tc.expectError {
+			if err == nil {
+				t.Errorf("%s: expected an error", tc.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tc.name, err)
+			continue
+		}
+		if merged.SourceIdentifierColumn != sourceColumn {
+			t.Errorf("%s: expected source identifier column %s, got %s",
+				tc.name, sourceColumn, merged.SourceIdentifierColumn)
+		}
+		if len(merged.Columns) != len(tc.expectedColumns) {
+			t.Errorf("%s: expected columns %v, got %v", tc.name, tc.expectedColumns, merged.Columns)
+			continue
+		}
+		for column, columnType := range tc.expectedColumns {
+			if merged.Columns[column] != columnType {
+				t.Errorf("%s: expected column %s to have type %s, got %s",
+					tc.name, column, columnType, merged.Columns[column])
+			}
+		}
+	}
+}
+
+func TestMergeFanInTableSchemaDoesNotModifyIncoming(t *testing.T) {
+	incoming := &protos.TableSchema{
+		TableIdentifier:  "tenant1.users",
+		PrimaryKeyColumn: "id",
+		Columns:          map[string]string{"id": string(qvalue.QValueKindInt64)},
+	}
+	if _, err := mergeFanInTableSchema(nil, incoming, "_peerdb_source_table"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := incoming.Columns["_peerdb_source_table"]; ok {
+		t.Error("expected the source table schema to be left unchanged")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/PeerDB-io/peer-flow/concurrency"
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"github.com/PeerDB-io/peer-flow/shared"
 	"github.com/google/uuid"
@@ -81,12 +82,14 @@ func (s *SnapshotFlowExecution) cloneTable(
 	snapshotName string,
 	sourceTableName string,
 	destinationTableName string,
+	destinationPeer *protos.Peer,
 ) error {
 	flowName := s.config.FlowJobName
 	srcName := sourceTableName
 	dstName := destinationTableName
 	childWorkflowIDSideEffect := workflow.SideEffect(childCtx, func(ctx workflow.Context) interface{} {
-		childWorkflowID := fmt.Sprintf("clone_%s_%s_%s", flowName, dstName, uuid.New().String())
+		childWorkflowID := fmt.Sprintf("clone_%s_%s_%s_%s", flowName, destinationPeer.Name, dstName,
+			uuid.New().String())
 		reg := regexp.MustCompile("[^a-zA-Z0-9]+")
 		return reg.ReplaceAllString(childWorkflowID, "_")
 	})
@@ -124,6 +127,11 @@ func (s *SnapshotFlowExecution) cloneTable(
 	sourcePostgres.GetPostgresConfig().TransactionSnapshot = snapshotName
 
 	query := fmt.Sprintf("SELECT * FROM %s WHERE ctid BETWEEN {{.start}} AND {{.end}}", srcName)
+	// fan-in tables also need the source table identifier for every row
+	if fanInSourceColumn := utils.GetFanInSourceColumn(s.config); fanInSourceColumn != "" {
+		query = fmt.Sprintf("SELECT *,%s AS %s FROM %s WHERE ctid BETWEEN {{.start}} AND {{.end}}",
+			utils.QuoteLiteral(srcName), utils.QuoteIdentifier(fanInSourceColumn), srcName)
+	}
 
 	numWorkers := uint32(8)
 	if s.config.SnapshotMaxParallelWorkers > 0 {
@@ -138,7 +146,7 @@ func (s *SnapshotFlowExecution) cloneTable(
 	config := &protos.QRepConfig{
 		FlowJobName:                childWorkflowID,
 		SourcePeer:                 sourcePostgres,
-		DestinationPeer:            s.config.Destination,
+		DestinationPeer:            destinationPeer,
 		Query:                      query,
 		WatermarkColumn:            "ctid",
 		WatermarkTable:             srcName,
@@ -152,7 +160,7 @@ func (s *SnapshotFlowExecution) cloneTable(
 
 	numPartitionsProcessed := 0
 
-	boundSelector.SpawnChild(childCtx, QRepFlowWorkflow, config, lastPartition, numPartitionsProcessed)
+	boundSelector.SpawnChild(childCtx, QRepFlowWorkflow, config, lastPartition, numPartitionsProcessed, nil, nil)
 	return nil
 }
 
@@ -168,22 +176,25 @@ func (s *SnapshotFlowExecution) cloneTables(
 	srcTables := maps.Keys(s.config.TableNameMapping)
 	sort.Strings(srcTables)
 
-	boundSelector := concurrency.NewBoundSelector(maxParallelClones, len(srcTables), ctx)
-
-	for _, srcTbl := range srcTables {
-		source := srcTbl
-		destination := s.config.TableNameMapping[source]
-		snapshotName := slotInfo.SnapshotName
-		logrus.WithFields(logrus.Fields{
-			"snapshotName": snapshotName,
-		}).Infof(
-			"Cloning table with source table %s and destination table name %s",
-			source, destination,
-		)
-		err := s.cloneTable(boundSelector, ctx, snapshotName, source, destination)
-		if err != nil {
-			s.logger.Error("failed to start clone child workflow: ", err)
-			continue
+	destinationPeers := utils.GetDestinations(s.config)
+	boundSelector := concurrency.NewBoundSelector(maxParallelClones, len(srcTables)*len(destinationPeers), ctx)
+
+	for _, destinationPeer := range destinationPeers {
+		for _, srcTbl := range srcTables {
+			source := srcTbl
+			destination := s.config.TableNameMapping[source]
+			snapshotName := slotInfo.SnapshotName
+			logrus.WithFields(logrus.Fields{
+				"snapshotName": snapshotName,
+			}).Infof(
+				"Cloning table with source table %s and destination table name %s on peer %s",
+				source, destination, destinationPeer.Name,
+			)
+			err := s.cloneTable(boundSelector, ctx, snapshotName, source, destination, destinationPeer)
+			if err != nil {
+				s.logger.Error("failed to start clone child workflow: ", err)
+				continue
+			}
 		}
 	}
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/PeerDB-io/peer-flow/connectors/utils"
 	"github.com/PeerDB-io/peer-flow/generated/protos"
 	"github.com/PeerDB-io/peer-flow/model"
 	"go.temporal.io/sdk/log"
@@ -61,23 +62,39 @@ func (s *SyncFlowExecution) executeSyncFlow(
 		StartToCloseTimeout: 1 * time.Minute,
 	})
 
-	// execute GetLastSyncedID on destination peer
-	lastSyncInput := &protos.GetLastSyncedIDInput{
-		PeerConnectionConfig: config.Destination,
-		FlowJobName:          s.PeerFlowName,
+	// execute GetLastSyncedID on every destination peer, the slot can only move past
+	// what the slowest destination has synced.
+	destinations := utils.GetDestinations(config)
+	lastSyncFutures := make([]workflow.Future, 0, len(destinations))
+	for _, destination := range destinations {
+		lastSyncInput := &protos.GetLastSyncedIDInput{
+			PeerConnectionConfig: destination,
+			FlowJobName:          s.PeerFlowName,
+		}
+		lastSyncFutures = append(lastSyncFutures,
+			workflow.ExecuteActivity(syncMetaCtx, flowable.GetLastSyncedID, lastSyncInput))
 	}
 
-	lastSyncFuture := workflow.ExecuteActivity(syncMetaCtx, flowable.GetLastSyncedID, lastSyncInput)
 	var dstSyncState *protos.LastSyncState
-	if err := lastSyncFuture.Get(syncMetaCtx, &dstSyncState); err != nil {
-		return nil, fmt.Errorf("failed to get last synced ID from destination peer: %w", err)
-	}
-
-	if dstSyncState != nil {
-		msg := fmt.Sprintf("last synced ID from destination peer - %d\n", dstSyncState.Checkpoint)
-		s.logger.Info(msg)
-	} else {
-		s.logger.Info("no last synced ID from destination peer")
+	destinationSyncStates := make(map[string]*protos.LastSyncState, len(destinations))
+	for i, destination := range destinations {
+		var syncState *protos.LastSyncState
+		if err := lastSyncFutures[i].Get(syncMetaCtx, &syncState); err != nil {
+			return nil, fmt.Errorf("failed to get last synced ID from destination peer %s: %w",
+				destination.Name, err)
+		}
+
+		if syncState != nil {
+			msg := fmt.Sprintf("last synced ID from destination peer %s - %d\n", destination.Name, syncState.Checkpoint)
+			s.logger.Info(msg)
+			destinationSyncStates[destination.Name] = syncState
+		} else {
+			s.logger.Info("no last synced ID from destination peer ", destination.Name)
+		}
+
+		if i == 0 || (dstSyncState != nil && (syncState == nil || syncState.Checkpoint < dstSyncState.Checkpoint)) {
+			dstSyncState = syncState
+		}
 	}
 
 	startFlowCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
@@ -92,6 +109,7 @@ func (s *SyncFlowExecution) executeSyncFlow(
 		FlowConnectionConfigs: config,
 		LastSyncState:         dstSyncState,
 		SyncFlowOptions:       opts,
+		DestinationSyncStates: destinationSyncStates,
 	}
 	fStartFlow := workflow.ExecuteActivity(startFlowCtx, flowable.StartFlow, startFlowInput)
 
@@ -10,8 +10,9 @@ use anyhow::Context;
 use pt::{
     flow_model::{FlowJob, FlowJobTableMapping, FlowSyncMode, QRepFlowJob},
     peerdb_peers::{
-        peer::Config, BigqueryConfig, DbType, EventHubConfig, MongoConfig, Peer, PostgresConfig,
-        S3Config, SnowflakeConfig, SqlServerConfig,
+        peer::Config, BigqueryConfig, BigqueryStorageWriteMode, DbType, EventEncoding,
+        EventEnvelope, EventHubConfig, MongoConfig, Peer, PostgresConfig, S3Config,
+        S3ConnectionConfig, SnowflakeConfig, SqlServerConfig,
     },
 };
 use qrep::process_options;
@@ -230,10 +231,126 @@ impl StatementAnalyzer for PeerDDLAnalyzer {
                             _ => false,
                         };
 
+                        let destination_table_name_template = match raw_options
+                            .remove("destination_table_name_template")
+                        {
+                            Some(sqlparser::ast::Value::SingleQuotedString(s)) => Some(s.clone()),
+                            _ => None,
+                        };
+
+                        let destination_table_name_regex = match raw_options
+                            .remove("destination_table_name_regex")
+                        {
+                            Some(sqlparser::ast::Value::SingleQuotedString(s)) => Some(s.clone()),
+                            _ => None,
+                        };
+
+                        let destination_table_name_replacement = match raw_options
+                            .remove("destination_table_name_replacement")
+                        {
+                            Some(sqlparser::ast::Value::SingleQuotedString(s)) => Some(s.clone()),
+                            _ => None,
+                        };
+
+                        let fan_in = match raw_options.remove("fan_in") {
+                            Some(sqlparser::ast::Value::Boolean(b)) => *b,
+                            _ => false,
+                        };
+
+                        let fan_in_source_column = match raw_options.remove("fan_in_source_column") {
+                            Some(sqlparser::ast::Value::SingleQuotedString(s)) => Some(s.clone()),
+                            _ => None,
+                        };
+
+                        let synced_at_column = match raw_options.remove("synced_at_column") {
+                            Some(sqlparser::ast::Value::Boolean(b)) => *b,
+                            _ => false,
+                        };
+
+                        let source_lsn_column = match raw_options.remove("source_lsn_column") {
+                            Some(sqlparser::ast::Value::Boolean(b)) => *b,
+                            _ => false,
+                        };
+
+                        let commit_ts_column = match raw_options.remove("commit_ts_column") {
+                            Some(sqlparser::ast::Value::Boolean(b)) => *b,
+                            _ => false,
+                        };
+
+                        let history_mode = match raw_options.remove("history_mode") {
+                            Some(sqlparser::ast::Value::Boolean(b)) => *b,
+                            _ => false,
+                        };
+
+                        let direct_apply = match raw_options.remove("direct_apply") {
+                            Some(sqlparser::ast::Value::Boolean(b)) => *b,
+                            _ => false,
+                        };
+
+                        let cdc_output_format = match raw_options.remove("cdc_output_format") {
+                            Some(sqlparser::ast::Value::SingleQuotedString(s)) => {
+                                Some(s.to_lowercase())
+                            }
+                            _ => None,
+                        };
+
+                        let dead_letter = match raw_options.remove("dead_letter") {
+                            Some(sqlparser::ast::Value::Boolean(b)) => *b,
+                            _ => false,
+                        };
+
+                        let dead_letter_s3_path = match raw_options.remove("dead_letter_s3_path") {
+                            Some(sqlparser::ast::Value::SingleQuotedString(s)) => Some(s.clone()),
+                            _ => None,
+                        };
+
+                        let dead_letter_max_errors = match raw_options
+                            .remove("dead_letter_max_errors")
+                        {
+                            Some(sqlparser::ast::Value::Number(n, _)) => Some(n.parse::<u32>()?),
+                            _ => None,
+                        };
+
+                        let dead_letter_max_error_percent = match raw_options
+                            .remove("dead_letter_max_error_percent")
+                        {
+                            Some(sqlparser::ast::Value::Number(n, _)) => Some(n.parse::<u32>()?),
+                            _ => None,
+                        };
+
+                        let snowflake_table_options =
+                            match raw_options.remove("snowflake_table_options") {
+                                Some(sqlparser::ast::Value::SingleQuotedString(s)) => {
+                                    flow_rs::grpc::parse_snowflake_table_options(s)?;
+                                    Some(s.clone())
+                                }
+                                _ => None,
+                            };
+
+                        let bigquery_table_options =
+                            match raw_options.remove("bigquery_table_options") {
+                                Some(sqlparser::ast::Value::SingleQuotedString(s)) => {
+                                    flow_rs::grpc::parse_bigquery_table_options(s)?;
+                                    Some(s.clone())
+                                }
+                                _ => None,
+                            };
+
+                        let additional_target_peers: Vec<String> =
+                            match raw_options.remove("additional_destinations") {
+                                Some(sqlparser::ast::Value::SingleQuotedString(s)) => s
+                                    .split(',')
+                                    .map(|peer| peer.trim().to_lowercase())
+                                    .filter(|peer| !peer.is_empty())
+                                    .collect(),
+                                _ => vec![],
+                            };
+
                         let flow_job = FlowJob {
                             name: cdc.mirror_name.to_string().to_lowercase(),
                             source_peer: cdc.source_peer.to_string().to_lowercase(),
                             target_peer: cdc.target_peer.to_string().to_lowercase(),
+                            additional_target_peers,
                             table_mappings: flow_job_table_mappings,
                             description: "".to_string(), // TODO: add description
                             do_initial_copy,
@@ -246,7 +363,24 @@ impl StatementAnalyzer for PeerDDLAnalyzer {
                             cdc_sync_mode,
                             cdc_staging_path,
                             soft_delete,
-                            replication_slot_name
+                            replication_slot_name,
+                            destination_table_name_template,
+                            destination_table_name_regex,
+                            destination_table_name_replacement,
+                            fan_in,
+                            fan_in_source_column,
+                            synced_at_column,
+                            source_lsn_column,
+                            commit_ts_column,
+                            history_mode,
+                            direct_apply,
+                            cdc_output_format,
+                            dead_letter,
+                            dead_letter_s3_path,
+                            dead_letter_max_errors,
+                            dead_letter_max_error_percent,
+                            snowflake_table_options,
+                            bigquery_table_options,
                         };
 
                         // Error reporting
@@ -258,6 +392,33 @@ impl StatementAnalyzer for PeerDDLAnalyzer {
                             ));
                         }
 
+                        for (i, peer) in flow_job.additional_target_peers.iter().enumerate() {
+                            if *peer == flow_job.target_peer
+                                || flow_job.additional_target_peers[..i].contains(peer)
+                            {
+                                return Err(anyhow::anyhow!(
+                                    "additional_destinations cannot repeat destination peer {}.",
+                                    peer
+                                ));
+                            }
+                        }
+
+                        if let Some(format) = &flow_job.cdc_output_format {
+                            if format != "avro" && format != "parquet" {
+                                return Err(anyhow::anyhow!(
+                                    "cdc_output_format must be one of avro, parquet."
+                                ));
+                            }
+                        }
+
+                        if let Some(percent) = flow_job.dead_letter_max_error_percent {
+                            if percent > 100 {
+                                return Err(anyhow::anyhow!(
+                                    "dead_letter_max_error_percent must be between 0 and 100."
+                                ));
+                            }
+                        }
+
                         Ok(Some(PeerDDL::CreateMirrorForCDC { flow_job }))
                     }
                     Select(select) => {
@@ -381,6 +542,11 @@ fn parse_db_options(
                 .ok_or_else(|| anyhow::anyhow!("missing private_key option for bigquery"))?;
             pem::parse(pem_str.as_bytes())
                 .map_err(|err| anyhow::anyhow!("unable to parse private_key: {:?}", err))?;
+            let storage_write_mode = match opts.get("storage_write_mode").map(|s| s.as_str()) {
+                None | Some("committed") => BigqueryStorageWriteMode::Committed,
+                Some("pending") => BigqueryStorageWriteMode::Pending,
+                Some(other) => anyhow::bail!("unsupported storage_write_mode {}", other),
+            };
             let bq_config = BigqueryConfig {
                 auth_type: opts
                     .remove("type")
@@ -415,6 +581,7 @@ fn parse_db_options(
                 dataset_id: opts
                     .remove("dataset_id")
                     .ok_or_else(|| anyhow::anyhow!("missing dataset_id in peer options"))?,
+                storage_write_mode: storage_write_mode as i32,
             };
             let config = Config::BigqueryConfig(bq_config);
             Some(config)
@@ -453,6 +620,19 @@ fn parse_db_options(
                     .parse::<u64>()
                     .context("unable to parse query_timeout")?,
                 s3_integration: s3_int,
+                staging_s3_connection: parse_s3_connection_options(&opts, "staging_s3_")?,
+                stage_file_size_mb: match opts.get("stage_file_size_mb") {
+                    Some(v) => v
+                        .parse::<u32>()
+                        .context("unable to parse stage_file_size_mb")?,
+                    None => 0,
+                },
+                stage_upload_parallelism: match opts.get("stage_upload_parallelism") {
+                    Some(v) => v
+                        .parse::<u32>()
+                        .context("unable to parse stage_upload_parallelism")?,
+                    None => 0,
+                },
             };
             let config = Config::SnowflakeConfig(snowflake_config);
             Some(config)
@@ -537,6 +717,32 @@ fn parse_db_options(
                     _ => (),
                 };
             }
+            let envelope = match opts.get("envelope").map(|s| s.as_str()) {
+                None | Some("none") => EventEnvelope::None,
+                Some("debezium") => EventEnvelope::Debezium,
+                Some("cloudevents") => EventEnvelope::Cloudevents,
+                Some(other) => anyhow::bail!("unsupported envelope {}", other),
+            };
+            let encoding = match opts.get("encoding").map(|s| s.as_str()) {
+                None | Some("json") => EventEncoding::Json,
+                Some("avro") => EventEncoding::Avro,
+                Some("protobuf") => EventEncoding::Protobuf,
+                Some(other) => anyhow::bail!("unsupported encoding {}", other),
+            };
+            let parse_u32 = |name: &str| -> anyhow::Result<u32> {
+                match opts.get(name) {
+                    Some(v) => v
+                        .parse::<u32>()
+                        .with_context(|| format!("unable to parse {}", name)),
+                    None => Ok(0),
+                }
+            };
+            let disable_hub_creation = match opts.get("disable_hub_creation") {
+                Some(v) => v
+                    .parse::<bool>()
+                    .context("unable to parse disable_hub_creation")?,
+                None => false,
+            };
             let eventhub_config = EventHubConfig {
                 namespace: opts
                     .get("namespace")
@@ -551,6 +757,25 @@ fn parse_db_options(
                     .context("location not specified")?
                     .to_string(),
                 metadata_db: Some(metadata_db),
+                partition_key_column: opts
+                    .get("partition_key_column")
+                    .cloned()
+                    .unwrap_or_default(),
+                envelope: envelope as i32,
+                encoding: encoding as i32,
+                schema_registry_url: opts.get("schema_registry_url").cloned().unwrap_or_default(),
+                schema_registry_username: opts
+                    .get("schema_registry_username")
+                    .cloned()
+                    .unwrap_or_default(),
+                schema_registry_password: opts
+                    .get("schema_registry_password")
+                    .cloned()
+                    .unwrap_or_default(),
+                hub_routes: opts.get("hub_routes").cloned().unwrap_or_default(),
+                partition_count: parse_u32("partition_count")?,
+                message_retention_days: parse_u32("message_retention_days")?,
+                disable_hub_creation,
             };
             let config = Config::EventhubConfig(eventhub_config);
             Some(config)
@@ -561,6 +786,7 @@ fn parse_db_options(
                     .get("url")
                     .context("S3 bucket url not specified")?
                     .to_string(),
+                connection: parse_s3_connection_options(&opts, "")?,
             };
             let config = Config::S3Config(s3_config);
             Some(config)
@@ -591,3 +817,35 @@ fn parse_db_options(
 
     Ok(config)
 }
+
+// parses the options to reach an S3 bucket, all of them are optional and the flow
+// worker falls back to its AWS_* environment variables when they are not set.
+fn parse_s3_connection_options(
+    opts: &HashMap<String, String>,
+    prefix: &str,
+) -> anyhow::Result<Option<S3ConnectionConfig>> {
+    let get = |name: &str| {
+        opts.get(&format!("{}{}", prefix, name))
+            .cloned()
+            .unwrap_or_default()
+    };
+    let force_path_style = match opts.get(&format!("{}force_path_style", prefix)) {
+        Some(v) => v
+            .parse::<bool>()
+            .context("unable to parse force_path_style")?,
+        None => false,
+    };
+
+    let connection = S3ConnectionConfig {
+        access_key_id: get("access_key_id"),
+        secret_access_key: get("secret_access_key"),
+        role_arn: get("role_arn"),
+        region: get("region"),
+        endpoint: get("endpoint"),
+        force_path_style,
+    };
+    if connection == S3ConnectionConfig::default() {
+        return Ok(None);
+    }
+    Ok(Some(connection))
+}
@@ -60,7 +60,7 @@ lazy_static::lazy_static! {
             name: "sync_data_format",
             default_val: Some("default"),
             required: false,
-            accepted_values: Some(vec!["default", "avro"]),
+            accepted_values: Some(vec!["default", "avro", "storage_write"]),
         },
         QRepOptionType::String {
             name: "staging_path",
@@ -68,6 +68,42 @@ lazy_static::lazy_static! {
             required: false,
             accepted_values: None,
         },
+        QRepOptionType::String {
+            name: "output_format",
+            default_val: Some("avro"),
+            required: false,
+            accepted_values: Some(vec!["avro", "parquet"]),
+        },
+        QRepOptionType::String {
+            name: "output_compression",
+            default_val: None,
+            required: false,
+            accepted_values: Some(vec!["snappy", "zstd", "gzip", "deflate", "none"]),
+        },
+        QRepOptionType::String {
+            name: "delete_mode",
+            default_val: Some("none"),
+            required: false,
+            accepted_values: Some(vec!["none", "hard", "soft"]),
+        },
+        QRepOptionType::String {
+            name: "schedule_cron",
+            default_val: None,
+            required: false,
+            accepted_values: None,
+        },
+        QRepOptionType::String {
+            name: "run_windows",
+            default_val: None,
+            required: false,
+            accepted_values: None,
+        },
+        QRepOptionType::String {
+            name: "soft_delete_column",
+            default_val: None,
+            required: false,
+            accepted_values: None,
+        },
         QRepOptionType::Int {
             name: "parallelism",
             min_value: Some(1),
@@ -98,6 +134,36 @@ lazy_static::lazy_static! {
             default_value: 0,
             required: false,
         },
+        QRepOptionType::Int {
+            name: "parquet_row_group_size",
+            min_value: Some(0),
+            default_value: 0,
+            required: false,
+        },
+        QRepOptionType::Int {
+            name: "target_file_size_bytes",
+            min_value: Some(0),
+            default_value: 0,
+            required: false,
+        },
+        QRepOptionType::Int {
+            name: "num_objects_per_partition",
+            min_value: Some(0),
+            default_value: 0,
+            required: false,
+        },
+        QRepOptionType::Int {
+            name: "num_key_partitions",
+            min_value: Some(0),
+            default_value: 0,
+            required: false,
+        },
+        QRepOptionType::Int {
+            name: "reconcile_interval_seconds",
+            min_value: Some(0),
+            default_value: 0,
+            required: false,
+        },
         QRepOptionType::Boolean {
             name: "initial_copy_only",
             default_value: false,
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS peerdb_stats.dead_letters (
+    flow_name TEXT NOT NULL,
+    destination TEXT NOT NULL,
+    table_name TEXT NOT NULL,
+    lsn NUMERIC NOT NULL,
+    record TEXT NOT NULL,
+    error TEXT NOT NULL,
+    failed_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (flow_name, destination, lsn)
+);
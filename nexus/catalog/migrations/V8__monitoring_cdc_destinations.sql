@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS peerdb_stats.cdc_flow_destinations (
+    flow_name TEXT NOT NULL,
+    destination TEXT NOT NULL,
+    latest_lsn_at_target NUMERIC NOT NULL,
+    latest_batch_id BIGINT NOT NULL,
+    PRIMARY KEY (flow_name, destination)
+);
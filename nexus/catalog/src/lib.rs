@@ -51,6 +51,7 @@ pub struct WorkflowDetails {
     pub workflow_id: String,
     pub source_peer: pt::peerdb_peers::Peer,
     pub destination_peer: pt::peerdb_peers::Peer,
+    pub additional_destination_peers: Vec<pt::peerdb_peers::Peer>,
 }
 
 impl CatalogConfig {
@@ -359,14 +360,24 @@ impl Catalog {
             .get_peer_id_i32(&job.target_peer)
             .await
             .context("unable to get destination peer id")?;
+        for peer_name in &job.additional_target_peers {
+            self.get_peer_id_i32(peer_name).await.with_context(|| {
+                format!("unable to get additional destination peer {}", peer_name)
+            })?;
+        }
+        // the additional destinations are kept by name so that dropping the mirror can clean them up.
+        let flow_metadata = serde_json::json!({
+            "additional_destination_peers": job.additional_target_peers,
+        });
 
         let stmt = self
             .pg
             .prepare_typed(
                 "INSERT INTO flows (name, source_peer, destination_peer, description,
-                     source_table_identifier, destination_table_identifier) VALUES ($1, $2, $3, $4, $5, $6)",
+                     source_table_identifier, destination_table_identifier, flow_metadata)
+                     VALUES ($1, $2, $3, $4, $5, $6, $7)",
                 &[types::Type::TEXT, types::Type::INT4, types::Type::INT4, types::Type::TEXT,
-                 types::Type::TEXT, types::Type::TEXT],
+                 types::Type::TEXT, types::Type::TEXT, types::Type::JSONB],
             )
             .await?;
 
@@ -392,6 +403,7 @@ impl Catalog {
                                 destination_peer_id,
                             )
                             .await?,
+                        &flow_metadata,
                     ],
                 )
                 .await?;
@@ -499,7 +511,7 @@ impl Catalog {
         let rows = self
             .pg
             .query(
-                "SELECT workflow_id, source_peer, destination_peer FROM flows WHERE NAME = $1",
+                "SELECT workflow_id, source_peer, destination_peer, flow_metadata FROM flows WHERE NAME = $1",
                 &[&flow_job_name],
             )
             .await?;
@@ -515,6 +527,7 @@ impl Catalog {
         let workflow_id: String = first_row.get(0);
         let source_peer_id: i32 = first_row.get(1);
         let destination_peer_id: i32 = first_row.get(2);
+        let flow_metadata: Option<serde_json::Value> = first_row.get(3);
 
         let source_peer = self
             .get_peer_by_id(source_peer_id)
@@ -525,10 +538,26 @@ impl Catalog {
             .await
             .context("unable to get destination peer")?;
 
+        let mut additional_destination_peers = vec![];
+        let additional_peer_names = flow_metadata
+            .as_ref()
+            .and_then(|metadata| metadata.get("additional_destination_peers"))
+            .and_then(|peers| peers.as_array());
+        for peer_name in additional_peer_names.into_iter().flatten() {
+            if let Some(peer_name) = peer_name.as_str() {
+                let peer = self
+                    .get_peer(peer_name)
+                    .await
+                    .context("unable to get additional destination peer")?;
+                additional_destination_peers.push(peer);
+            }
+        }
+
         Ok(Some(WorkflowDetails {
             workflow_id,
             source_peer,
             destination_peer,
+            additional_destination_peers,
         }))
     }
 
@@ -45,6 +45,44 @@ async fn connect_with_retries(grpc_endpoint: String) -> anyhow::Result<tonic::tr
     }
 }
 
+/// Parses the snowflake_table_options of a mirror, a JSON object of SnowflakeTableOptions keyed by
+/// destination table name. data_retention_days keeps the default of the schema when not set.
+pub fn parse_snowflake_table_options(
+    options: &str,
+) -> anyhow::Result<HashMap<String, pt::peerdb_flow::SnowflakeTableOptions>> {
+    let table_options: HashMap<String, pt::peerdb_flow::SnowflakeTableOptions> =
+        serde_json::from_str(options)
+            .context("snowflake_table_options must be a JSON object keyed by table name")?;
+    for (table, options) in &table_options {
+        if options.data_retention_days.map_or(false, |days| days < 0) {
+            return Err(anyhow::anyhow!(
+                "table {} has a negative data_retention_days in snowflake_table_options",
+                table
+            ));
+        }
+    }
+    Ok(table_options)
+}
+
+/// Parses the bigquery_table_options of a mirror, a JSON object of BigQueryTableOptions keyed by
+/// destination table name.
+pub fn parse_bigquery_table_options(
+    options: &str,
+) -> anyhow::Result<HashMap<String, pt::peerdb_flow::BigQueryTableOptions>> {
+    let table_options: HashMap<String, pt::peerdb_flow::BigQueryTableOptions> =
+        serde_json::from_str(options)
+            .context("bigquery_table_options must be a JSON object keyed by table name")?;
+    for (table, options) in &table_options {
+        if options.cluster_by.len() > 4 {
+            return Err(anyhow::anyhow!(
+                "table {} has more than 4 clustering columns in bigquery_table_options",
+                table
+            ));
+        }
+    }
+    Ok(table_options)
+}
+
 impl FlowGrpcClient {
     // create a new grpc client to the flow server using flow server address
     pub async fn new(flow_server_addr: &str) -> anyhow::Result<Self> {
@@ -104,6 +142,7 @@ impl FlowGrpcClient {
             workflow_id: workflow_details.workflow_id,
             source_peer: Some(workflow_details.source_peer),
             destination_peer: Some(workflow_details.destination_peer),
+            additional_destination_peers: workflow_details.additional_destination_peers,
         };
         let response = self.client.shutdown_flow(shutdown_flow_req).await?;
         let shutdown_response = response.into_inner();
@@ -122,6 +161,7 @@ impl FlowGrpcClient {
         job: &FlowJob,
         src: pt::peerdb_peers::Peer,
         dst: pt::peerdb_peers::Peer,
+        additional_dsts: Vec<pt::peerdb_peers::Peer>,
     ) -> anyhow::Result<String> {
         let mut src_dst_name_map: HashMap<String, String> = HashMap::new();
         job.table_mappings.iter().for_each(|mapping| {
@@ -141,6 +181,7 @@ impl FlowGrpcClient {
         let flow_conn_cfg = pt::peerdb_flow::FlowConnectionConfigs {
             source: Some(src),
             destination: Some(dst),
+            additional_destinations: additional_dsts,
             flow_job_name: job.name.clone(),
             table_name_mapping: src_dst_name_map,
             do_initial_copy,
@@ -162,6 +203,46 @@ impl FlowGrpcClient {
             cdc_staging_path: job.cdc_staging_path.clone().unwrap_or_default(),
             soft_delete: job.soft_delete,
             replication_slot_name: replication_slot_name.unwrap_or_default(),
+            destination_table_name_template: job
+                .destination_table_name_template
+                .clone()
+                .unwrap_or_default(),
+            destination_table_name_regex: job.destination_table_name_regex.clone().unwrap_or_default(),
+            destination_table_name_replacement: job
+                .destination_table_name_replacement
+                .clone()
+                .unwrap_or_default(),
+            fan_in: job.fan_in,
+            fan_in_source_column: job.fan_in_source_column.clone().unwrap_or_default(),
+            system_columns: Some(pt::peerdb_flow::SystemColumns {
+                synced_at: job.synced_at_column,
+                source_lsn: job.source_lsn_column,
+                commit_ts: job.commit_ts_column,
+            }),
+            history_mode: job.history_mode,
+            direct_apply: job.direct_apply,
+            cdc_output_format: match job.cdc_output_format.as_deref() {
+                Some("parquet") => pt::peerdb_flow::QRepOutputFormat::QrepOutputFormatParquet as i32,
+                _ => pt::peerdb_flow::QRepOutputFormat::QrepOutputFormatAvro as i32,
+            },
+            dead_letter: if job.dead_letter || job.dead_letter_s3_path.is_some() {
+                Some(pt::peerdb_flow::DeadLetterConfig {
+                    s3_path: job.dead_letter_s3_path.clone().unwrap_or_default(),
+                    max_errors: job.dead_letter_max_errors.unwrap_or(0),
+                    max_error_percent: job.dead_letter_max_error_percent.unwrap_or(0),
+                    ..Default::default()
+                })
+            } else {
+                None
+            },
+            snowflake_table_options: match &job.snowflake_table_options {
+                Some(options) => parse_snowflake_table_options(options)?,
+                None => HashMap::new(),
+            },
+            bigquery_table_options: match &job.bigquery_table_options {
+                Some(options) => parse_bigquery_table_options(options)?,
+                None => HashMap::new(),
+            },
             ..Default::default()
         };
 
@@ -191,6 +272,9 @@ impl FlowGrpcClient {
                     "sync_data_format" => {
                         cfg.sync_mode = match s.as_str() {
                             "avro" => pt::peerdb_flow::QRepSyncMode::QrepSyncModeStorageAvro as i32,
+                            "storage_write" => {
+                                pt::peerdb_flow::QRepSyncMode::QrepSyncModeStorageWrite as i32
+                            }
                             _ => pt::peerdb_flow::QRepSyncMode::QrepSyncModeMultiInsert as i32,
                         }
                     }
@@ -218,6 +302,23 @@ impl FlowGrpcClient {
                         }
                     }
                     "staging_path" => cfg.staging_path = s.clone(),
+                    "output_format" => {
+                        cfg.output_format = match s.as_str() {
+                            "parquet" => pt::peerdb_flow::QRepOutputFormat::QrepOutputFormatParquet as i32,
+                            _ => pt::peerdb_flow::QRepOutputFormat::QrepOutputFormatAvro as i32,
+                        }
+                    }
+                    "output_compression" => cfg.output_compression = s.clone(),
+                    "delete_mode" => {
+                        cfg.delete_mode = match s.as_str() {
+                            "hard" => pt::peerdb_flow::QRepDeleteMode::QrepDeleteModeHard as i32,
+                            "soft" => pt::peerdb_flow::QRepDeleteMode::QrepDeleteModeSoft as i32,
+                            _ => pt::peerdb_flow::QRepDeleteMode::QrepDeleteModeNone as i32,
+                        }
+                    }
+                    "soft_delete_column" => cfg.soft_delete_column = s.clone(),
+                    "schedule_cron" => cfg.schedule_cron = s.clone(),
+                    "run_windows" => cfg.run_windows = s.clone(),
                     _ => return anyhow::Result::Err(anyhow::anyhow!("invalid str option {}", key)),
                 },
                 Value::Number(n) => match key.as_str() {
@@ -246,6 +347,31 @@ impl FlowGrpcClient {
                             cfg.num_rows_per_partition = n as u32;
                         }
                     }
+                    "parquet_row_group_size" => {
+                        if let Some(n) = n.as_i64() {
+                            cfg.parquet_row_group_size = n as u32;
+                        }
+                    }
+                    "target_file_size_bytes" => {
+                        if let Some(n) = n.as_i64() {
+                            cfg.target_file_size_bytes = n as u64;
+                        }
+                    }
+                    "num_objects_per_partition" => {
+                        if let Some(n) = n.as_i64() {
+                            cfg.num_objects_per_partition = n as u32;
+                        }
+                    }
+                    "num_key_partitions" => {
+                        if let Some(n) = n.as_i64() {
+                            cfg.num_key_partitions = n as u32;
+                        }
+                    }
+                    "reconcile_interval_seconds" => {
+                        if let Some(n) = n.as_i64() {
+                            cfg.reconcile_interval_seconds = n as u32;
+                        }
+                    }
                     _ => return anyhow::Result::Err(anyhow::anyhow!("invalid num option {}", key)),
                 },
                 Value::Bool(v) => {
@@ -15,6 +15,7 @@ pub struct FlowJobTableMapping {
 pub enum FlowSyncMode {
     Avro,
     SQL,
+    StorageWrite,
 }
 
 impl FlowSyncMode {
@@ -22,6 +23,7 @@ impl FlowSyncMode {
         match s {
             "avro" => Ok(FlowSyncMode::Avro),
             "sql" => Ok(FlowSyncMode::SQL),
+            "storage_write" => Ok(FlowSyncMode::StorageWrite),
             _ => Err(format!("{} is not a valid FlowSyncMode", s)),
         }
     }
@@ -30,6 +32,9 @@ impl FlowSyncMode {
         match self {
             FlowSyncMode::Avro => peerdb_flow::QRepSyncMode::QrepSyncModeStorageAvro as i32,
             FlowSyncMode::SQL => peerdb_flow::QRepSyncMode::QrepSyncModeMultiInsert as i32,
+            FlowSyncMode::StorageWrite => {
+                peerdb_flow::QRepSyncMode::QrepSyncModeStorageWrite as i32
+            }
         }
     }
 }
@@ -41,6 +46,7 @@ impl std::str::FromStr for FlowSyncMode {
         match s {
             "avro" => Ok(FlowSyncMode::Avro),
             "default" => Ok(FlowSyncMode::SQL),
+            "storage_write" => Ok(FlowSyncMode::StorageWrite),
             _ => Err(format!("{} is not a valid FlowSyncMode", s)),
         }
     }
@@ -51,6 +57,7 @@ impl ToString for FlowSyncMode {
         match self {
             FlowSyncMode::Avro => "avro".to_string(),
             FlowSyncMode::SQL => "default".to_string(),
+            FlowSyncMode::StorageWrite => "storage_write".to_string(),
         }
     }
 }
@@ -60,6 +67,7 @@ pub struct FlowJob {
     pub name: String,
     pub source_peer: String,
     pub target_peer: String,
+    pub additional_target_peers: Vec<String>,
     pub table_mappings: Vec<FlowJobTableMapping>,
     pub description: String,
     pub do_initial_copy: bool,
@@ -72,7 +80,24 @@ pub struct FlowJob {
     pub cdc_sync_mode: Option<FlowSyncMode>,
     pub cdc_staging_path: Option<String>,
     pub soft_delete: bool,
-    pub replication_slot_name: Option<String>
+    pub replication_slot_name: Option<String>,
+    pub destination_table_name_template: Option<String>,
+    pub destination_table_name_regex: Option<String>,
+    pub destination_table_name_replacement: Option<String>,
+    pub fan_in: bool,
+    pub fan_in_source_column: Option<String>,
+    pub synced_at_column: bool,
+    pub source_lsn_column: bool,
+    pub commit_ts_column: bool,
+    pub history_mode: bool,
+    pub direct_apply: bool,
+    pub cdc_output_format: Option<String>,
+    pub dead_letter: bool,
+    pub dead_letter_s3_path: Option<String>,
+    pub dead_letter_max_errors: Option<u32>,
+    pub dead_letter_max_error_percent: Option<u32>,
+    pub snowflake_table_options: Option<String>,
+    pub bigquery_table_options: Option<String>,
 }
 
 #[derive(Debug, PartialEq, Eq, Serialize, Deserialize, Clone)]
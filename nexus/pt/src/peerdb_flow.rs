@@ -49,11 +49,87 @@ pub struct FlowConnectionConfigs {
     pub snapshot_staging_path: ::prost::alloc::string::String,
     #[prost(string, tag="18")]
     pub cdc_staging_path: ::prost::alloc::string::String,
-    /// currently only works for snowflake
+    /// mark deleted rows with _peerdb_is_deleted instead of deleting them.
     #[prost(bool, tag="19")]
     pub soft_delete: bool,
     #[prost(string, tag="20")]
     pub replication_slot_name: ::prost::alloc::string::String,
+    /// optional rewrite of destination table names, applied to every source table in
+    /// table_name_mapping. The template is a Go text/template over {{.schema}} and {{.table}},
+    /// the regex is matched against "schema.table" and expanded with the replacement.
+    #[prost(string, tag="21")]
+    pub destination_table_name_template: ::prost::alloc::string::String,
+    #[prost(string, tag="22")]
+    pub destination_table_name_regex: ::prost::alloc::string::String,
+    #[prost(string, tag="23")]
+    pub destination_table_name_replacement: ::prost::alloc::string::String,
+    /// fan-in allows multiple source tables to be merged into one destination table,
+    /// the source table is recorded in fan_in_source_column which becomes part of the primary key.
+    #[prost(bool, tag="24")]
+    pub fan_in: bool,
+    #[prost(string, tag="25")]
+    pub fan_in_source_column: ::prost::alloc::string::String,
+    /// additional destination peers that receive the same changes as destination.
+    /// every destination keeps its own checkpoint, the replication slot is only
+    /// acknowledged up to the lowest checkpoint across all destinations.
+    #[prost(message, repeated, tag="26")]
+    pub additional_destinations: ::prost::alloc::vec::Vec<super::peerdb_peers::Peer>,
+    /// system columns to maintain on every normalized table.
+    #[prost(message, optional, tag="27")]
+    pub system_columns: ::core::option::Option<SystemColumns>,
+    /// keep the history of every row instead of only its latest state, each change closes the
+    /// current version (_peerdb_valid_to, _peerdb_is_current) and inserts a new one (_peerdb_valid_from).
+    #[prost(bool, tag="28")]
+    pub history_mode: bool,
+    /// apply changes straight to the normalized tables in the sync transaction instead of going
+    /// through the raw table, only supported when every destination is Postgres.
+    #[prost(bool, tag="29")]
+    pub direct_apply: bool,
+    /// format of the change files written by destinations that store files, like S3.
+    #[prost(enumeration="QRepOutputFormat", tag="30")]
+    pub cdc_output_format: i32,
+    /// records that fail to convert or to be written go to a dead letter sink instead of
+    /// failing the whole batch, not set means a failed record fails the batch.
+    #[prost(message, optional, tag="31")]
+    pub dead_letter: ::core::option::Option<DeadLetterConfig>,
+    /// options of the normalized tables on Snowflake destinations, keyed by destination table name.
+    #[prost(map="string, message", tag="32")]
+    pub snowflake_table_options: ::std::collections::HashMap<::prost::alloc::string::String, SnowflakeTableOptions>,
+    /// options of the normalized tables on BigQuery destinations, keyed by destination table name.
+    #[prost(map="string, message", tag="33")]
+    pub bigquery_table_options: ::std::collections::HashMap<::prost::alloc::string::String, BigQueryTableOptions>,
+}
+/// A dead letter sink keeps the records that failed along with their table, LSN and error.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct DeadLetterConfig {
+    /// s3://bucket/prefix to write the failed records to,
+    /// the peerdb_stats.dead_letters table of the catalog is used when not set.
+    #[prost(string, tag="1")]
+    pub s3_path: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub s3_connection: ::core::option::Option<super::peerdb_peers::S3ConnectionConfig>,
+    /// the mirror halts when more records of a batch fail than both max_errors and
+    /// max_error_percent of its records, rounded up. Not set means no limit.
+    #[prost(uint32, tag="3")]
+    pub max_errors: u32,
+    #[prost(uint32, tag="4")]
+    pub max_error_percent: u32,
+}
+/// System columns are maintained by the normalize step on every normalized table,
+/// _peerdb_is_deleted is added when soft_delete is set.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SystemColumns {
+    /// _peerdb_synced_at, when the row was last merged into the normalized table.
+    #[prost(bool, tag="1")]
+    pub synced_at: bool,
+    /// _peerdb_source_lsn, LSN of the last change applied to the row.
+    #[prost(bool, tag="2")]
+    pub source_lsn: bool,
+    /// _peerdb_commit_ts, commit time of the source transaction of that change.
+    #[prost(bool, tag="3")]
+    pub commit_ts: bool,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -84,6 +160,10 @@ pub struct StartFlowInput {
     pub flow_connection_configs: ::core::option::Option<FlowConnectionConfigs>,
     #[prost(message, optional, tag="3")]
     pub sync_flow_options: ::core::option::Option<SyncFlowOptions>,
+    /// checkpoints of every destination keyed by peer name,
+    /// last_sync_state is the lowest of these when there are additional destinations.
+    #[prost(map="string, message", tag="4")]
+    pub destination_sync_states: ::std::collections::HashMap<::prost::alloc::string::String, LastSyncState>,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -208,6 +288,13 @@ pub struct TableSchema {
     pub columns: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
     #[prost(string, tag="3")]
     pub primary_key_column: ::prost::alloc::string::String,
+    /// set for fan-in tables, column holding the source table identifier.
+    /// this column is part of the primary key along with primary_key_column.
+    #[prost(string, tag="4")]
+    pub source_identifier_column: ::prost::alloc::string::String,
+    /// comments of the source columns, like pg_description on Postgres.
+    #[prost(map="string, string", tag="5")]
+    pub column_comments: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -240,6 +327,63 @@ pub struct SetupNormalizedTableBatchInput {
     pub peer_connection_config: ::core::option::Option<super::peerdb_peers::Peer>,
     #[prost(map="string, message", tag="2")]
     pub table_name_schema_mapping: ::std::collections::HashMap<::prost::alloc::string::String, TableSchema>,
+    #[prost(bool, tag="3")]
+    pub soft_delete: bool,
+    #[prost(message, optional, tag="4")]
+    pub system_columns: ::core::option::Option<SystemColumns>,
+    #[prost(bool, tag="5")]
+    pub history_mode: bool,
+    #[prost(map="string, message", tag="6")]
+    pub snowflake_table_options: ::std::collections::HashMap<::prost::alloc::string::String, SnowflakeTableOptions>,
+    #[prost(map="string, message", tag="7")]
+    pub bigquery_table_options: ::std::collections::HashMap<::prost::alloc::string::String, BigQueryTableOptions>,
+}
+/// SnowflakeTableOptions are applied when a normalized table is created on Snowflake,
+/// and reconciled with the existing table on later runs. Column collations are not covered:
+/// Snowflake cannot change the collation of an existing column, so they could not be reconciled.
+/// Columns get the default collation of their schema, DEFAULT_DDL_COLLATION sets it.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct SnowflakeTableOptions {
+    /// clustering key expressions, over the upper case column names. Empty drops the clustering key.
+    #[prost(string, repeated, tag="1")]
+    pub cluster_by: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    /// transient tables have no Fail-safe, this can only be set when the table is created.
+    #[prost(bool, tag="2")]
+    pub transient: bool,
+    /// DATA_RETENTION_TIME_IN_DAYS of the table, not set keeps the default of the schema and 0 disables Time Travel.
+    #[prost(int32, optional, tag="3")]
+    pub data_retention_days: ::core::option::Option<i32>,
+    /// copy the comments of the source columns to the table.
+    #[prost(bool, tag="4")]
+    pub column_comments: bool,
+    /// tags set on the table, the tags must already exist. Other tags of the table are unset.
+    #[prost(map="string, string", tag="5")]
+    pub tags: ::std::collections::HashMap<::prost::alloc::string::String, ::prost::alloc::string::String>,
+}
+/// BigQueryTableOptions are applied when a normalized table is created on BigQuery,
+/// the clustering of existing tables is updated on later runs.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct BigQueryTableOptions {
+    /// column the table is partitioned on, TIMESTAMP and DATE columns are partitioned by time
+    /// and integer columns by range. Normalization only scans the partitions a batch touches,
+    /// batches with updates or deletes are only pruned when the column is in the replica identity.
+    #[prost(string, tag="1")]
+    pub partition_column: ::prost::alloc::string::String,
+    /// granularity of time partitioning: HOUR, DAY, MONTH or YEAR, DAY when empty.
+    #[prost(string, tag="2")]
+    pub partition_granularity: ::prost::alloc::string::String,
+    /// integer range partitioning splits [range_start, range_end) in ranges of range_interval.
+    #[prost(int64, tag="3")]
+    pub range_start: i64,
+    #[prost(int64, tag="4")]
+    pub range_end: i64,
+    #[prost(int64, tag="5")]
+    pub range_interval: i64,
+    /// up to four clustering columns.
+    #[prost(string, repeated, tag="6")]
+    pub cluster_by: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -288,11 +432,53 @@ pub struct TidPartitionRange {
     #[prost(message, optional, tag="2")]
     pub end: ::core::option::Option<Tid>,
 }
+/// objects of an object store prefix, last_modified is the last modified time
+/// of the latest object and serves as the watermark of the next partitions.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct ObjectPartitionRange {
+    #[prost(string, repeated, tag="1")]
+    pub keys: ::prost::alloc::vec::Vec<::prost::alloc::string::String>,
+    #[prost(message, optional, tag="2")]
+    pub last_modified: ::core::option::Option<::pbjson_types::Timestamp>,
+}
+/// range of a uuid or text watermark column, uuids are in their canonical text form.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct StringPartitionRange {
+    #[prost(string, tag="1")]
+    pub start: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub end: ::prost::alloc::string::String,
+}
+/// value of a column of a composite watermark, the field matching the type is set.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct PartitionValue {
+    #[prost(enumeration="PartitionValueType", tag="1")]
+    pub r#type: i32,
+    #[prost(int64, tag="2")]
+    pub int_value: i64,
+    #[prost(message, optional, tag="3")]
+    pub timestamp_value: ::core::option::Option<::pbjson_types::Timestamp>,
+    #[prost(string, tag="4")]
+    pub string_value: ::prost::alloc::string::String,
+}
+/// range of a composite watermark, start and end have a value per watermark column
+/// and are compared as tuples.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct TuplePartitionRange {
+    #[prost(message, repeated, tag="1")]
+    pub start: ::prost::alloc::vec::Vec<PartitionValue>,
+    #[prost(message, repeated, tag="2")]
+    pub end: ::prost::alloc::vec::Vec<PartitionValue>,
+}
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct PartitionRange {
     /// can be a timestamp range or an integer range
-    #[prost(oneof="partition_range::Range", tags="1, 2, 3")]
+    #[prost(oneof="partition_range::Range", tags="1, 2, 3, 4, 5, 6")]
     pub range: ::core::option::Option<partition_range::Range>,
 }
 /// Nested message and enum types in `PartitionRange`.
@@ -307,6 +493,12 @@ pub mod partition_range {
         TimestampRange(super::TimestampPartitionRange),
         #[prost(message, tag="3")]
         TidRange(super::TidPartitionRange),
+        #[prost(message, tag="4")]
+        ObjectRange(super::ObjectPartitionRange),
+        #[prost(message, tag="5")]
+        StringRange(super::StringPartitionRange),
+        #[prost(message, tag="6")]
+        TupleRange(super::TuplePartitionRange),
     }
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
@@ -332,6 +524,7 @@ pub struct QRepConfig {
     pub query: ::prost::alloc::string::String,
     #[prost(string, tag="6")]
     pub watermark_table: ::prost::alloc::string::String,
+    /// comma separated columns for a composite watermark, e.g. "updated_at, id".
     #[prost(string, tag="7")]
     pub watermark_column: ::prost::alloc::string::String,
     #[prost(bool, tag="8")]
@@ -362,6 +555,47 @@ pub struct QRepConfig {
     /// how many rows to process per batch.
     #[prost(uint32, tag="16")]
     pub num_rows_per_partition: u32,
+    /// format of the files written by file based destinations like S3.
+    #[prost(enumeration="QRepOutputFormat", tag="17")]
+    pub output_format: i32,
+    /// compression codec of the output files, snappy, gzip or zstd for parquet and
+    /// deflate, snappy, gzip or zstd for avro.
+    #[prost(string, tag="18")]
+    pub output_compression: ::prost::alloc::string::String,
+    /// number of rows per parquet row group, a default is used when not set.
+    #[prost(uint32, tag="19")]
+    pub parquet_row_group_size: u32,
+    /// approximate size at which the output of a partition rolls over to a new file,
+    /// every partition is written to a single file when not set.
+    #[prost(uint64, tag="20")]
+    pub target_file_size_bytes: u64,
+    /// number of objects read per partition when the source is an object store,
+    /// every object is read as its own partition when not set.
+    #[prost(uint32, tag="21")]
+    pub num_objects_per_partition: u32,
+    /// number of partitions the range of a uuid or text watermark column is split
+    /// into when num_rows_per_partition is not set, 16 when not set.
+    #[prost(uint32, tag="22")]
+    pub num_key_partitions: u32,
+    #[prost(enumeration="QRepDeleteMode", tag="23")]
+    pub delete_mode: i32,
+    /// boolean column of the destination table set to true for soft deletes.
+    #[prost(string, tag="24")]
+    pub soft_delete_column: ::prost::alloc::string::String,
+    /// interval between reconciliations of the keys for delete detection,
+    /// the keys are reconciled after every run when not set.
+    #[prost(uint32, tag="25")]
+    pub reconcile_interval_seconds: u32,
+    /// standard 5 field cron expression, in UTC, for the runs of the flow. Runs are otherwise
+    /// started wait_between_batches_seconds after the previous run.
+    #[prost(string, tag="26")]
+    pub schedule_cron: ::prost::alloc::string::String,
+    /// comma separated windows of the day, in UTC, that runs are allowed to start in,
+    /// e.g. "01:00-05:00". A window can wrap around midnight, e.g. "22:00-02:00".
+    /// Partitions only start within windows, running partitions finish after their window closes and
+    /// the partitions left are replicated from the start of the next window.
+    #[prost(string, tag="27")]
+    pub run_windows: ::prost::alloc::string::String,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -393,12 +627,43 @@ pub struct DropFlowInput {
     #[prost(string, tag="1")]
     pub flow_name: ::prost::alloc::string::String,
 }
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum PartitionValueType {
+    Int = 0,
+    Timestamp = 1,
+    String = 2,
+}
+impl PartitionValueType {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            PartitionValueType::Int => "PARTITION_VALUE_TYPE_INT",
+            PartitionValueType::Timestamp => "PARTITION_VALUE_TYPE_TIMESTAMP",
+            PartitionValueType::String => "PARTITION_VALUE_TYPE_STRING",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "PARTITION_VALUE_TYPE_INT" => Some(Self::Int),
+            "PARTITION_VALUE_TYPE_TIMESTAMP" => Some(Self::Timestamp),
+            "PARTITION_VALUE_TYPE_STRING" => Some(Self::String),
+            _ => None,
+        }
+    }
+}
 /// protos for qrep
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
 pub enum QRepSyncMode {
     QrepSyncModeMultiInsert = 0,
     QrepSyncModeStorageAvro = 1,
+    /// streams rows with the BigQuery Storage Write API, without staging them.
+    QrepSyncModeStorageWrite = 2,
 }
 impl QRepSyncMode {
     /// String value of the enum field names used in the ProtoBuf definition.
@@ -409,6 +674,7 @@ impl QRepSyncMode {
         match self {
             QRepSyncMode::QrepSyncModeMultiInsert => "QREP_SYNC_MODE_MULTI_INSERT",
             QRepSyncMode::QrepSyncModeStorageAvro => "QREP_SYNC_MODE_STORAGE_AVRO",
+            QRepSyncMode::QrepSyncModeStorageWrite => "QREP_SYNC_MODE_STORAGE_WRITE",
         }
     }
     /// Creates an enum from field names used in the ProtoBuf definition.
@@ -416,6 +682,33 @@ impl QRepSyncMode {
         match value {
             "QREP_SYNC_MODE_MULTI_INSERT" => Some(Self::QrepSyncModeMultiInsert),
             "QREP_SYNC_MODE_STORAGE_AVRO" => Some(Self::QrepSyncModeStorageAvro),
+            "QREP_SYNC_MODE_STORAGE_WRITE" => Some(Self::QrepSyncModeStorageWrite),
+            _ => None,
+        }
+    }
+}
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum QRepOutputFormat {
+    QrepOutputFormatAvro = 0,
+    QrepOutputFormatParquet = 1,
+}
+impl QRepOutputFormat {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            QRepOutputFormat::QrepOutputFormatAvro => "QREP_OUTPUT_FORMAT_AVRO",
+            QRepOutputFormat::QrepOutputFormatParquet => "QREP_OUTPUT_FORMAT_PARQUET",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "QREP_OUTPUT_FORMAT_AVRO" => Some(Self::QrepOutputFormatAvro),
+            "QREP_OUTPUT_FORMAT_PARQUET" => Some(Self::QrepOutputFormatParquet),
             _ => None,
         }
     }
@@ -446,5 +739,36 @@ impl QRepWriteType {
         }
     }
 }
+/// how rows deleted at the source are removed from the destination, the keys of the
+/// source and destination are reconciled on the upsert key columns of the write mode.
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum QRepDeleteMode {
+    QrepDeleteModeNone = 0,
+    QrepDeleteModeHard = 1,
+    QrepDeleteModeSoft = 2,
+}
+impl QRepDeleteMode {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            QRepDeleteMode::QrepDeleteModeNone => "QREP_DELETE_MODE_NONE",
+            QRepDeleteMode::QrepDeleteModeHard => "QREP_DELETE_MODE_HARD",
+            QRepDeleteMode::QrepDeleteModeSoft => "QREP_DELETE_MODE_SOFT",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "QREP_DELETE_MODE_NONE" => Some(Self::QrepDeleteModeNone),
+            "QREP_DELETE_MODE_HARD" => Some(Self::QrepDeleteModeHard),
+            "QREP_DELETE_MODE_SOFT" => Some(Self::QrepDeleteModeSoft),
+            _ => None,
+        }
+    }
+}
 include!("peerdb_flow.serde.rs");
 // @@protoc_insertion_point(module)
\ No newline at end of file
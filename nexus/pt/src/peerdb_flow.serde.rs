@@ -1,4 +1,196 @@
 // @generated
+impl serde::Serialize for BigQueryTableOptions {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if !self.partition_column.is_empty() {
+            len += 1;
+        }
+        if !self.partition_granularity.is_empty() {
+            len += 1;
+        }
+        if self.range_start != 0 {
+            len += 1;
+        }
+        if self.range_end != 0 {
+            len += 1;
+        }
+        if self.range_interval != 0 {
+            len += 1;
+        }
+        if !self.cluster_by.is_empty() {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("peerdb_flow.BigQueryTableOptions", len)?;
+        if !self.partition_column.is_empty() {
+            struct_ser.serialize_field("partitionColumn", &self.partition_column)?;
+        }
+        if !self.partition_granularity.is_empty() {
+            struct_ser.serialize_field("partitionGranularity", &self.partition_granularity)?;
+        }
+        if self.range_start != 0 {
+            struct_ser.serialize_field("rangeStart", ToString::to_string(&self.range_start).as_str())?;
+        }
+        if self.range_end != 0 {
+            struct_ser.serialize_field("rangeEnd", ToString::to_string(&self.range_end).as_str())?;
+        }
+        if self.range_interval != 0 {
+            struct_ser.serialize_field("rangeInterval", ToString::to_string(&self.range_interval).as_str())?;
+        }
+        if !self.cluster_by.is_empty() {
+            struct_ser.serialize_field("clusterBy", &self.cluster_by)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for BigQueryTableOptions {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "partition_column",
+            "partitionColumn",
+            "partition_granularity",
+            "partitionGranularity",
+            "range_start",
+            "rangeStart",
+            "range_end",
+            "rangeEnd",
+            "range_interval",
+            "rangeInterval",
+            "cluster_by",
+            "clusterBy",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            PartitionColumn,
+            PartitionGranularity,
+            RangeStart,
+            RangeEnd,
+            RangeInterval,
+            ClusterBy,
+            __SkipField__,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "partitionColumn" | "partition_column" => Ok(GeneratedField::PartitionColumn),
+                            "partitionGranularity" | "partition_granularity" => Ok(GeneratedField::PartitionGranularity),
+                            "rangeStart" | "range_start" => Ok(GeneratedField::RangeStart),
+                            "rangeEnd" | "range_end" => Ok(GeneratedField::RangeEnd),
+                            "rangeInterval" | "range_interval" => Ok(GeneratedField::RangeInterval),
+                            "clusterBy" | "cluster_by" => Ok(GeneratedField::ClusterBy),
+                            _ => Ok(GeneratedField::__SkipField__),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = BigQueryTableOptions;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct peerdb_flow.BigQueryTableOptions")
+            }
+
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<BigQueryTableOptions, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut partition_column__ = None;
+                let mut partition_granularity__ = None;
+                let mut range_start__ = None;
+                let mut range_end__ = None;
+                let mut range_interval__ = None;
+                let mut cluster_by__ = None;
+                while let Some(k) = map.next_key()? {
+                    match k {
+                        GeneratedField::PartitionColumn => {
+                            if partition_column__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("partitionColumn"));
+                            }
+                            partition_column__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::PartitionGranularity => {
+                            if partition_granularity__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("partitionGranularity"));
+                            }
+                            partition_granularity__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::RangeStart => {
+                            if range_start__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("rangeStart"));
+                            }
+                            range_start__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::RangeEnd => {
+                            if range_end__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("rangeEnd"));
+                            }
+                            range_end__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::RangeInterval => {
+                            if range_interval__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("rangeInterval"));
+                            }
+                            range_interval__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::ClusterBy => {
+                            if cluster_by__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("clusterBy"));
+                            }
+                            cluster_by__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::__SkipField__ => {
+                            let _ = map.next_value::<serde::de::IgnoredAny>()?;
+                        }
+                    }
+                }
+                Ok(BigQueryTableOptions {
+                    partition_column: partition_column__.unwrap_or_default(),
+                    partition_granularity: partition_granularity__.unwrap_or_default(),
+                    range_start: range_start__.unwrap_or_default(),
+                    range_end: range_end__.unwrap_or_default(),
+                    range_interval: range_interval__.unwrap_or_default(),
+                    cluster_by: cluster_by__.unwrap_or_default(),
+                })
+            }
+        }
+        deserializer.deserialize_struct("peerdb_flow.BigQueryTableOptions", FIELDS, GeneratedVisitor)
+    }
+}
 impl serde::Serialize for CreateRawTableInput {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
@@ -249,6 +441,160 @@ impl<'de> serde::Deserialize<'de> for CreateRawTableOutput {
         deserializer.deserialize_struct("peerdb_flow.CreateRawTableOutput", FIELDS, GeneratedVisitor)
     }
 }
+impl serde::Serialize for DeadLetterConfig {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if !self.s3_path.is_empty() {
+            len += 1;
+        }
+        if self.s3_connection.is_some() {
+            len += 1;
+        }
+        if self.max_errors != 0 {
+            len += 1;
+        }
+        if self.max_error_percent != 0 {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("peerdb_flow.DeadLetterConfig", len)?;
+        if !self.s3_path.is_empty() {
+            struct_ser.serialize_field("s3Path", &self.s3_path)?;
+        }
+        if let Some(v) = self.s3_connection.as_ref() {
+            struct_ser.serialize_field("s3Connection", v)?;
+        }
+        if self.max_errors != 0 {
+            struct_ser.serialize_field("maxErrors", &self.max_errors)?;
+        }
+        if self.max_error_percent != 0 {
+            struct_ser.serialize_field("maxErrorPercent", &self.max_error_percent)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for DeadLetterConfig {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "s3_path",
+            "s3Path",
+            "s3_connection",
+            "s3Connection",
+            "max_errors",
+            "maxErrors",
+            "max_error_percent",
+            "maxErrorPercent",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            S3Path,
+            S3Connection,
+            MaxErrors,
+            MaxErrorPercent,
+            __SkipField__,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "s3Path" | "s3_path" => Ok(GeneratedField::S3Path),
+                            "s3Connection" | "s3_connection" => Ok(GeneratedField::S3Connection),
+                            "maxErrors" | "max_errors" => Ok(GeneratedField::MaxErrors),
+                            "maxErrorPercent" | "max_error_percent" => Ok(GeneratedField::MaxErrorPercent),
+                            _ => Ok(GeneratedField::__SkipField__),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = DeadLetterConfig;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct peerdb_flow.DeadLetterConfig")
+            }
+
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<DeadLetterConfig, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut s3_path__ = None;
+                let mut s3_connection__ = None;
+                let mut max_errors__ = None;
+                let mut max_error_percent__ = None;
+                while let Some(k) = map.next_key()? {
+                    match k {
+                        GeneratedField::S3Path => {
+                            if s3_path__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("s3Path"));
+                            }
+                            s3_path__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::S3Connection => {
+                            if s3_connection__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("s3Connection"));
+                            }
+                            s3_connection__ = map.next_value()?;
+                        }
+                        GeneratedField::MaxErrors => {
+                            if max_errors__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("maxErrors"));
+                            }
+                            max_errors__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::MaxErrorPercent => {
+                            if max_error_percent__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("maxErrorPercent"));
+                            }
+                            max_error_percent__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::__SkipField__ => {
+                            let _ = map.next_value::<serde::de::IgnoredAny>()?;
+                        }
+                    }
+                }
+                Ok(DeadLetterConfig {
+                    s3_path: s3_path__.unwrap_or_default(),
+                    s3_connection: s3_connection__,
+                    max_errors: max_errors__.unwrap_or_default(),
+                    max_error_percent: max_error_percent__.unwrap_or_default(),
+                })
+            }
+        }
+        deserializer.deserialize_struct("peerdb_flow.DeadLetterConfig", FIELDS, GeneratedVisitor)
+    }
+}
 impl serde::Serialize for DropFlowInput {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
@@ -871,6 +1217,45 @@ impl serde::Serialize for FlowConnectionConfigs {
         if !self.replication_slot_name.is_empty() {
             len += 1;
         }
+        if !self.destination_table_name_template.is_empty() {
+            len += 1;
+        }
+        if !self.destination_table_name_regex.is_empty() {
+            len += 1;
+        }
+        if !self.destination_table_name_replacement.is_empty() {
+            len += 1;
+        }
+        if self.fan_in {
+            len += 1;
+        }
+        if !self.fan_in_source_column.is_empty() {
+            len += 1;
+        }
+        if !self.additional_destinations.is_empty() {
+            len += 1;
+        }
+        if self.system_columns.is_some() {
+            len += 1;
+        }
+        if self.history_mode {
+            len += 1;
+        }
+        if self.direct_apply {
+            len += 1;
+        }
+        if self.cdc_output_format != 0 {
+            len += 1;
+        }
+        if self.dead_letter.is_some() {
+            len += 1;
+        }
+        if !self.snowflake_table_options.is_empty() {
+            len += 1;
+        }
+        if !self.bigquery_table_options.is_empty() {
+            len += 1;
+        }
         let mut struct_ser = serializer.serialize_struct("peerdb_flow.FlowConnectionConfigs", len)?;
         if let Some(v) = self.source.as_ref() {
             struct_ser.serialize_field("source", v)?;
@@ -936,6 +1321,47 @@ impl serde::Serialize for FlowConnectionConfigs {
         if !self.replication_slot_name.is_empty() {
             struct_ser.serialize_field("replicationSlotName", &self.replication_slot_name)?;
         }
+        if !self.destination_table_name_template.is_empty() {
+            struct_ser.serialize_field("destinationTableNameTemplate", &self.destination_table_name_template)?;
+        }
+        if !self.destination_table_name_regex.is_empty() {
+            struct_ser.serialize_field("destinationTableNameRegex", &self.destination_table_name_regex)?;
+        }
+        if !self.destination_table_name_replacement.is_empty() {
+            struct_ser.serialize_field("destinationTableNameReplacement", &self.destination_table_name_replacement)?;
+        }
+        if self.fan_in {
+            struct_ser.serialize_field("fanIn", &self.fan_in)?;
+        }
+        if !self.fan_in_source_column.is_empty() {
+            struct_ser.serialize_field("fanInSourceColumn", &self.fan_in_source_column)?;
+        }
+        if !self.additional_destinations.is_empty() {
+            struct_ser.serialize_field("additionalDestinations", &self.additional_destinations)?;
+        }
+        if let Some(v) = self.system_columns.as_ref() {
+            struct_ser.serialize_field("systemColumns", v)?;
+        }
+        if self.history_mode {
+            struct_ser.serialize_field("historyMode", &self.history_mode)?;
+        }
+        if self.direct_apply {
+            struct_ser.serialize_field("directApply", &self.direct_apply)?;
+        }
+        if self.cdc_output_format != 0 {
+            let v = QRepOutputFormat::from_i32(self.cdc_output_format)
+                .ok_or_else(|| serde::ser::Error::custom(format!("Invalid variant {}", self.cdc_output_format)))?;
+            struct_ser.serialize_field("cdcOutputFormat", &v)?;
+        }
+        if let Some(v) = self.dead_letter.as_ref() {
+            struct_ser.serialize_field("deadLetter", v)?;
+        }
+        if !self.snowflake_table_options.is_empty() {
+            struct_ser.serialize_field("snowflakeTableOptions", &self.snowflake_table_options)?;
+        }
+        if !self.bigquery_table_options.is_empty() {
+            struct_ser.serialize_field("bigqueryTableOptions", &self.bigquery_table_options)?;
+        }
         struct_ser.end()
     }
 }
@@ -984,6 +1410,32 @@ impl<'de> serde::Deserialize<'de> for FlowConnectionConfigs {
             "softDelete",
             "replication_slot_name",
             "replicationSlotName",
+            "destination_table_name_template",
+            "destinationTableNameTemplate",
+            "destination_table_name_regex",
+            "destinationTableNameRegex",
+            "destination_table_name_replacement",
+            "destinationTableNameReplacement",
+            "fan_in",
+            "fanIn",
+            "fan_in_source_column",
+            "fanInSourceColumn",
+            "additional_destinations",
+            "additionalDestinations",
+            "system_columns",
+            "systemColumns",
+            "history_mode",
+            "historyMode",
+            "direct_apply",
+            "directApply",
+            "cdc_output_format",
+            "cdcOutputFormat",
+            "dead_letter",
+            "deadLetter",
+            "snowflake_table_options",
+            "snowflakeTableOptions",
+            "bigquery_table_options",
+            "bigqueryTableOptions",
         ];
 
         #[allow(clippy::enum_variant_names)]
@@ -1008,6 +1460,19 @@ impl<'de> serde::Deserialize<'de> for FlowConnectionConfigs {
             CdcStagingPath,
             SoftDelete,
             ReplicationSlotName,
+            DestinationTableNameTemplate,
+            DestinationTableNameRegex,
+            DestinationTableNameReplacement,
+            FanIn,
+            FanInSourceColumn,
+            AdditionalDestinations,
+            SystemColumns,
+            HistoryMode,
+            DirectApply,
+            CdcOutputFormat,
+            DeadLetter,
+            SnowflakeTableOptions,
+            BigqueryTableOptions,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -1050,6 +1515,19 @@ impl<'de> serde::Deserialize<'de> for FlowConnectionConfigs {
                             "cdcStagingPath" | "cdc_staging_path" => Ok(GeneratedField::CdcStagingPath),
                             "softDelete" | "soft_delete" => Ok(GeneratedField::SoftDelete),
                             "replicationSlotName" | "replication_slot_name" => Ok(GeneratedField::ReplicationSlotName),
+                            "destinationTableNameTemplate" | "destination_table_name_template" => Ok(GeneratedField::DestinationTableNameTemplate),
+                            "destinationTableNameRegex" | "destination_table_name_regex" => Ok(GeneratedField::DestinationTableNameRegex),
+                            "destinationTableNameReplacement" | "destination_table_name_replacement" => Ok(GeneratedField::DestinationTableNameReplacement),
+                            "fanIn" | "fan_in" => Ok(GeneratedField::FanIn),
+                            "fanInSourceColumn" | "fan_in_source_column" => Ok(GeneratedField::FanInSourceColumn),
+                            "additionalDestinations" | "additional_destinations" => Ok(GeneratedField::AdditionalDestinations),
+                            "systemColumns" | "system_columns" => Ok(GeneratedField::SystemColumns),
+                            "historyMode" | "history_mode" => Ok(GeneratedField::HistoryMode),
+                            "directApply" | "direct_apply" => Ok(GeneratedField::DirectApply),
+                            "cdcOutputFormat" | "cdc_output_format" => Ok(GeneratedField::CdcOutputFormat),
+                            "deadLetter" | "dead_letter" => Ok(GeneratedField::DeadLetter),
+                            "snowflakeTableOptions" | "snowflake_table_options" => Ok(GeneratedField::SnowflakeTableOptions),
+                            "bigqueryTableOptions" | "bigquery_table_options" => Ok(GeneratedField::BigqueryTableOptions),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -1089,6 +1567,19 @@ impl<'de> serde::Deserialize<'de> for FlowConnectionConfigs {
                 let mut cdc_staging_path__ = None;
                 let mut soft_delete__ = None;
                 let mut replication_slot_name__ = None;
+                let mut destination_table_name_template__ = None;
+                let mut destination_table_name_regex__ = None;
+                let mut destination_table_name_replacement__ = None;
+                let mut fan_in__ = None;
+                let mut fan_in_source_column__ = None;
+                let mut additional_destinations__ = None;
+                let mut system_columns__ = None;
+                let mut history_mode__ = None;
+                let mut direct_apply__ = None;
+                let mut cdc_output_format__ = None;
+                let mut dead_letter__ = None;
+                let mut snowflake_table_options__ = None;
+                let mut bigquery_table_options__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
                         GeneratedField::Source => {
@@ -1226,23 +1717,105 @@ impl<'de> serde::Deserialize<'de> for FlowConnectionConfigs {
                             }
                             replication_slot_name__ = Some(map.next_value()?);
                         }
-                        GeneratedField::__SkipField__ => {
-                            let _ = map.next_value::<serde::de::IgnoredAny>()?;
+                        GeneratedField::DestinationTableNameTemplate => {
+                            if destination_table_name_template__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("destinationTableNameTemplate"));
+                            }
+                            destination_table_name_template__ = Some(map.next_value()?);
                         }
-                    }
-                }
-                Ok(FlowConnectionConfigs {
-                    source: source__,
-                    destination: destination__,
-                    flow_job_name: flow_job_name__.unwrap_or_default(),
-                    table_schema: table_schema__,
-                    table_name_mapping: table_name_mapping__.unwrap_or_default(),
-                    src_table_id_name_mapping: src_table_id_name_mapping__.unwrap_or_default(),
-                    table_name_schema_mapping: table_name_schema_mapping__.unwrap_or_default(),
-                    metadata_peer: metadata_peer__,
-                    max_batch_size: max_batch_size__.unwrap_or_default(),
-                    do_initial_copy: do_initial_copy__.unwrap_or_default(),
-                    publication_name: publication_name__.unwrap_or_default(),
+                        GeneratedField::DestinationTableNameRegex => {
+                            if destination_table_name_regex__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("destinationTableNameRegex"));
+                            }
+                            destination_table_name_regex__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::DestinationTableNameReplacement => {
+                            if destination_table_name_replacement__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("destinationTableNameReplacement"));
+                            }
+                            destination_table_name_replacement__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::FanIn => {
+                            if fan_in__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("fanIn"));
+                            }
+                            fan_in__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::FanInSourceColumn => {
+                            if fan_in_source_column__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("fanInSourceColumn"));
+                            }
+                            fan_in_source_column__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::AdditionalDestinations => {
+                            if additional_destinations__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("additionalDestinations"));
+                            }
+                            additional_destinations__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::SystemColumns => {
+                            if system_columns__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("systemColumns"));
+                            }
+                            system_columns__ = map.next_value()?;
+                        }
+                        GeneratedField::HistoryMode => {
+                            if history_mode__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("historyMode"));
+                            }
+                            history_mode__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::DirectApply => {
+                            if direct_apply__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("directApply"));
+                            }
+                            direct_apply__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::CdcOutputFormat => {
+                            if cdc_output_format__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("cdcOutputFormat"));
+                            }
+                            cdc_output_format__ = Some(map.next_value::<QRepOutputFormat>()? as i32);
+                        }
+                        GeneratedField::DeadLetter => {
+                            if dead_letter__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("deadLetter"));
+                            }
+                            dead_letter__ = map.next_value()?;
+                        }
+                        GeneratedField::SnowflakeTableOptions => {
+                            if snowflake_table_options__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("snowflakeTableOptions"));
+                            }
+                            snowflake_table_options__ = Some(
+                                map.next_value::<std::collections::HashMap<_, _>>()?
+                            );
+                        }
+                        GeneratedField::BigqueryTableOptions => {
+                            if bigquery_table_options__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("bigqueryTableOptions"));
+                            }
+                            bigquery_table_options__ = Some(
+                                map.next_value::<std::collections::HashMap<_, _>>()?
+                            );
+                        }
+                        GeneratedField::__SkipField__ => {
+                            let _ = map.next_value::<serde::de::IgnoredAny>()?;
+                        }
+                    }
+                }
+                Ok(FlowConnectionConfigs {
+                    source: source__,
+                    destination: destination__,
+                    flow_job_name: flow_job_name__.unwrap_or_default(),
+                    table_schema: table_schema__,
+                    table_name_mapping: table_name_mapping__.unwrap_or_default(),
+                    src_table_id_name_mapping: src_table_id_name_mapping__.unwrap_or_default(),
+                    table_name_schema_mapping: table_name_schema_mapping__.unwrap_or_default(),
+                    metadata_peer: metadata_peer__,
+                    max_batch_size: max_batch_size__.unwrap_or_default(),
+                    do_initial_copy: do_initial_copy__.unwrap_or_default(),
+                    publication_name: publication_name__.unwrap_or_default(),
                     snapshot_num_rows_per_partition: snapshot_num_rows_per_partition__.unwrap_or_default(),
                     snapshot_max_parallel_workers: snapshot_max_parallel_workers__.unwrap_or_default(),
                     snapshot_num_tables_in_parallel: snapshot_num_tables_in_parallel__.unwrap_or_default(),
@@ -1252,6 +1825,19 @@ impl<'de> serde::Deserialize<'de> for FlowConnectionConfigs {
                     cdc_staging_path: cdc_staging_path__.unwrap_or_default(),
                     soft_delete: soft_delete__.unwrap_or_default(),
                     replication_slot_name: replication_slot_name__.unwrap_or_default(),
+                    destination_table_name_template: destination_table_name_template__.unwrap_or_default(),
+                    destination_table_name_regex: destination_table_name_regex__.unwrap_or_default(),
+                    destination_table_name_replacement: destination_table_name_replacement__.unwrap_or_default(),
+                    fan_in: fan_in__.unwrap_or_default(),
+                    fan_in_source_column: fan_in_source_column__.unwrap_or_default(),
+                    additional_destinations: additional_destinations__.unwrap_or_default(),
+                    system_columns: system_columns__,
+                    history_mode: history_mode__.unwrap_or_default(),
+                    direct_apply: direct_apply__.unwrap_or_default(),
+                    cdc_output_format: cdc_output_format__.unwrap_or_default(),
+                    dead_letter: dead_letter__,
+                    snowflake_table_options: snowflake_table_options__.unwrap_or_default(),
+                    bigquery_table_options: bigquery_table_options__.unwrap_or_default(),
                 })
             }
         }
@@ -1913,6 +2499,119 @@ impl<'de> serde::Deserialize<'de> for NormalizeFlowOptions {
         deserializer.deserialize_struct("peerdb_flow.NormalizeFlowOptions", FIELDS, GeneratedVisitor)
     }
 }
+impl serde::Serialize for ObjectPartitionRange {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if !self.keys.is_empty() {
+            len += 1;
+        }
+        if self.last_modified.is_some() {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("peerdb_flow.ObjectPartitionRange", len)?;
+        if !self.keys.is_empty() {
+            struct_ser.serialize_field("keys", &self.keys)?;
+        }
+        if let Some(v) = self.last_modified.as_ref() {
+            struct_ser.serialize_field("lastModified", v)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for ObjectPartitionRange {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "keys",
+            "last_modified",
+            "lastModified",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            Keys,
+            LastModified,
+            __SkipField__,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "keys" => Ok(GeneratedField::Keys),
+                            "lastModified" | "last_modified" => Ok(GeneratedField::LastModified),
+                            _ => Ok(GeneratedField::__SkipField__),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = ObjectPartitionRange;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct peerdb_flow.ObjectPartitionRange")
+            }
+
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<ObjectPartitionRange, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut keys__ = None;
+                let mut last_modified__ = None;
+                while let Some(k) = map.next_key()? {
+                    match k {
+                        GeneratedField::Keys => {
+                            if keys__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("keys"));
+                            }
+                            keys__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::LastModified => {
+                            if last_modified__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("lastModified"));
+                            }
+                            last_modified__ = map.next_value()?;
+                        }
+                        GeneratedField::__SkipField__ => {
+                            let _ = map.next_value::<serde::de::IgnoredAny>()?;
+                        }
+                    }
+                }
+                Ok(ObjectPartitionRange {
+                    keys: keys__.unwrap_or_default(),
+                    last_modified: last_modified__,
+                })
+            }
+        }
+        deserializer.deserialize_struct("peerdb_flow.ObjectPartitionRange", FIELDS, GeneratedVisitor)
+    }
+}
 impl serde::Serialize for PartitionRange {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
@@ -1936,6 +2635,15 @@ impl serde::Serialize for PartitionRange {
                 partition_range::Range::TidRange(v) => {
                     struct_ser.serialize_field("tidRange", v)?;
                 }
+                partition_range::Range::ObjectRange(v) => {
+                    struct_ser.serialize_field("objectRange", v)?;
+                }
+                partition_range::Range::StringRange(v) => {
+                    struct_ser.serialize_field("stringRange", v)?;
+                }
+                partition_range::Range::TupleRange(v) => {
+                    struct_ser.serialize_field("tupleRange", v)?;
+                }
             }
         }
         struct_ser.end()
@@ -1954,6 +2662,12 @@ impl<'de> serde::Deserialize<'de> for PartitionRange {
             "timestampRange",
             "tid_range",
             "tidRange",
+            "object_range",
+            "objectRange",
+            "string_range",
+            "stringRange",
+            "tuple_range",
+            "tupleRange",
         ];
 
         #[allow(clippy::enum_variant_names)]
@@ -1961,6 +2675,9 @@ impl<'de> serde::Deserialize<'de> for PartitionRange {
             IntRange,
             TimestampRange,
             TidRange,
+            ObjectRange,
+            StringRange,
+            TupleRange,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -1986,6 +2703,9 @@ impl<'de> serde::Deserialize<'de> for PartitionRange {
                             "intRange" | "int_range" => Ok(GeneratedField::IntRange),
                             "timestampRange" | "timestamp_range" => Ok(GeneratedField::TimestampRange),
                             "tidRange" | "tid_range" => Ok(GeneratedField::TidRange),
+                            "objectRange" | "object_range" => Ok(GeneratedField::ObjectRange),
+                            "stringRange" | "string_range" => Ok(GeneratedField::StringRange),
+                            "tupleRange" | "tuple_range" => Ok(GeneratedField::TupleRange),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -2027,6 +2747,27 @@ impl<'de> serde::Deserialize<'de> for PartitionRange {
                                 return Err(serde::de::Error::duplicate_field("tidRange"));
                             }
                             range__ = map.next_value::<::std::option::Option<_>>()?.map(partition_range::Range::TidRange)
+;
+                        }
+                        GeneratedField::ObjectRange => {
+                            if range__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("objectRange"));
+                            }
+                            range__ = map.next_value::<::std::option::Option<_>>()?.map(partition_range::Range::ObjectRange)
+;
+                        }
+                        GeneratedField::StringRange => {
+                            if range__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("stringRange"));
+                            }
+                            range__ = map.next_value::<::std::option::Option<_>>()?.map(partition_range::Range::StringRange)
+;
+                        }
+                        GeneratedField::TupleRange => {
+                            if range__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("tupleRange"));
+                            }
+                            range__ = map.next_value::<::std::option::Option<_>>()?.map(partition_range::Range::TupleRange)
 ;
                         }
                         GeneratedField::__SkipField__ => {
@@ -2042,7 +2783,7 @@ impl<'de> serde::Deserialize<'de> for PartitionRange {
         deserializer.deserialize_struct("peerdb_flow.PartitionRange", FIELDS, GeneratedVisitor)
     }
 }
-impl serde::Serialize for PostgresTableIdentifier {
+impl serde::Serialize for PartitionValue {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
     where
@@ -2050,30 +2791,58 @@ impl serde::Serialize for PostgresTableIdentifier {
     {
         use serde::ser::SerializeStruct;
         let mut len = 0;
-        if self.rel_id != 0 {
+        if self.r#type != 0 {
             len += 1;
         }
-        let mut struct_ser = serializer.serialize_struct("peerdb_flow.PostgresTableIdentifier", len)?;
-        if self.rel_id != 0 {
-            struct_ser.serialize_field("relId", &self.rel_id)?;
+        if self.int_value != 0 {
+            len += 1;
+        }
+        if self.timestamp_value.is_some() {
+            len += 1;
+        }
+        if !self.string_value.is_empty() {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("peerdb_flow.PartitionValue", len)?;
+        if self.r#type != 0 {
+            let v = PartitionValueType::from_i32(self.r#type)
+                .ok_or_else(|| serde::ser::Error::custom(format!("Invalid variant {}", self.r#type)))?;
+            struct_ser.serialize_field("type", &v)?;
+        }
+        if self.int_value != 0 {
+            struct_ser.serialize_field("intValue", ToString::to_string(&self.int_value).as_str())?;
+        }
+        if let Some(v) = self.timestamp_value.as_ref() {
+            struct_ser.serialize_field("timestampValue", v)?;
+        }
+        if !self.string_value.is_empty() {
+            struct_ser.serialize_field("stringValue", &self.string_value)?;
         }
         struct_ser.end()
     }
 }
-impl<'de> serde::Deserialize<'de> for PostgresTableIdentifier {
+impl<'de> serde::Deserialize<'de> for PartitionValue {
     #[allow(deprecated)]
     fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
     where
         D: serde::Deserializer<'de>,
     {
         const FIELDS: &[&str] = &[
-            "rel_id",
-            "relId",
+            "type",
+            "int_value",
+            "intValue",
+            "timestamp_value",
+            "timestampValue",
+            "string_value",
+            "stringValue",
         ];
 
         #[allow(clippy::enum_variant_names)]
         enum GeneratedField {
-            RelId,
+            Type,
+            IntValue,
+            TimestampValue,
+            StringValue,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -2096,7 +2865,10 @@ impl<'de> serde::Deserialize<'de> for PostgresTableIdentifier {
                         E: serde::de::Error,
                     {
                         match value {
-                            "relId" | "rel_id" => Ok(GeneratedField::RelId),
+                            "type" => Ok(GeneratedField::Type),
+                            "intValue" | "int_value" => Ok(GeneratedField::IntValue),
+                            "timestampValue" | "timestamp_value" => Ok(GeneratedField::TimestampValue),
+                            "stringValue" | "string_value" => Ok(GeneratedField::StringValue),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -2106,41 +2878,141 @@ impl<'de> serde::Deserialize<'de> for PostgresTableIdentifier {
         }
         struct GeneratedVisitor;
         impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
-            type Value = PostgresTableIdentifier;
+            type Value = PartitionValue;
 
             fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
-                formatter.write_str("struct peerdb_flow.PostgresTableIdentifier")
+                formatter.write_str("struct peerdb_flow.PartitionValue")
             }
 
-            fn visit_map<V>(self, mut map: V) -> std::result::Result<PostgresTableIdentifier, V::Error>
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<PartitionValue, V::Error>
                 where
                     V: serde::de::MapAccess<'de>,
             {
-                let mut rel_id__ = None;
+                let mut r#type__ = None;
+                let mut int_value__ = None;
+                let mut timestamp_value__ = None;
+                let mut string_value__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
-                        GeneratedField::RelId => {
-                            if rel_id__.is_some() {
-                                return Err(serde::de::Error::duplicate_field("relId"));
+                        GeneratedField::Type => {
+                            if r#type__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("type"));
                             }
-                            rel_id__ = 
+                            r#type__ = Some(map.next_value::<PartitionValueType>()? as i32);
+                        }
+                        GeneratedField::IntValue => {
+                            if int_value__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("intValue"));
+                            }
+                            int_value__ = 
                                 Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
                             ;
                         }
+                        GeneratedField::TimestampValue => {
+                            if timestamp_value__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("timestampValue"));
+                            }
+                            timestamp_value__ = map.next_value()?;
+                        }
+                        GeneratedField::StringValue => {
+                            if string_value__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("stringValue"));
+                            }
+                            string_value__ = Some(map.next_value()?);
+                        }
                         GeneratedField::__SkipField__ => {
                             let _ = map.next_value::<serde::de::IgnoredAny>()?;
                         }
                     }
                 }
-                Ok(PostgresTableIdentifier {
-                    rel_id: rel_id__.unwrap_or_default(),
+                Ok(PartitionValue {
+                    r#type: r#type__.unwrap_or_default(),
+                    int_value: int_value__.unwrap_or_default(),
+                    timestamp_value: timestamp_value__,
+                    string_value: string_value__.unwrap_or_default(),
                 })
             }
         }
-        deserializer.deserialize_struct("peerdb_flow.PostgresTableIdentifier", FIELDS, GeneratedVisitor)
+        deserializer.deserialize_struct("peerdb_flow.PartitionValue", FIELDS, GeneratedVisitor)
     }
 }
-impl serde::Serialize for QRepConfig {
+impl serde::Serialize for PartitionValueType {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        let variant = match self {
+            Self::Int => "PARTITION_VALUE_TYPE_INT",
+            Self::Timestamp => "PARTITION_VALUE_TYPE_TIMESTAMP",
+            Self::String => "PARTITION_VALUE_TYPE_STRING",
+        };
+        serializer.serialize_str(variant)
+    }
+}
+impl<'de> serde::Deserialize<'de> for PartitionValueType {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "PARTITION_VALUE_TYPE_INT",
+            "PARTITION_VALUE_TYPE_TIMESTAMP",
+            "PARTITION_VALUE_TYPE_STRING",
+        ];
+
+        struct GeneratedVisitor;
+
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = PartitionValueType;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                write!(formatter, "expected one of: {:?}", &FIELDS)
+            }
+
+            fn visit_i64<E>(self, v: i64) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                use std::convert::TryFrom;
+                i32::try_from(v)
+                    .ok()
+                    .and_then(PartitionValueType::from_i32)
+                    .ok_or_else(|| {
+                        serde::de::Error::invalid_value(serde::de::Unexpected::Signed(v), &self)
+                    })
+            }
+
+            fn visit_u64<E>(self, v: u64) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                use std::convert::TryFrom;
+                i32::try_from(v)
+                    .ok()
+                    .and_then(PartitionValueType::from_i32)
+                    .ok_or_else(|| {
+                        serde::de::Error::invalid_value(serde::de::Unexpected::Unsigned(v), &self)
+                    })
+            }
+
+            fn visit_str<E>(self, value: &str) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                match value {
+                    "PARTITION_VALUE_TYPE_INT" => Ok(PartitionValueType::Int),
+                    "PARTITION_VALUE_TYPE_TIMESTAMP" => Ok(PartitionValueType::Timestamp),
+                    "PARTITION_VALUE_TYPE_STRING" => Ok(PartitionValueType::String),
+                    _ => Err(serde::de::Error::unknown_variant(value, FIELDS)),
+                }
+            }
+        }
+        deserializer.deserialize_any(GeneratedVisitor)
+    }
+}
+impl serde::Serialize for PostgresTableIdentifier {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
     where
@@ -2148,20 +3020,118 @@ impl serde::Serialize for QRepConfig {
     {
         use serde::ser::SerializeStruct;
         let mut len = 0;
-        if !self.flow_job_name.is_empty() {
-            len += 1;
-        }
-        if self.source_peer.is_some() {
-            len += 1;
-        }
-        if self.destination_peer.is_some() {
-            len += 1;
-        }
-        if !self.destination_table_identifier.is_empty() {
+        if self.rel_id != 0 {
             len += 1;
         }
-        if !self.query.is_empty() {
-            len += 1;
+        let mut struct_ser = serializer.serialize_struct("peerdb_flow.PostgresTableIdentifier", len)?;
+        if self.rel_id != 0 {
+            struct_ser.serialize_field("relId", &self.rel_id)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for PostgresTableIdentifier {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "rel_id",
+            "relId",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            RelId,
+            __SkipField__,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "relId" | "rel_id" => Ok(GeneratedField::RelId),
+                            _ => Ok(GeneratedField::__SkipField__),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = PostgresTableIdentifier;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct peerdb_flow.PostgresTableIdentifier")
+            }
+
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<PostgresTableIdentifier, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut rel_id__ = None;
+                while let Some(k) = map.next_key()? {
+                    match k {
+                        GeneratedField::RelId => {
+                            if rel_id__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("relId"));
+                            }
+                            rel_id__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::__SkipField__ => {
+                            let _ = map.next_value::<serde::de::IgnoredAny>()?;
+                        }
+                    }
+                }
+                Ok(PostgresTableIdentifier {
+                    rel_id: rel_id__.unwrap_or_default(),
+                })
+            }
+        }
+        deserializer.deserialize_struct("peerdb_flow.PostgresTableIdentifier", FIELDS, GeneratedVisitor)
+    }
+}
+impl serde::Serialize for QRepConfig {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if !self.flow_job_name.is_empty() {
+            len += 1;
+        }
+        if self.source_peer.is_some() {
+            len += 1;
+        }
+        if self.destination_peer.is_some() {
+            len += 1;
+        }
+        if !self.destination_table_identifier.is_empty() {
+            len += 1;
+        }
+        if !self.query.is_empty() {
+            len += 1;
         }
         if !self.watermark_table.is_empty() {
             len += 1;
@@ -2196,6 +3166,39 @@ impl serde::Serialize for QRepConfig {
         if self.num_rows_per_partition != 0 {
             len += 1;
         }
+        if self.output_format != 0 {
+            len += 1;
+        }
+        if !self.output_compression.is_empty() {
+            len += 1;
+        }
+        if self.parquet_row_group_size != 0 {
+            len += 1;
+        }
+        if self.target_file_size_bytes != 0 {
+            len += 1;
+        }
+        if self.num_objects_per_partition != 0 {
+            len += 1;
+        }
+        if self.num_key_partitions != 0 {
+            len += 1;
+        }
+        if self.delete_mode != 0 {
+            len += 1;
+        }
+        if !self.soft_delete_column.is_empty() {
+            len += 1;
+        }
+        if self.reconcile_interval_seconds != 0 {
+            len += 1;
+        }
+        if !self.schedule_cron.is_empty() {
+            len += 1;
+        }
+        if !self.run_windows.is_empty() {
+            len += 1;
+        }
         let mut struct_ser = serializer.serialize_struct("peerdb_flow.QRepConfig", len)?;
         if !self.flow_job_name.is_empty() {
             struct_ser.serialize_field("flowJobName", &self.flow_job_name)?;
@@ -2247,6 +3250,43 @@ impl serde::Serialize for QRepConfig {
         if self.num_rows_per_partition != 0 {
             struct_ser.serialize_field("numRowsPerPartition", &self.num_rows_per_partition)?;
         }
+        if self.output_format != 0 {
+            let v = QRepOutputFormat::from_i32(self.output_format)
+                .ok_or_else(|| serde::ser::Error::custom(format!("Invalid variant {}", self.output_format)))?;
+            struct_ser.serialize_field("outputFormat", &v)?;
+        }
+        if !self.output_compression.is_empty() {
+            struct_ser.serialize_field("outputCompression", &self.output_compression)?;
+        }
+        if self.parquet_row_group_size != 0 {
+            struct_ser.serialize_field("parquetRowGroupSize", &self.parquet_row_group_size)?;
+        }
+        if self.target_file_size_bytes != 0 {
+            struct_ser.serialize_field("targetFileSizeBytes", ToString::to_string(&self.target_file_size_bytes).as_str())?;
+        }
+        if self.num_objects_per_partition != 0 {
+            struct_ser.serialize_field("numObjectsPerPartition", &self.num_objects_per_partition)?;
+        }
+        if self.num_key_partitions != 0 {
+            struct_ser.serialize_field("numKeyPartitions", &self.num_key_partitions)?;
+        }
+        if self.delete_mode != 0 {
+            let v = QRepDeleteMode::from_i32(self.delete_mode)
+                .ok_or_else(|| serde::ser::Error::custom(format!("Invalid variant {}", self.delete_mode)))?;
+            struct_ser.serialize_field("deleteMode", &v)?;
+        }
+        if !self.soft_delete_column.is_empty() {
+            struct_ser.serialize_field("softDeleteColumn", &self.soft_delete_column)?;
+        }
+        if self.reconcile_interval_seconds != 0 {
+            struct_ser.serialize_field("reconcileIntervalSeconds", &self.reconcile_interval_seconds)?;
+        }
+        if !self.schedule_cron.is_empty() {
+            struct_ser.serialize_field("scheduleCron", &self.schedule_cron)?;
+        }
+        if !self.run_windows.is_empty() {
+            struct_ser.serialize_field("runWindows", &self.run_windows)?;
+        }
         struct_ser.end()
     }
 }
@@ -2288,6 +3328,28 @@ impl<'de> serde::Deserialize<'de> for QRepConfig {
             "stagingPath",
             "num_rows_per_partition",
             "numRowsPerPartition",
+            "output_format",
+            "outputFormat",
+            "output_compression",
+            "outputCompression",
+            "parquet_row_group_size",
+            "parquetRowGroupSize",
+            "target_file_size_bytes",
+            "targetFileSizeBytes",
+            "num_objects_per_partition",
+            "numObjectsPerPartition",
+            "num_key_partitions",
+            "numKeyPartitions",
+            "delete_mode",
+            "deleteMode",
+            "soft_delete_column",
+            "softDeleteColumn",
+            "reconcile_interval_seconds",
+            "reconcileIntervalSeconds",
+            "schedule_cron",
+            "scheduleCron",
+            "run_windows",
+            "runWindows",
         ];
 
         #[allow(clippy::enum_variant_names)]
@@ -2308,6 +3370,17 @@ impl<'de> serde::Deserialize<'de> for QRepConfig {
             WriteMode,
             StagingPath,
             NumRowsPerPartition,
+            OutputFormat,
+            OutputCompression,
+            ParquetRowGroupSize,
+            TargetFileSizeBytes,
+            NumObjectsPerPartition,
+            NumKeyPartitions,
+            DeleteMode,
+            SoftDeleteColumn,
+            ReconcileIntervalSeconds,
+            ScheduleCron,
+            RunWindows,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -2346,6 +3419,17 @@ impl<'de> serde::Deserialize<'de> for QRepConfig {
                             "writeMode" | "write_mode" => Ok(GeneratedField::WriteMode),
                             "stagingPath" | "staging_path" => Ok(GeneratedField::StagingPath),
                             "numRowsPerPartition" | "num_rows_per_partition" => Ok(GeneratedField::NumRowsPerPartition),
+                            "outputFormat" | "output_format" => Ok(GeneratedField::OutputFormat),
+                            "outputCompression" | "output_compression" => Ok(GeneratedField::OutputCompression),
+                            "parquetRowGroupSize" | "parquet_row_group_size" => Ok(GeneratedField::ParquetRowGroupSize),
+                            "targetFileSizeBytes" | "target_file_size_bytes" => Ok(GeneratedField::TargetFileSizeBytes),
+                            "numObjectsPerPartition" | "num_objects_per_partition" => Ok(GeneratedField::NumObjectsPerPartition),
+                            "numKeyPartitions" | "num_key_partitions" => Ok(GeneratedField::NumKeyPartitions),
+                            "deleteMode" | "delete_mode" => Ok(GeneratedField::DeleteMode),
+                            "softDeleteColumn" | "soft_delete_column" => Ok(GeneratedField::SoftDeleteColumn),
+                            "reconcileIntervalSeconds" | "reconcile_interval_seconds" => Ok(GeneratedField::ReconcileIntervalSeconds),
+                            "scheduleCron" | "schedule_cron" => Ok(GeneratedField::ScheduleCron),
+                            "runWindows" | "run_windows" => Ok(GeneratedField::RunWindows),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -2381,6 +3465,17 @@ impl<'de> serde::Deserialize<'de> for QRepConfig {
                 let mut write_mode__ = None;
                 let mut staging_path__ = None;
                 let mut num_rows_per_partition__ = None;
+                let mut output_format__ = None;
+                let mut output_compression__ = None;
+                let mut parquet_row_group_size__ = None;
+                let mut target_file_size_bytes__ = None;
+                let mut num_objects_per_partition__ = None;
+                let mut num_key_partitions__ = None;
+                let mut delete_mode__ = None;
+                let mut soft_delete_column__ = None;
+                let mut reconcile_interval_seconds__ = None;
+                let mut schedule_cron__ = None;
+                let mut run_windows__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
                         GeneratedField::FlowJobName => {
@@ -2489,6 +3584,82 @@ impl<'de> serde::Deserialize<'de> for QRepConfig {
                                 Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
                             ;
                         }
+                        GeneratedField::OutputFormat => {
+                            if output_format__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("outputFormat"));
+                            }
+                            output_format__ = Some(map.next_value::<QRepOutputFormat>()? as i32);
+                        }
+                        GeneratedField::OutputCompression => {
+                            if output_compression__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("outputCompression"));
+                            }
+                            output_compression__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::ParquetRowGroupSize => {
+                            if parquet_row_group_size__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("parquetRowGroupSize"));
+                            }
+                            parquet_row_group_size__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::TargetFileSizeBytes => {
+                            if target_file_size_bytes__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("targetFileSizeBytes"));
+                            }
+                            target_file_size_bytes__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::NumObjectsPerPartition => {
+                            if num_objects_per_partition__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("numObjectsPerPartition"));
+                            }
+                            num_objects_per_partition__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::NumKeyPartitions => {
+                            if num_key_partitions__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("numKeyPartitions"));
+                            }
+                            num_key_partitions__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::DeleteMode => {
+                            if delete_mode__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("deleteMode"));
+                            }
+                            delete_mode__ = Some(map.next_value::<QRepDeleteMode>()? as i32);
+                        }
+                        GeneratedField::SoftDeleteColumn => {
+                            if soft_delete_column__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("softDeleteColumn"));
+                            }
+                            soft_delete_column__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::ReconcileIntervalSeconds => {
+                            if reconcile_interval_seconds__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("reconcileIntervalSeconds"));
+                            }
+                            reconcile_interval_seconds__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::ScheduleCron => {
+                            if schedule_cron__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("scheduleCron"));
+                            }
+                            schedule_cron__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::RunWindows => {
+                            if run_windows__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("runWindows"));
+                            }
+                            run_windows__ = Some(map.next_value()?);
+                        }
                         GeneratedField::__SkipField__ => {
                             let _ = map.next_value::<serde::de::IgnoredAny>()?;
                         }
@@ -2511,106 +3682,266 @@ impl<'de> serde::Deserialize<'de> for QRepConfig {
                     write_mode: write_mode__,
                     staging_path: staging_path__.unwrap_or_default(),
                     num_rows_per_partition: num_rows_per_partition__.unwrap_or_default(),
+                    output_format: output_format__.unwrap_or_default(),
+                    output_compression: output_compression__.unwrap_or_default(),
+                    parquet_row_group_size: parquet_row_group_size__.unwrap_or_default(),
+                    target_file_size_bytes: target_file_size_bytes__.unwrap_or_default(),
+                    num_objects_per_partition: num_objects_per_partition__.unwrap_or_default(),
+                    num_key_partitions: num_key_partitions__.unwrap_or_default(),
+                    delete_mode: delete_mode__.unwrap_or_default(),
+                    soft_delete_column: soft_delete_column__.unwrap_or_default(),
+                    reconcile_interval_seconds: reconcile_interval_seconds__.unwrap_or_default(),
+                    schedule_cron: schedule_cron__.unwrap_or_default(),
+                    run_windows: run_windows__.unwrap_or_default(),
                 })
             }
         }
         deserializer.deserialize_struct("peerdb_flow.QRepConfig", FIELDS, GeneratedVisitor)
     }
 }
-impl serde::Serialize for QRepParitionResult {
+impl serde::Serialize for QRepDeleteMode {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
     where
         S: serde::Serializer,
     {
-        use serde::ser::SerializeStruct;
-        let mut len = 0;
-        if !self.partitions.is_empty() {
-            len += 1;
-        }
-        let mut struct_ser = serializer.serialize_struct("peerdb_flow.QRepParitionResult", len)?;
-        if !self.partitions.is_empty() {
-            struct_ser.serialize_field("partitions", &self.partitions)?;
-        }
-        struct_ser.end()
+        let variant = match self {
+            Self::QrepDeleteModeNone => "QREP_DELETE_MODE_NONE",
+            Self::QrepDeleteModeHard => "QREP_DELETE_MODE_HARD",
+            Self::QrepDeleteModeSoft => "QREP_DELETE_MODE_SOFT",
+        };
+        serializer.serialize_str(variant)
     }
 }
-impl<'de> serde::Deserialize<'de> for QRepParitionResult {
+impl<'de> serde::Deserialize<'de> for QRepDeleteMode {
     #[allow(deprecated)]
     fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
     where
         D: serde::Deserializer<'de>,
     {
         const FIELDS: &[&str] = &[
-            "partitions",
+            "QREP_DELETE_MODE_NONE",
+            "QREP_DELETE_MODE_HARD",
+            "QREP_DELETE_MODE_SOFT",
         ];
 
-        #[allow(clippy::enum_variant_names)]
-        enum GeneratedField {
-            Partitions,
-            __SkipField__,
-        }
-        impl<'de> serde::Deserialize<'de> for GeneratedField {
-            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
-            where
-                D: serde::Deserializer<'de>,
-            {
-                struct GeneratedVisitor;
-
-                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
-                    type Value = GeneratedField;
-
-                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
-                        write!(formatter, "expected one of: {:?}", &FIELDS)
-                    }
-
-                    #[allow(unused_variables)]
-                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
-                    where
-                        E: serde::de::Error,
-                    {
-                        match value {
-                            "partitions" => Ok(GeneratedField::Partitions),
-                            _ => Ok(GeneratedField::__SkipField__),
-                        }
-                    }
-                }
-                deserializer.deserialize_identifier(GeneratedVisitor)
-            }
-        }
         struct GeneratedVisitor;
+
         impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
-            type Value = QRepParitionResult;
+            type Value = QRepDeleteMode;
 
             fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
-                formatter.write_str("struct peerdb_flow.QRepParitionResult")
+                write!(formatter, "expected one of: {:?}", &FIELDS)
             }
 
-            fn visit_map<V>(self, mut map: V) -> std::result::Result<QRepParitionResult, V::Error>
-                where
-                    V: serde::de::MapAccess<'de>,
+            fn visit_i64<E>(self, v: i64) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
             {
-                let mut partitions__ = None;
-                while let Some(k) = map.next_key()? {
-                    match k {
-                        GeneratedField::Partitions => {
-                            if partitions__.is_some() {
-                                return Err(serde::de::Error::duplicate_field("partitions"));
-                            }
-                            partitions__ = Some(map.next_value()?);
-                        }
-                        GeneratedField::__SkipField__ => {
-                            let _ = map.next_value::<serde::de::IgnoredAny>()?;
-                        }
-                    }
-                }
-                Ok(QRepParitionResult {
-                    partitions: partitions__.unwrap_or_default(),
-                })
+                use std::convert::TryFrom;
+                i32::try_from(v)
+                    .ok()
+                    .and_then(QRepDeleteMode::from_i32)
+                    .ok_or_else(|| {
+                        serde::de::Error::invalid_value(serde::de::Unexpected::Signed(v), &self)
+                    })
             }
-        }
-        deserializer.deserialize_struct("peerdb_flow.QRepParitionResult", FIELDS, GeneratedVisitor)
-    }
+
+            fn visit_u64<E>(self, v: u64) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                use std::convert::TryFrom;
+                i32::try_from(v)
+                    .ok()
+                    .and_then(QRepDeleteMode::from_i32)
+                    .ok_or_else(|| {
+                        serde::de::Error::invalid_value(serde::de::Unexpected::Unsigned(v), &self)
+                    })
+            }
+
+            fn visit_str<E>(self, value: &str) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                match value {
+                    "QREP_DELETE_MODE_NONE" => Ok(QRepDeleteMode::QrepDeleteModeNone),
+                    "QREP_DELETE_MODE_HARD" => Ok(QRepDeleteMode::QrepDeleteModeHard),
+                    "QREP_DELETE_MODE_SOFT" => Ok(QRepDeleteMode::QrepDeleteModeSoft),
+                    _ => Err(serde::de::Error::unknown_variant(value, FIELDS)),
+                }
+            }
+        }
+        deserializer.deserialize_any(GeneratedVisitor)
+    }
+}
+impl serde::Serialize for QRepOutputFormat {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        let variant = match self {
+            Self::QrepOutputFormatAvro => "QREP_OUTPUT_FORMAT_AVRO",
+            Self::QrepOutputFormatParquet => "QREP_OUTPUT_FORMAT_PARQUET",
+        };
+        serializer.serialize_str(variant)
+    }
+}
+impl<'de> serde::Deserialize<'de> for QRepOutputFormat {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "QREP_OUTPUT_FORMAT_AVRO",
+            "QREP_OUTPUT_FORMAT_PARQUET",
+        ];
+
+        struct GeneratedVisitor;
+
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = QRepOutputFormat;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                write!(formatter, "expected one of: {:?}", &FIELDS)
+            }
+
+            fn visit_i64<E>(self, v: i64) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                use std::convert::TryFrom;
+                i32::try_from(v)
+                    .ok()
+                    .and_then(QRepOutputFormat::from_i32)
+                    .ok_or_else(|| {
+                        serde::de::Error::invalid_value(serde::de::Unexpected::Signed(v), &self)
+                    })
+            }
+
+            fn visit_u64<E>(self, v: u64) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                use std::convert::TryFrom;
+                i32::try_from(v)
+                    .ok()
+                    .and_then(QRepOutputFormat::from_i32)
+                    .ok_or_else(|| {
+                        serde::de::Error::invalid_value(serde::de::Unexpected::Unsigned(v), &self)
+                    })
+            }
+
+            fn visit_str<E>(self, value: &str) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                match value {
+                    "QREP_OUTPUT_FORMAT_AVRO" => Ok(QRepOutputFormat::QrepOutputFormatAvro),
+                    "QREP_OUTPUT_FORMAT_PARQUET" => Ok(QRepOutputFormat::QrepOutputFormatParquet),
+                    _ => Err(serde::de::Error::unknown_variant(value, FIELDS)),
+                }
+            }
+        }
+        deserializer.deserialize_any(GeneratedVisitor)
+    }
+}
+impl serde::Serialize for QRepParitionResult {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if !self.partitions.is_empty() {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("peerdb_flow.QRepParitionResult", len)?;
+        if !self.partitions.is_empty() {
+            struct_ser.serialize_field("partitions", &self.partitions)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for QRepParitionResult {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "partitions",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            Partitions,
+            __SkipField__,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "partitions" => Ok(GeneratedField::Partitions),
+                            _ => Ok(GeneratedField::__SkipField__),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = QRepParitionResult;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct peerdb_flow.QRepParitionResult")
+            }
+
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<QRepParitionResult, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut partitions__ = None;
+                while let Some(k) = map.next_key()? {
+                    match k {
+                        GeneratedField::Partitions => {
+                            if partitions__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("partitions"));
+                            }
+                            partitions__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::__SkipField__ => {
+                            let _ = map.next_value::<serde::de::IgnoredAny>()?;
+                        }
+                    }
+                }
+                Ok(QRepParitionResult {
+                    partitions: partitions__.unwrap_or_default(),
+                })
+            }
+        }
+        deserializer.deserialize_struct("peerdb_flow.QRepParitionResult", FIELDS, GeneratedVisitor)
+    }
 }
 impl serde::Serialize for QRepPartition {
     #[allow(deprecated)]
@@ -2867,6 +4198,7 @@ impl serde::Serialize for QRepSyncMode {
         let variant = match self {
             Self::QrepSyncModeMultiInsert => "QREP_SYNC_MODE_MULTI_INSERT",
             Self::QrepSyncModeStorageAvro => "QREP_SYNC_MODE_STORAGE_AVRO",
+            Self::QrepSyncModeStorageWrite => "QREP_SYNC_MODE_STORAGE_WRITE",
         };
         serializer.serialize_str(variant)
     }
@@ -2880,6 +4212,7 @@ impl<'de> serde::Deserialize<'de> for QRepSyncMode {
         const FIELDS: &[&str] = &[
             "QREP_SYNC_MODE_MULTI_INSERT",
             "QREP_SYNC_MODE_STORAGE_AVRO",
+            "QREP_SYNC_MODE_STORAGE_WRITE",
         ];
 
         struct GeneratedVisitor;
@@ -2924,6 +4257,7 @@ impl<'de> serde::Deserialize<'de> for QRepSyncMode {
                 match value {
                     "QREP_SYNC_MODE_MULTI_INSERT" => Ok(QRepSyncMode::QrepSyncModeMultiInsert),
                     "QREP_SYNC_MODE_STORAGE_AVRO" => Ok(QRepSyncMode::QrepSyncModeStorageAvro),
+                    "QREP_SYNC_MODE_STORAGE_WRITE" => Ok(QRepSyncMode::QrepSyncModeStorageWrite),
                     _ => Err(serde::de::Error::unknown_variant(value, FIELDS)),
                 }
             }
@@ -3134,6 +4468,21 @@ impl serde::Serialize for SetupNormalizedTableBatchInput {
         if !self.table_name_schema_mapping.is_empty() {
             len += 1;
         }
+        if self.soft_delete {
+            len += 1;
+        }
+        if self.system_columns.is_some() {
+            len += 1;
+        }
+        if self.history_mode {
+            len += 1;
+        }
+        if !self.snowflake_table_options.is_empty() {
+            len += 1;
+        }
+        if !self.bigquery_table_options.is_empty() {
+            len += 1;
+        }
         let mut struct_ser = serializer.serialize_struct("peerdb_flow.SetupNormalizedTableBatchInput", len)?;
         if let Some(v) = self.peer_connection_config.as_ref() {
             struct_ser.serialize_field("peerConnectionConfig", v)?;
@@ -3141,6 +4490,21 @@ impl serde::Serialize for SetupNormalizedTableBatchInput {
         if !self.table_name_schema_mapping.is_empty() {
             struct_ser.serialize_field("tableNameSchemaMapping", &self.table_name_schema_mapping)?;
         }
+        if self.soft_delete {
+            struct_ser.serialize_field("softDelete", &self.soft_delete)?;
+        }
+        if let Some(v) = self.system_columns.as_ref() {
+            struct_ser.serialize_field("systemColumns", v)?;
+        }
+        if self.history_mode {
+            struct_ser.serialize_field("historyMode", &self.history_mode)?;
+        }
+        if !self.snowflake_table_options.is_empty() {
+            struct_ser.serialize_field("snowflakeTableOptions", &self.snowflake_table_options)?;
+        }
+        if !self.bigquery_table_options.is_empty() {
+            struct_ser.serialize_field("bigqueryTableOptions", &self.bigquery_table_options)?;
+        }
         struct_ser.end()
     }
 }
@@ -3155,12 +4519,27 @@ impl<'de> serde::Deserialize<'de> for SetupNormalizedTableBatchInput {
             "peerConnectionConfig",
             "table_name_schema_mapping",
             "tableNameSchemaMapping",
+            "soft_delete",
+            "softDelete",
+            "system_columns",
+            "systemColumns",
+            "history_mode",
+            "historyMode",
+            "snowflake_table_options",
+            "snowflakeTableOptions",
+            "bigquery_table_options",
+            "bigqueryTableOptions",
         ];
 
         #[allow(clippy::enum_variant_names)]
         enum GeneratedField {
             PeerConnectionConfig,
             TableNameSchemaMapping,
+            SoftDelete,
+            SystemColumns,
+            HistoryMode,
+            SnowflakeTableOptions,
+            BigqueryTableOptions,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -3185,6 +4564,11 @@ impl<'de> serde::Deserialize<'de> for SetupNormalizedTableBatchInput {
                         match value {
                             "peerConnectionConfig" | "peer_connection_config" => Ok(GeneratedField::PeerConnectionConfig),
                             "tableNameSchemaMapping" | "table_name_schema_mapping" => Ok(GeneratedField::TableNameSchemaMapping),
+                            "softDelete" | "soft_delete" => Ok(GeneratedField::SoftDelete),
+                            "systemColumns" | "system_columns" => Ok(GeneratedField::SystemColumns),
+                            "historyMode" | "history_mode" => Ok(GeneratedField::HistoryMode),
+                            "snowflakeTableOptions" | "snowflake_table_options" => Ok(GeneratedField::SnowflakeTableOptions),
+                            "bigqueryTableOptions" | "bigquery_table_options" => Ok(GeneratedField::BigqueryTableOptions),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -3206,6 +4590,11 @@ impl<'de> serde::Deserialize<'de> for SetupNormalizedTableBatchInput {
             {
                 let mut peer_connection_config__ = None;
                 let mut table_name_schema_mapping__ = None;
+                let mut soft_delete__ = None;
+                let mut system_columns__ = None;
+                let mut history_mode__ = None;
+                let mut snowflake_table_options__ = None;
+                let mut bigquery_table_options__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
                         GeneratedField::PeerConnectionConfig => {
@@ -3222,6 +4611,40 @@ impl<'de> serde::Deserialize<'de> for SetupNormalizedTableBatchInput {
                                 map.next_value::<std::collections::HashMap<_, _>>()?
                             );
                         }
+                        GeneratedField::SoftDelete => {
+                            if soft_delete__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("softDelete"));
+                            }
+                            soft_delete__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::SystemColumns => {
+                            if system_columns__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("systemColumns"));
+                            }
+                            system_columns__ = map.next_value()?;
+                        }
+                        GeneratedField::HistoryMode => {
+                            if history_mode__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("historyMode"));
+                            }
+                            history_mode__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::SnowflakeTableOptions => {
+                            if snowflake_table_options__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("snowflakeTableOptions"));
+                            }
+                            snowflake_table_options__ = Some(
+                                map.next_value::<std::collections::HashMap<_, _>>()?
+                            );
+                        }
+                        GeneratedField::BigqueryTableOptions => {
+                            if bigquery_table_options__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("bigqueryTableOptions"));
+                            }
+                            bigquery_table_options__ = Some(
+                                map.next_value::<std::collections::HashMap<_, _>>()?
+                            );
+                        }
                         GeneratedField::__SkipField__ => {
                             let _ = map.next_value::<serde::de::IgnoredAny>()?;
                         }
@@ -3230,6 +4653,11 @@ impl<'de> serde::Deserialize<'de> for SetupNormalizedTableBatchInput {
                 Ok(SetupNormalizedTableBatchInput {
                     peer_connection_config: peer_connection_config__,
                     table_name_schema_mapping: table_name_schema_mapping__.unwrap_or_default(),
+                    soft_delete: soft_delete__.unwrap_or_default(),
+                    system_columns: system_columns__,
+                    history_mode: history_mode__.unwrap_or_default(),
+                    snowflake_table_options: snowflake_table_options__.unwrap_or_default(),
+                    bigquery_table_options: bigquery_table_options__.unwrap_or_default(),
                 })
             }
         }
@@ -3897,10 +5325,428 @@ impl<'de> serde::Deserialize<'de> for SetupReplicationOutput {
                 })
             }
         }
-        deserializer.deserialize_struct("peerdb_flow.SetupReplicationOutput", FIELDS, GeneratedVisitor)
+        deserializer.deserialize_struct("peerdb_flow.SetupReplicationOutput", FIELDS, GeneratedVisitor)
+    }
+}
+impl serde::Serialize for SnowflakeTableOptions {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if !self.cluster_by.is_empty() {
+            len += 1;
+        }
+        if self.transient {
+            len += 1;
+        }
+        if self.data_retention_days.is_some() {
+            len += 1;
+        }
+        if self.column_comments {
+            len += 1;
+        }
+        if !self.tags.is_empty() {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("peerdb_flow.SnowflakeTableOptions", len)?;
+        if !self.cluster_by.is_empty() {
+            struct_ser.serialize_field("clusterBy", &self.cluster_by)?;
+        }
+        if self.transient {
+            struct_ser.serialize_field("transient", &self.transient)?;
+        }
+        if let Some(v) = self.data_retention_days.as_ref() {
+            struct_ser.serialize_field("dataRetentionDays", v)?;
+        }
+        if self.column_comments {
+            struct_ser.serialize_field("columnComments", &self.column_comments)?;
+        }
+        if !self.tags.is_empty() {
+            struct_ser.serialize_field("tags", &self.tags)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for SnowflakeTableOptions {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "cluster_by",
+            "clusterBy",
+            "transient",
+            "data_retention_days",
+            "dataRetentionDays",
+            "column_comments",
+            "columnComments",
+            "tags",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            ClusterBy,
+            Transient,
+            DataRetentionDays,
+            ColumnComments,
+            Tags,
+            __SkipField__,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "clusterBy" | "cluster_by" => Ok(GeneratedField::ClusterBy),
+                            "transient" => Ok(GeneratedField::Transient),
+                            "dataRetentionDays" | "data_retention_days" => Ok(GeneratedField::DataRetentionDays),
+                            "columnComments" | "column_comments" => Ok(GeneratedField::ColumnComments),
+                            "tags" => Ok(GeneratedField::Tags),
+                            _ => Ok(GeneratedField::__SkipField__),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = SnowflakeTableOptions;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct peerdb_flow.SnowflakeTableOptions")
+            }
+
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<SnowflakeTableOptions, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut cluster_by__ = None;
+                let mut transient__ = None;
+                let mut data_retention_days__ = None;
+                let mut column_comments__ = None;
+                let mut tags__ = None;
+                while let Some(k) = map.next_key()? {
+                    match k {
+                        GeneratedField::ClusterBy => {
+                            if cluster_by__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("clusterBy"));
+                            }
+                            cluster_by__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::Transient => {
+                            if transient__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("transient"));
+                            }
+                            transient__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::DataRetentionDays => {
+                            if data_retention_days__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("dataRetentionDays"));
+                            }
+                            data_retention_days__ = 
+                                map.next_value::<::std::option::Option<::pbjson::private::NumberDeserialize<_>>>()?.map(|x| x.0)
+                            ;
+                        }
+                        GeneratedField::ColumnComments => {
+                            if column_comments__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("columnComments"));
+                            }
+                            column_comments__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::Tags => {
+                            if tags__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("tags"));
+                            }
+                            tags__ = Some(
+                                map.next_value::<std::collections::HashMap<_, _>>()?
+                            );
+                        }
+                        GeneratedField::__SkipField__ => {
+                            let _ = map.next_value::<serde::de::IgnoredAny>()?;
+                        }
+                    }
+                }
+                Ok(SnowflakeTableOptions {
+                    cluster_by: cluster_by__.unwrap_or_default(),
+                    transient: transient__.unwrap_or_default(),
+                    data_retention_days: data_retention_days__,
+                    column_comments: column_comments__.unwrap_or_default(),
+                    tags: tags__.unwrap_or_default(),
+                })
+            }
+        }
+        deserializer.deserialize_struct("peerdb_flow.SnowflakeTableOptions", FIELDS, GeneratedVisitor)
+    }
+}
+impl serde::Serialize for StartFlowInput {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if self.last_sync_state.is_some() {
+            len += 1;
+        }
+        if self.flow_connection_configs.is_some() {
+            len += 1;
+        }
+        if self.sync_flow_options.is_some() {
+            len += 1;
+        }
+        if !self.destination_sync_states.is_empty() {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("peerdb_flow.StartFlowInput", len)?;
+        if let Some(v) = self.last_sync_state.as_ref() {
+            struct_ser.serialize_field("lastSyncState", v)?;
+        }
+        if let Some(v) = self.flow_connection_configs.as_ref() {
+            struct_ser.serialize_field("flowConnectionConfigs", v)?;
+        }
+        if let Some(v) = self.sync_flow_options.as_ref() {
+            struct_ser.serialize_field("syncFlowOptions", v)?;
+        }
+        if !self.destination_sync_states.is_empty() {
+            struct_ser.serialize_field("destinationSyncStates", &self.destination_sync_states)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for StartFlowInput {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "last_sync_state",
+            "lastSyncState",
+            "flow_connection_configs",
+            "flowConnectionConfigs",
+            "sync_flow_options",
+            "syncFlowOptions",
+            "destination_sync_states",
+            "destinationSyncStates",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            LastSyncState,
+            FlowConnectionConfigs,
+            SyncFlowOptions,
+            DestinationSyncStates,
+            __SkipField__,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "lastSyncState" | "last_sync_state" => Ok(GeneratedField::LastSyncState),
+                            "flowConnectionConfigs" | "flow_connection_configs" => Ok(GeneratedField::FlowConnectionConfigs),
+                            "syncFlowOptions" | "sync_flow_options" => Ok(GeneratedField::SyncFlowOptions),
+                            "destinationSyncStates" | "destination_sync_states" => Ok(GeneratedField::DestinationSyncStates),
+                            _ => Ok(GeneratedField::__SkipField__),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = StartFlowInput;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct peerdb_flow.StartFlowInput")
+            }
+
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<StartFlowInput, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut last_sync_state__ = None;
+                let mut flow_connection_configs__ = None;
+                let mut sync_flow_options__ = None;
+                let mut destination_sync_states__ = None;
+                while let Some(k) = map.next_key()? {
+                    match k {
+                        GeneratedField::LastSyncState => {
+                            if last_sync_state__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("lastSyncState"));
+                            }
+                            last_sync_state__ = map.next_value()?;
+                        }
+                        GeneratedField::FlowConnectionConfigs => {
+                            if flow_connection_configs__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("flowConnectionConfigs"));
+                            }
+                            flow_connection_configs__ = map.next_value()?;
+                        }
+                        GeneratedField::SyncFlowOptions => {
+                            if sync_flow_options__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("syncFlowOptions"));
+                            }
+                            sync_flow_options__ = map.next_value()?;
+                        }
+                        GeneratedField::DestinationSyncStates => {
+                            if destination_sync_states__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("destinationSyncStates"));
+                            }
+                            destination_sync_states__ = Some(
+                                map.next_value::<std::collections::HashMap<_, _>>()?
+                            );
+                        }
+                        GeneratedField::__SkipField__ => {
+                            let _ = map.next_value::<serde::de::IgnoredAny>()?;
+                        }
+                    }
+                }
+                Ok(StartFlowInput {
+                    last_sync_state: last_sync_state__,
+                    flow_connection_configs: flow_connection_configs__,
+                    sync_flow_options: sync_flow_options__,
+                    destination_sync_states: destination_sync_states__.unwrap_or_default(),
+                })
+            }
+        }
+        deserializer.deserialize_struct("peerdb_flow.StartFlowInput", FIELDS, GeneratedVisitor)
+    }
+}
+impl serde::Serialize for StartNormalizeInput {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if self.flow_connection_configs.is_some() {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("peerdb_flow.StartNormalizeInput", len)?;
+        if let Some(v) = self.flow_connection_configs.as_ref() {
+            struct_ser.serialize_field("flowConnectionConfigs", v)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for StartNormalizeInput {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "flow_connection_configs",
+            "flowConnectionConfigs",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            FlowConnectionConfigs,
+            __SkipField__,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "flowConnectionConfigs" | "flow_connection_configs" => Ok(GeneratedField::FlowConnectionConfigs),
+                            _ => Ok(GeneratedField::__SkipField__),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = StartNormalizeInput;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct peerdb_flow.StartNormalizeInput")
+            }
+
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<StartNormalizeInput, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut flow_connection_configs__ = None;
+                while let Some(k) = map.next_key()? {
+                    match k {
+                        GeneratedField::FlowConnectionConfigs => {
+                            if flow_connection_configs__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("flowConnectionConfigs"));
+                            }
+                            flow_connection_configs__ = map.next_value()?;
+                        }
+                        GeneratedField::__SkipField__ => {
+                            let _ = map.next_value::<serde::de::IgnoredAny>()?;
+                        }
+                    }
+                }
+                Ok(StartNormalizeInput {
+                    flow_connection_configs: flow_connection_configs__,
+                })
+            }
+        }
+        deserializer.deserialize_struct("peerdb_flow.StartNormalizeInput", FIELDS, GeneratedVisitor)
     }
 }
-impl serde::Serialize for StartFlowInput {
+impl serde::Serialize for StringPartitionRange {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
     where
@@ -3908,48 +5754,37 @@ impl serde::Serialize for StartFlowInput {
     {
         use serde::ser::SerializeStruct;
         let mut len = 0;
-        if self.last_sync_state.is_some() {
-            len += 1;
-        }
-        if self.flow_connection_configs.is_some() {
+        if !self.start.is_empty() {
             len += 1;
         }
-        if self.sync_flow_options.is_some() {
+        if !self.end.is_empty() {
             len += 1;
         }
-        let mut struct_ser = serializer.serialize_struct("peerdb_flow.StartFlowInput", len)?;
-        if let Some(v) = self.last_sync_state.as_ref() {
-            struct_ser.serialize_field("lastSyncState", v)?;
-        }
-        if let Some(v) = self.flow_connection_configs.as_ref() {
-            struct_ser.serialize_field("flowConnectionConfigs", v)?;
+        let mut struct_ser = serializer.serialize_struct("peerdb_flow.StringPartitionRange", len)?;
+        if !self.start.is_empty() {
+            struct_ser.serialize_field("start", &self.start)?;
         }
-        if let Some(v) = self.sync_flow_options.as_ref() {
-            struct_ser.serialize_field("syncFlowOptions", v)?;
+        if !self.end.is_empty() {
+            struct_ser.serialize_field("end", &self.end)?;
         }
         struct_ser.end()
     }
 }
-impl<'de> serde::Deserialize<'de> for StartFlowInput {
+impl<'de> serde::Deserialize<'de> for StringPartitionRange {
     #[allow(deprecated)]
     fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
     where
         D: serde::Deserializer<'de>,
     {
         const FIELDS: &[&str] = &[
-            "last_sync_state",
-            "lastSyncState",
-            "flow_connection_configs",
-            "flowConnectionConfigs",
-            "sync_flow_options",
-            "syncFlowOptions",
+            "start",
+            "end",
         ];
 
         #[allow(clippy::enum_variant_names)]
         enum GeneratedField {
-            LastSyncState,
-            FlowConnectionConfigs,
-            SyncFlowOptions,
+            Start,
+            End,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -3972,9 +5807,8 @@ impl<'de> serde::Deserialize<'de> for StartFlowInput {
                         E: serde::de::Error,
                     {
                         match value {
-                            "lastSyncState" | "last_sync_state" => Ok(GeneratedField::LastSyncState),
-                            "flowConnectionConfigs" | "flow_connection_configs" => Ok(GeneratedField::FlowConnectionConfigs),
-                            "syncFlowOptions" | "sync_flow_options" => Ok(GeneratedField::SyncFlowOptions),
+                            "start" => Ok(GeneratedField::Start),
+                            "end" => Ok(GeneratedField::End),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -3984,55 +5818,47 @@ impl<'de> serde::Deserialize<'de> for StartFlowInput {
         }
         struct GeneratedVisitor;
         impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
-            type Value = StartFlowInput;
+            type Value = StringPartitionRange;
 
             fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
-                formatter.write_str("struct peerdb_flow.StartFlowInput")
+                formatter.write_str("struct peerdb_flow.StringPartitionRange")
             }
 
-            fn visit_map<V>(self, mut map: V) -> std::result::Result<StartFlowInput, V::Error>
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<StringPartitionRange, V::Error>
                 where
                     V: serde::de::MapAccess<'de>,
             {
-                let mut last_sync_state__ = None;
-                let mut flow_connection_configs__ = None;
-                let mut sync_flow_options__ = None;
+                let mut start__ = None;
+                let mut end__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
-                        GeneratedField::LastSyncState => {
-                            if last_sync_state__.is_some() {
-                                return Err(serde::de::Error::duplicate_field("lastSyncState"));
-                            }
-                            last_sync_state__ = map.next_value()?;
-                        }
-                        GeneratedField::FlowConnectionConfigs => {
-                            if flow_connection_configs__.is_some() {
-                                return Err(serde::de::Error::duplicate_field("flowConnectionConfigs"));
+                        GeneratedField::Start => {
+                            if start__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("start"));
                             }
-                            flow_connection_configs__ = map.next_value()?;
+                            start__ = Some(map.next_value()?);
                         }
-                        GeneratedField::SyncFlowOptions => {
-                            if sync_flow_options__.is_some() {
-                                return Err(serde::de::Error::duplicate_field("syncFlowOptions"));
+                        GeneratedField::End => {
+                            if end__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("end"));
                             }
-                            sync_flow_options__ = map.next_value()?;
+                            end__ = Some(map.next_value()?);
                         }
                         GeneratedField::__SkipField__ => {
                             let _ = map.next_value::<serde::de::IgnoredAny>()?;
                         }
                     }
                 }
-                Ok(StartFlowInput {
-                    last_sync_state: last_sync_state__,
-                    flow_connection_configs: flow_connection_configs__,
-                    sync_flow_options: sync_flow_options__,
+                Ok(StringPartitionRange {
+                    start: start__.unwrap_or_default(),
+                    end: end__.unwrap_or_default(),
                 })
             }
         }
-        deserializer.deserialize_struct("peerdb_flow.StartFlowInput", FIELDS, GeneratedVisitor)
+        deserializer.deserialize_struct("peerdb_flow.StringPartitionRange", FIELDS, GeneratedVisitor)
     }
 }
-impl serde::Serialize for StartNormalizeInput {
+impl serde::Serialize for SyncFlowOptions {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
     where
@@ -4040,30 +5866,30 @@ impl serde::Serialize for StartNormalizeInput {
     {
         use serde::ser::SerializeStruct;
         let mut len = 0;
-        if self.flow_connection_configs.is_some() {
+        if self.batch_size != 0 {
             len += 1;
         }
-        let mut struct_ser = serializer.serialize_struct("peerdb_flow.StartNormalizeInput", len)?;
-        if let Some(v) = self.flow_connection_configs.as_ref() {
-            struct_ser.serialize_field("flowConnectionConfigs", v)?;
+        let mut struct_ser = serializer.serialize_struct("peerdb_flow.SyncFlowOptions", len)?;
+        if self.batch_size != 0 {
+            struct_ser.serialize_field("batchSize", &self.batch_size)?;
         }
         struct_ser.end()
     }
 }
-impl<'de> serde::Deserialize<'de> for StartNormalizeInput {
+impl<'de> serde::Deserialize<'de> for SyncFlowOptions {
     #[allow(deprecated)]
     fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
     where
         D: serde::Deserializer<'de>,
     {
         const FIELDS: &[&str] = &[
-            "flow_connection_configs",
-            "flowConnectionConfigs",
+            "batch_size",
+            "batchSize",
         ];
 
         #[allow(clippy::enum_variant_names)]
         enum GeneratedField {
-            FlowConnectionConfigs,
+            BatchSize,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -4086,7 +5912,7 @@ impl<'de> serde::Deserialize<'de> for StartNormalizeInput {
                         E: serde::de::Error,
                     {
                         match value {
-                            "flowConnectionConfigs" | "flow_connection_configs" => Ok(GeneratedField::FlowConnectionConfigs),
+                            "batchSize" | "batch_size" => Ok(GeneratedField::BatchSize),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -4096,39 +5922,41 @@ impl<'de> serde::Deserialize<'de> for StartNormalizeInput {
         }
         struct GeneratedVisitor;
         impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
-            type Value = StartNormalizeInput;
+            type Value = SyncFlowOptions;
 
             fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
-                formatter.write_str("struct peerdb_flow.StartNormalizeInput")
+                formatter.write_str("struct peerdb_flow.SyncFlowOptions")
             }
 
-            fn visit_map<V>(self, mut map: V) -> std::result::Result<StartNormalizeInput, V::Error>
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<SyncFlowOptions, V::Error>
                 where
                     V: serde::de::MapAccess<'de>,
             {
-                let mut flow_connection_configs__ = None;
+                let mut batch_size__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
-                        GeneratedField::FlowConnectionConfigs => {
-                            if flow_connection_configs__.is_some() {
-                                return Err(serde::de::Error::duplicate_field("flowConnectionConfigs"));
+                        GeneratedField::BatchSize => {
+                            if batch_size__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("batchSize"));
                             }
-                            flow_connection_configs__ = map.next_value()?;
+                            batch_size__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
                         }
                         GeneratedField::__SkipField__ => {
                             let _ = map.next_value::<serde::de::IgnoredAny>()?;
                         }
                     }
                 }
-                Ok(StartNormalizeInput {
-                    flow_connection_configs: flow_connection_configs__,
+                Ok(SyncFlowOptions {
+                    batch_size: batch_size__.unwrap_or_default(),
                 })
             }
         }
-        deserializer.deserialize_struct("peerdb_flow.StartNormalizeInput", FIELDS, GeneratedVisitor)
+        deserializer.deserialize_struct("peerdb_flow.SyncFlowOptions", FIELDS, GeneratedVisitor)
     }
 }
-impl serde::Serialize for SyncFlowOptions {
+impl serde::Serialize for SystemColumns {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
     where
@@ -4136,30 +5964,48 @@ impl serde::Serialize for SyncFlowOptions {
     {
         use serde::ser::SerializeStruct;
         let mut len = 0;
-        if self.batch_size != 0 {
+        if self.synced_at {
             len += 1;
         }
-        let mut struct_ser = serializer.serialize_struct("peerdb_flow.SyncFlowOptions", len)?;
-        if self.batch_size != 0 {
-            struct_ser.serialize_field("batchSize", &self.batch_size)?;
+        if self.source_lsn {
+            len += 1;
+        }
+        if self.commit_ts {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("peerdb_flow.SystemColumns", len)?;
+        if self.synced_at {
+            struct_ser.serialize_field("syncedAt", &self.synced_at)?;
+        }
+        if self.source_lsn {
+            struct_ser.serialize_field("sourceLsn", &self.source_lsn)?;
+        }
+        if self.commit_ts {
+            struct_ser.serialize_field("commitTs", &self.commit_ts)?;
         }
         struct_ser.end()
     }
 }
-impl<'de> serde::Deserialize<'de> for SyncFlowOptions {
+impl<'de> serde::Deserialize<'de> for SystemColumns {
     #[allow(deprecated)]
     fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
     where
         D: serde::Deserializer<'de>,
     {
         const FIELDS: &[&str] = &[
-            "batch_size",
-            "batchSize",
+            "synced_at",
+            "syncedAt",
+            "source_lsn",
+            "sourceLsn",
+            "commit_ts",
+            "commitTs",
         ];
 
         #[allow(clippy::enum_variant_names)]
         enum GeneratedField {
-            BatchSize,
+            SyncedAt,
+            SourceLsn,
+            CommitTs,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -4182,7 +6028,9 @@ impl<'de> serde::Deserialize<'de> for SyncFlowOptions {
                         E: serde::de::Error,
                     {
                         match value {
-                            "batchSize" | "batch_size" => Ok(GeneratedField::BatchSize),
+                            "syncedAt" | "synced_at" => Ok(GeneratedField::SyncedAt),
+                            "sourceLsn" | "source_lsn" => Ok(GeneratedField::SourceLsn),
+                            "commitTs" | "commit_ts" => Ok(GeneratedField::CommitTs),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -4192,38 +6040,52 @@ impl<'de> serde::Deserialize<'de> for SyncFlowOptions {
         }
         struct GeneratedVisitor;
         impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
-            type Value = SyncFlowOptions;
+            type Value = SystemColumns;
 
             fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
-                formatter.write_str("struct peerdb_flow.SyncFlowOptions")
+                formatter.write_str("struct peerdb_flow.SystemColumns")
             }
 
-            fn visit_map<V>(self, mut map: V) -> std::result::Result<SyncFlowOptions, V::Error>
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<SystemColumns, V::Error>
                 where
                     V: serde::de::MapAccess<'de>,
             {
-                let mut batch_size__ = None;
+                let mut synced_at__ = None;
+                let mut source_lsn__ = None;
+                let mut commit_ts__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
-                        GeneratedField::BatchSize => {
-                            if batch_size__.is_some() {
-                                return Err(serde::de::Error::duplicate_field("batchSize"));
+                        GeneratedField::SyncedAt => {
+                            if synced_at__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("syncedAt"));
                             }
-                            batch_size__ = 
-                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
-                            ;
+                            synced_at__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::SourceLsn => {
+                            if source_lsn__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("sourceLsn"));
+                            }
+                            source_lsn__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::CommitTs => {
+                            if commit_ts__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("commitTs"));
+                            }
+                            commit_ts__ = Some(map.next_value()?);
                         }
                         GeneratedField::__SkipField__ => {
                             let _ = map.next_value::<serde::de::IgnoredAny>()?;
                         }
                     }
                 }
-                Ok(SyncFlowOptions {
-                    batch_size: batch_size__.unwrap_or_default(),
+                Ok(SystemColumns {
+                    synced_at: synced_at__.unwrap_or_default(),
+                    source_lsn: source_lsn__.unwrap_or_default(),
+                    commit_ts: commit_ts__.unwrap_or_default(),
                 })
             }
         }
-        deserializer.deserialize_struct("peerdb_flow.SyncFlowOptions", FIELDS, GeneratedVisitor)
+        deserializer.deserialize_struct("peerdb_flow.SystemColumns", FIELDS, GeneratedVisitor)
     }
 }
 impl serde::Serialize for Tid {
@@ -4688,6 +6550,12 @@ impl serde::Serialize for TableSchema {
         if !self.primary_key_column.is_empty() {
             len += 1;
         }
+        if !self.source_identifier_column.is_empty() {
+            len += 1;
+        }
+        if !self.column_comments.is_empty() {
+            len += 1;
+        }
         let mut struct_ser = serializer.serialize_struct("peerdb_flow.TableSchema", len)?;
         if !self.table_identifier.is_empty() {
             struct_ser.serialize_field("tableIdentifier", &self.table_identifier)?;
@@ -4698,6 +6566,12 @@ impl serde::Serialize for TableSchema {
         if !self.primary_key_column.is_empty() {
             struct_ser.serialize_field("primaryKeyColumn", &self.primary_key_column)?;
         }
+        if !self.source_identifier_column.is_empty() {
+            struct_ser.serialize_field("sourceIdentifierColumn", &self.source_identifier_column)?;
+        }
+        if !self.column_comments.is_empty() {
+            struct_ser.serialize_field("columnComments", &self.column_comments)?;
+        }
         struct_ser.end()
     }
 }
@@ -4713,6 +6587,10 @@ impl<'de> serde::Deserialize<'de> for TableSchema {
             "columns",
             "primary_key_column",
             "primaryKeyColumn",
+            "source_identifier_column",
+            "sourceIdentifierColumn",
+            "column_comments",
+            "columnComments",
         ];
 
         #[allow(clippy::enum_variant_names)]
@@ -4720,6 +6598,8 @@ impl<'de> serde::Deserialize<'de> for TableSchema {
             TableIdentifier,
             Columns,
             PrimaryKeyColumn,
+            SourceIdentifierColumn,
+            ColumnComments,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -4745,6 +6625,8 @@ impl<'de> serde::Deserialize<'de> for TableSchema {
                             "tableIdentifier" | "table_identifier" => Ok(GeneratedField::TableIdentifier),
                             "columns" => Ok(GeneratedField::Columns),
                             "primaryKeyColumn" | "primary_key_column" => Ok(GeneratedField::PrimaryKeyColumn),
+                            "sourceIdentifierColumn" | "source_identifier_column" => Ok(GeneratedField::SourceIdentifierColumn),
+                            "columnComments" | "column_comments" => Ok(GeneratedField::ColumnComments),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -4767,6 +6649,8 @@ impl<'de> serde::Deserialize<'de> for TableSchema {
                 let mut table_identifier__ = None;
                 let mut columns__ = None;
                 let mut primary_key_column__ = None;
+                let mut source_identifier_column__ = None;
+                let mut column_comments__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
                         GeneratedField::TableIdentifier => {
@@ -4789,6 +6673,20 @@ impl<'de> serde::Deserialize<'de> for TableSchema {
                             }
                             primary_key_column__ = Some(map.next_value()?);
                         }
+                        GeneratedField::SourceIdentifierColumn => {
+                            if source_identifier_column__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("sourceIdentifierColumn"));
+                            }
+                            source_identifier_column__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::ColumnComments => {
+                            if column_comments__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("columnComments"));
+                            }
+                            column_comments__ = Some(
+                                map.next_value::<std::collections::HashMap<_, _>>()?
+                            );
+                        }
                         GeneratedField::__SkipField__ => {
                             let _ = map.next_value::<serde::de::IgnoredAny>()?;
                         }
@@ -4798,6 +6696,8 @@ impl<'de> serde::Deserialize<'de> for TableSchema {
                     table_identifier: table_identifier__.unwrap_or_default(),
                     columns: columns__.unwrap_or_default(),
                     primary_key_column: primary_key_column__.unwrap_or_default(),
+                    source_identifier_column: source_identifier_column__.unwrap_or_default(),
+                    column_comments: column_comments__.unwrap_or_default(),
                 })
             }
         }
@@ -4916,3 +6816,115 @@ impl<'de> serde::Deserialize<'de> for TimestampPartitionRange {
         deserializer.deserialize_struct("peerdb_flow.TimestampPartitionRange", FIELDS, GeneratedVisitor)
     }
 }
+impl serde::Serialize for TuplePartitionRange {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if !self.start.is_empty() {
+            len += 1;
+        }
+        if !self.end.is_empty() {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("peerdb_flow.TuplePartitionRange", len)?;
+        if !self.start.is_empty() {
+            struct_ser.serialize_field("start", &self.start)?;
+        }
+        if !self.end.is_empty() {
+            struct_ser.serialize_field("end", &self.end)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for TuplePartitionRange {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "start",
+            "end",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            Start,
+            End,
+            __SkipField__,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "start" => Ok(GeneratedField::Start),
+                            "end" => Ok(GeneratedField::End),
+                            _ => Ok(GeneratedField::__SkipField__),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = TuplePartitionRange;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct peerdb_flow.TuplePartitionRange")
+            }
+
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<TuplePartitionRange, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut start__ = None;
+                let mut end__ = None;
+                while let Some(k) = map.next_key()? {
+                    match k {
+                        GeneratedField::Start => {
+                            if start__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("start"));
+                            }
+                            start__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::End => {
+                            if end__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("end"));
+                            }
+                            end__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::__SkipField__ => {
+                            let _ = map.next_value::<serde::de::IgnoredAny>()?;
+                        }
+                    }
+                }
+                Ok(TuplePartitionRange {
+                    start: start__.unwrap_or_default(),
+                    end: end__.unwrap_or_default(),
+                })
+            }
+        }
+        deserializer.deserialize_struct("peerdb_flow.TuplePartitionRange", FIELDS, GeneratedVisitor)
+    }
+}
@@ -18,6 +18,15 @@ pub struct SnowflakeConfig {
     pub query_timeout: u64,
     #[prost(string, tag="9")]
     pub s3_integration: ::prost::alloc::string::String,
+    /// used to stage files on s3:// staging paths.
+    #[prost(message, optional, tag="10")]
+    pub staging_s3_connection: ::core::option::Option<S3ConnectionConfig>,
+    /// size in MB of the Avro files streamed to the stage, 64 when not set.
+    #[prost(uint32, tag="11")]
+    pub stage_file_size_mb: u32,
+    /// number of Avro files uploaded to the stage at once, 4 when not set.
+    #[prost(uint32, tag="12")]
+    pub stage_upload_parallelism: u32,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -44,6 +53,9 @@ pub struct BigqueryConfig {
     pub client_x509_cert_url: ::prost::alloc::string::String,
     #[prost(string, tag="11")]
     pub dataset_id: ::prost::alloc::string::String,
+    /// how rows are written in the storage write sync mode.
+    #[prost(enumeration="BigqueryStorageWriteMode", tag="12")]
+    pub storage_write_mode: i32,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -87,12 +99,66 @@ pub struct EventHubConfig {
     pub location: ::prost::alloc::string::String,
     #[prost(message, optional, tag="4")]
     pub metadata_db: ::core::option::Option<PostgresConfig>,
+    /// column whose value is the partition key of the events of a table, so that the changes
+    /// to a row are delivered in order. The primary key of the table is used when not set,
+    /// or when the table doesn't have the column.
+    #[prost(string, tag="5")]
+    pub partition_key_column: ::prost::alloc::string::String,
+    #[prost(enumeration="EventEnvelope", tag="6")]
+    pub envelope: i32,
+    #[prost(enumeration="EventEncoding", tag="7")]
+    pub encoding: i32,
+    /// url of the Confluent compatible schema registry, required by the Avro and Protobuf encodings.
+    #[prost(string, tag="8")]
+    pub schema_registry_url: ::prost::alloc::string::String,
+    #[prost(string, tag="9")]
+    pub schema_registry_username: ::prost::alloc::string::String,
+    #[prost(string, tag="10")]
+    pub schema_registry_password: ::prost::alloc::string::String,
+    /// routes of tables to hubs, comma separated rules like "public.orders_*=orders,public.users=users"
+    /// whose patterns are matched against the destination table names in order. Tables without a match
+    /// are sent to the hub named after them. Events carry the name of their table in the peerdb_table
+    /// property, so that the tables sharing a hub can be told apart.
+    #[prost(string, tag="11")]
+    pub hub_routes: ::prost::alloc::string::String,
+    /// partition count and message retention of the hubs created by the connector, 3 and 1 day when not set.
+    #[prost(uint32, tag="12")]
+    pub partition_count: u32,
+    #[prost(uint32, tag="13")]
+    pub message_retention_days: u32,
+    /// never create hubs, for namespaces the connector isn't allowed to manage.
+    /// The hubs have to exist before the flow starts.
+    #[prost(bool, tag="14")]
+    pub disable_hub_creation: bool,
+}
+/// how to reach an S3 bucket, or a bucket of an S3 compatible store like MinIO.
+/// unset fields fall back to the AWS_* environment variables of the flow worker.
+#[allow(clippy::derive_partial_eq_without_eq)]
+#[derive(Clone, PartialEq, ::prost::Message)]
+pub struct S3ConnectionConfig {
+    #[prost(string, tag="1")]
+    pub access_key_id: ::prost::alloc::string::String,
+    #[prost(string, tag="2")]
+    pub secret_access_key: ::prost::alloc::string::String,
+    /// role to assume, with the access keys above or the default credentials.
+    #[prost(string, tag="3")]
+    pub role_arn: ::prost::alloc::string::String,
+    #[prost(string, tag="4")]
+    pub region: ::prost::alloc::string::String,
+    /// endpoint of an S3 compatible store, AWS is used when not set.
+    #[prost(string, tag="5")]
+    pub endpoint: ::prost::alloc::string::String,
+    /// address buckets as <endpoint>/<bucket> instead of <bucket>.<endpoint>.
+    #[prost(bool, tag="6")]
+    pub force_path_style: bool,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
 pub struct S3Config {
     #[prost(string, tag="1")]
     pub url: ::prost::alloc::string::String,
+    #[prost(message, optional, tag="2")]
+    pub connection: ::core::option::Option<S3ConnectionConfig>,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -141,6 +207,99 @@ pub mod peer {
 }
 #[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
 #[repr(i32)]
+pub enum BigqueryStorageWriteMode {
+    /// rows are visible as soon as they are written.
+    Committed = 0,
+    /// rows are visible once all the rows of a batch are written and committed at once.
+    Pending = 1,
+}
+impl BigqueryStorageWriteMode {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            BigqueryStorageWriteMode::Committed => "BIGQUERY_STORAGE_WRITE_MODE_COMMITTED",
+            BigqueryStorageWriteMode::Pending => "BIGQUERY_STORAGE_WRITE_MODE_PENDING",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "BIGQUERY_STORAGE_WRITE_MODE_COMMITTED" => Some(Self::Committed),
+            "BIGQUERY_STORAGE_WRITE_MODE_PENDING" => Some(Self::Pending),
+            _ => None,
+        }
+    }
+}
+/// envelope of the change events sent to streaming destinations like EventHub.
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum EventEnvelope {
+    /// only the new image of the row, as JSON.
+    None = 0,
+    /// the payload of Debezium change events, with before, after, source, op and ts_ms.
+    Debezium = 1,
+    /// a CloudEvents JSON event, with the change as its data.
+    Cloudevents = 2,
+}
+impl EventEnvelope {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            EventEnvelope::None => "EVENT_ENVELOPE_NONE",
+            EventEnvelope::Debezium => "EVENT_ENVELOPE_DEBEZIUM",
+            EventEnvelope::Cloudevents => "EVENT_ENVELOPE_CLOUDEVENTS",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "EVENT_ENVELOPE_NONE" => Some(Self::None),
+            "EVENT_ENVELOPE_DEBEZIUM" => Some(Self::Debezium),
+            "EVENT_ENVELOPE_CLOUDEVENTS" => Some(Self::Cloudevents),
+            _ => None,
+        }
+    }
+}
+/// encoding of the change events sent to streaming destinations like EventHub.
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
+pub enum EventEncoding {
+    Json = 0,
+    /// binary Avro, with the schema of each table registered in a schema registry.
+    Avro = 1,
+    /// Protobuf, with the schema of each table registered in a schema registry.
+    Protobuf = 2,
+}
+impl EventEncoding {
+    /// String value of the enum field names used in the ProtoBuf definition.
+    ///
+    /// The values are not transformed in any way and thus are considered stable
+    /// (if the ProtoBuf definition does not change) and safe for programmatic use.
+    pub fn as_str_name(&self) -> &'static str {
+        match self {
+            EventEncoding::Json => "EVENT_ENCODING_JSON",
+            EventEncoding::Avro => "EVENT_ENCODING_AVRO",
+            EventEncoding::Protobuf => "EVENT_ENCODING_PROTOBUF",
+        }
+    }
+    /// Creates an enum from field names used in the ProtoBuf definition.
+    pub fn from_str_name(value: &str) -> ::core::option::Option<Self> {
+        match value {
+            "EVENT_ENCODING_JSON" => Some(Self::Json),
+            "EVENT_ENCODING_AVRO" => Some(Self::Avro),
+            "EVENT_ENCODING_PROTOBUF" => Some(Self::Protobuf),
+            _ => None,
+        }
+    }
+}
+#[derive(Clone, Copy, Debug, PartialEq, Eq, Hash, PartialOrd, Ord, ::prost::Enumeration)]
+#[repr(i32)]
 pub enum DbType {
     Bigquery = 0,
     Snowflake = 1,
@@ -40,6 +40,9 @@ impl serde::Serialize for BigqueryConfig {
         if !self.dataset_id.is_empty() {
             len += 1;
         }
+        if self.storage_write_mode != 0 {
+            len += 1;
+        }
         let mut struct_ser = serializer.serialize_struct("peerdb_peers.BigqueryConfig", len)?;
         if !self.auth_type.is_empty() {
             struct_ser.serialize_field("authType", &self.auth_type)?;
@@ -74,6 +77,11 @@ impl serde::Serialize for BigqueryConfig {
         if !self.dataset_id.is_empty() {
             struct_ser.serialize_field("datasetId", &self.dataset_id)?;
         }
+        if self.storage_write_mode != 0 {
+            let v = BigqueryStorageWriteMode::from_i32(self.storage_write_mode)
+                .ok_or_else(|| serde::ser::Error::custom(format!("Invalid variant {}", self.storage_write_mode)))?;
+            struct_ser.serialize_field("storageWriteMode", &v)?;
+        }
         struct_ser.end()
     }
 }
@@ -106,6 +114,8 @@ impl<'de> serde::Deserialize<'de> for BigqueryConfig {
             "clientX509CertUrl",
             "dataset_id",
             "datasetId",
+            "storage_write_mode",
+            "storageWriteMode",
         ];
 
         #[allow(clippy::enum_variant_names)]
@@ -121,6 +131,7 @@ impl<'de> serde::Deserialize<'de> for BigqueryConfig {
             AuthProviderX509CertUrl,
             ClientX509CertUrl,
             DatasetId,
+            StorageWriteMode,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -154,6 +165,7 @@ impl<'de> serde::Deserialize<'de> for BigqueryConfig {
                             "authProviderX509CertUrl" | "auth_provider_x509_cert_url" => Ok(GeneratedField::AuthProviderX509CertUrl),
                             "clientX509CertUrl" | "client_x509_cert_url" => Ok(GeneratedField::ClientX509CertUrl),
                             "datasetId" | "dataset_id" => Ok(GeneratedField::DatasetId),
+                            "storageWriteMode" | "storage_write_mode" => Ok(GeneratedField::StorageWriteMode),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -184,6 +196,7 @@ impl<'de> serde::Deserialize<'de> for BigqueryConfig {
                 let mut auth_provider_x509_cert_url__ = None;
                 let mut client_x509_cert_url__ = None;
                 let mut dataset_id__ = None;
+                let mut storage_write_mode__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
                         GeneratedField::AuthType => {
@@ -252,6 +265,12 @@ impl<'de> serde::Deserialize<'de> for BigqueryConfig {
                             }
                             dataset_id__ = Some(map.next_value()?);
                         }
+                        GeneratedField::StorageWriteMode => {
+                            if storage_write_mode__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("storageWriteMode"));
+                            }
+                            storage_write_mode__ = Some(map.next_value::<BigqueryStorageWriteMode>()? as i32);
+                        }
                         GeneratedField::__SkipField__ => {
                             let _ = map.next_value::<serde::de::IgnoredAny>()?;
                         }
@@ -269,12 +288,86 @@ impl<'de> serde::Deserialize<'de> for BigqueryConfig {
                     auth_provider_x509_cert_url: auth_provider_x509_cert_url__.unwrap_or_default(),
                     client_x509_cert_url: client_x509_cert_url__.unwrap_or_default(),
                     dataset_id: dataset_id__.unwrap_or_default(),
+                    storage_write_mode: storage_write_mode__.unwrap_or_default(),
                 })
             }
         }
         deserializer.deserialize_struct("peerdb_peers.BigqueryConfig", FIELDS, GeneratedVisitor)
     }
 }
+impl serde::Serialize for BigqueryStorageWriteMode {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        let variant = match self {
+            Self::Committed => "BIGQUERY_STORAGE_WRITE_MODE_COMMITTED",
+            Self::Pending => "BIGQUERY_STORAGE_WRITE_MODE_PENDING",
+        };
+        serializer.serialize_str(variant)
+    }
+}
+impl<'de> serde::Deserialize<'de> for BigqueryStorageWriteMode {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "BIGQUERY_STORAGE_WRITE_MODE_COMMITTED",
+            "BIGQUERY_STORAGE_WRITE_MODE_PENDING",
+        ];
+
+        struct GeneratedVisitor;
+
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = BigqueryStorageWriteMode;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                write!(formatter, "expected one of: {:?}", &FIELDS)
+            }
+
+            fn visit_i64<E>(self, v: i64) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                use std::convert::TryFrom;
+                i32::try_from(v)
+                    .ok()
+                    .and_then(BigqueryStorageWriteMode::from_i32)
+                    .ok_or_else(|| {
+                        serde::de::Error::invalid_value(serde::de::Unexpected::Signed(v), &self)
+                    })
+            }
+
+            fn visit_u64<E>(self, v: u64) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                use std::convert::TryFrom;
+                i32::try_from(v)
+                    .ok()
+                    .and_then(BigqueryStorageWriteMode::from_i32)
+                    .ok_or_else(|| {
+                        serde::de::Error::invalid_value(serde::de::Unexpected::Unsigned(v), &self)
+                    })
+            }
+
+            fn visit_str<E>(self, value: &str) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                match value {
+                    "BIGQUERY_STORAGE_WRITE_MODE_COMMITTED" => Ok(BigqueryStorageWriteMode::Committed),
+                    "BIGQUERY_STORAGE_WRITE_MODE_PENDING" => Ok(BigqueryStorageWriteMode::Pending),
+                    _ => Err(serde::de::Error::unknown_variant(value, FIELDS)),
+                }
+            }
+        }
+        deserializer.deserialize_any(GeneratedVisitor)
+    }
+}
 impl serde::Serialize for DbType {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
@@ -363,6 +456,158 @@ impl<'de> serde::Deserialize<'de> for DbType {
         deserializer.deserialize_any(GeneratedVisitor)
     }
 }
+impl serde::Serialize for EventEncoding {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        let variant = match self {
+            Self::Json => "EVENT_ENCODING_JSON",
+            Self::Avro => "EVENT_ENCODING_AVRO",
+            Self::Protobuf => "EVENT_ENCODING_PROTOBUF",
+        };
+        serializer.serialize_str(variant)
+    }
+}
+impl<'de> serde::Deserialize<'de> for EventEncoding {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "EVENT_ENCODING_JSON",
+            "EVENT_ENCODING_AVRO",
+            "EVENT_ENCODING_PROTOBUF",
+        ];
+
+        struct GeneratedVisitor;
+
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = EventEncoding;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                write!(formatter, "expected one of: {:?}", &FIELDS)
+            }
+
+            fn visit_i64<E>(self, v: i64) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                use std::convert::TryFrom;
+                i32::try_from(v)
+                    .ok()
+                    .and_then(EventEncoding::from_i32)
+                    .ok_or_else(|| {
+                        serde::de::Error::invalid_value(serde::de::Unexpected::Signed(v), &self)
+                    })
+            }
+
+            fn visit_u64<E>(self, v: u64) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                use std::convert::TryFrom;
+                i32::try_from(v)
+                    .ok()
+                    .and_then(EventEncoding::from_i32)
+                    .ok_or_else(|| {
+                        serde::de::Error::invalid_value(serde::de::Unexpected::Unsigned(v), &self)
+                    })
+            }
+
+            fn visit_str<E>(self, value: &str) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                match value {
+                    "EVENT_ENCODING_JSON" => Ok(EventEncoding::Json),
+                    "EVENT_ENCODING_AVRO" => Ok(EventEncoding::Avro),
+                    "EVENT_ENCODING_PROTOBUF" => Ok(EventEncoding::Protobuf),
+                    _ => Err(serde::de::Error::unknown_variant(value, FIELDS)),
+                }
+            }
+        }
+        deserializer.deserialize_any(GeneratedVisitor)
+    }
+}
+impl serde::Serialize for EventEnvelope {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        let variant = match self {
+            Self::None => "EVENT_ENVELOPE_NONE",
+            Self::Debezium => "EVENT_ENVELOPE_DEBEZIUM",
+            Self::Cloudevents => "EVENT_ENVELOPE_CLOUDEVENTS",
+        };
+        serializer.serialize_str(variant)
+    }
+}
+impl<'de> serde::Deserialize<'de> for EventEnvelope {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "EVENT_ENVELOPE_NONE",
+            "EVENT_ENVELOPE_DEBEZIUM",
+            "EVENT_ENVELOPE_CLOUDEVENTS",
+        ];
+
+        struct GeneratedVisitor;
+
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = EventEnvelope;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                write!(formatter, "expected one of: {:?}", &FIELDS)
+            }
+
+            fn visit_i64<E>(self, v: i64) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                use std::convert::TryFrom;
+                i32::try_from(v)
+                    .ok()
+                    .and_then(EventEnvelope::from_i32)
+                    .ok_or_else(|| {
+                        serde::de::Error::invalid_value(serde::de::Unexpected::Signed(v), &self)
+                    })
+            }
+
+            fn visit_u64<E>(self, v: u64) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                use std::convert::TryFrom;
+                i32::try_from(v)
+                    .ok()
+                    .and_then(EventEnvelope::from_i32)
+                    .ok_or_else(|| {
+                        serde::de::Error::invalid_value(serde::de::Unexpected::Unsigned(v), &self)
+                    })
+            }
+
+            fn visit_str<E>(self, value: &str) -> std::result::Result<Self::Value, E>
+            where
+                E: serde::de::Error,
+            {
+                match value {
+                    "EVENT_ENVELOPE_NONE" => Ok(EventEnvelope::None),
+                    "EVENT_ENVELOPE_DEBEZIUM" => Ok(EventEnvelope::Debezium),
+                    "EVENT_ENVELOPE_CLOUDEVENTS" => Ok(EventEnvelope::Cloudevents),
+                    _ => Err(serde::de::Error::unknown_variant(value, FIELDS)),
+                }
+            }
+        }
+        deserializer.deserialize_any(GeneratedVisitor)
+    }
+}
 impl serde::Serialize for EventHubConfig {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
@@ -383,6 +628,36 @@ impl serde::Serialize for EventHubConfig {
         if self.metadata_db.is_some() {
             len += 1;
         }
+        if !self.partition_key_column.is_empty() {
+            len += 1;
+        }
+        if self.envelope != 0 {
+            len += 1;
+        }
+        if self.encoding != 0 {
+            len += 1;
+        }
+        if !self.schema_registry_url.is_empty() {
+            len += 1;
+        }
+        if !self.schema_registry_username.is_empty() {
+            len += 1;
+        }
+        if !self.schema_registry_password.is_empty() {
+            len += 1;
+        }
+        if !self.hub_routes.is_empty() {
+            len += 1;
+        }
+        if self.partition_count != 0 {
+            len += 1;
+        }
+        if self.message_retention_days != 0 {
+            len += 1;
+        }
+        if self.disable_hub_creation {
+            len += 1;
+        }
         let mut struct_ser = serializer.serialize_struct("peerdb_peers.EventHubConfig", len)?;
         if !self.namespace.is_empty() {
             struct_ser.serialize_field("namespace", &self.namespace)?;
@@ -396,6 +671,40 @@ impl serde::Serialize for EventHubConfig {
         if let Some(v) = self.metadata_db.as_ref() {
             struct_ser.serialize_field("metadataDb", v)?;
         }
+        if !self.partition_key_column.is_empty() {
+            struct_ser.serialize_field("partitionKeyColumn", &self.partition_key_column)?;
+        }
+        if self.envelope != 0 {
+            let v = EventEnvelope::from_i32(self.envelope)
+                .ok_or_else(|| serde::ser::Error::custom(format!("Invalid variant {}", self.envelope)))?;
+            struct_ser.serialize_field("envelope", &v)?;
+        }
+        if self.encoding != 0 {
+            let v = EventEncoding::from_i32(self.encoding)
+                .ok_or_else(|| serde::ser::Error::custom(format!("Invalid variant {}", self.encoding)))?;
+            struct_ser.serialize_field("encoding", &v)?;
+        }
+        if !self.schema_registry_url.is_empty() {
+            struct_ser.serialize_field("schemaRegistryUrl", &self.schema_registry_url)?;
+        }
+        if !self.schema_registry_username.is_empty() {
+            struct_ser.serialize_field("schemaRegistryUsername", &self.schema_registry_username)?;
+        }
+        if !self.schema_registry_password.is_empty() {
+            struct_ser.serialize_field("schemaRegistryPassword", &self.schema_registry_password)?;
+        }
+        if !self.hub_routes.is_empty() {
+            struct_ser.serialize_field("hubRoutes", &self.hub_routes)?;
+        }
+        if self.partition_count != 0 {
+            struct_ser.serialize_field("partitionCount", &self.partition_count)?;
+        }
+        if self.message_retention_days != 0 {
+            struct_ser.serialize_field("messageRetentionDays", &self.message_retention_days)?;
+        }
+        if self.disable_hub_creation {
+            struct_ser.serialize_field("disableHubCreation", &self.disable_hub_creation)?;
+        }
         struct_ser.end()
     }
 }
@@ -412,6 +721,24 @@ impl<'de> serde::Deserialize<'de> for EventHubConfig {
             "location",
             "metadata_db",
             "metadataDb",
+            "partition_key_column",
+            "partitionKeyColumn",
+            "envelope",
+            "encoding",
+            "schema_registry_url",
+            "schemaRegistryUrl",
+            "schema_registry_username",
+            "schemaRegistryUsername",
+            "schema_registry_password",
+            "schemaRegistryPassword",
+            "hub_routes",
+            "hubRoutes",
+            "partition_count",
+            "partitionCount",
+            "message_retention_days",
+            "messageRetentionDays",
+            "disable_hub_creation",
+            "disableHubCreation",
         ];
 
         #[allow(clippy::enum_variant_names)]
@@ -420,6 +747,16 @@ impl<'de> serde::Deserialize<'de> for EventHubConfig {
             ResourceGroup,
             Location,
             MetadataDb,
+            PartitionKeyColumn,
+            Envelope,
+            Encoding,
+            SchemaRegistryUrl,
+            SchemaRegistryUsername,
+            SchemaRegistryPassword,
+            HubRoutes,
+            PartitionCount,
+            MessageRetentionDays,
+            DisableHubCreation,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -446,6 +783,16 @@ impl<'de> serde::Deserialize<'de> for EventHubConfig {
                             "resourceGroup" | "resource_group" => Ok(GeneratedField::ResourceGroup),
                             "location" => Ok(GeneratedField::Location),
                             "metadataDb" | "metadata_db" => Ok(GeneratedField::MetadataDb),
+                            "partitionKeyColumn" | "partition_key_column" => Ok(GeneratedField::PartitionKeyColumn),
+                            "envelope" => Ok(GeneratedField::Envelope),
+                            "encoding" => Ok(GeneratedField::Encoding),
+                            "schemaRegistryUrl" | "schema_registry_url" => Ok(GeneratedField::SchemaRegistryUrl),
+                            "schemaRegistryUsername" | "schema_registry_username" => Ok(GeneratedField::SchemaRegistryUsername),
+                            "schemaRegistryPassword" | "schema_registry_password" => Ok(GeneratedField::SchemaRegistryPassword),
+                            "hubRoutes" | "hub_routes" => Ok(GeneratedField::HubRoutes),
+                            "partitionCount" | "partition_count" => Ok(GeneratedField::PartitionCount),
+                            "messageRetentionDays" | "message_retention_days" => Ok(GeneratedField::MessageRetentionDays),
+                            "disableHubCreation" | "disable_hub_creation" => Ok(GeneratedField::DisableHubCreation),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -469,6 +816,16 @@ impl<'de> serde::Deserialize<'de> for EventHubConfig {
                 let mut resource_group__ = None;
                 let mut location__ = None;
                 let mut metadata_db__ = None;
+                let mut partition_key_column__ = None;
+                let mut envelope__ = None;
+                let mut encoding__ = None;
+                let mut schema_registry_url__ = None;
+                let mut schema_registry_username__ = None;
+                let mut schema_registry_password__ = None;
+                let mut hub_routes__ = None;
+                let mut partition_count__ = None;
+                let mut message_retention_days__ = None;
+                let mut disable_hub_creation__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
                         GeneratedField::Namespace => {
@@ -495,6 +852,70 @@ impl<'de> serde::Deserialize<'de> for EventHubConfig {
                             }
                             metadata_db__ = map.next_value()?;
                         }
+                        GeneratedField::PartitionKeyColumn => {
+                            if partition_key_column__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("partitionKeyColumn"));
+                            }
+                            partition_key_column__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::Envelope => {
+                            if envelope__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("envelope"));
+                            }
+                            envelope__ = Some(map.next_value::<EventEnvelope>()? as i32);
+                        }
+                        GeneratedField::Encoding => {
+                            if encoding__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("encoding"));
+                            }
+                            encoding__ = Some(map.next_value::<EventEncoding>()? as i32);
+                        }
+                        GeneratedField::SchemaRegistryUrl => {
+                            if schema_registry_url__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("schemaRegistryUrl"));
+                            }
+                            schema_registry_url__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::SchemaRegistryUsername => {
+                            if schema_registry_username__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("schemaRegistryUsername"));
+                            }
+                            schema_registry_username__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::SchemaRegistryPassword => {
+                            if schema_registry_password__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("schemaRegistryPassword"));
+                            }
+                            schema_registry_password__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::HubRoutes => {
+                            if hub_routes__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("hubRoutes"));
+                            }
+                            hub_routes__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::PartitionCount => {
+                            if partition_count__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("partitionCount"));
+                            }
+                            partition_count__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::MessageRetentionDays => {
+                            if message_retention_days__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("messageRetentionDays"));
+                            }
+                            message_retention_days__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::DisableHubCreation => {
+                            if disable_hub_creation__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("disableHubCreation"));
+                            }
+                            disable_hub_creation__ = Some(map.next_value()?);
+                        }
                         GeneratedField::__SkipField__ => {
                             let _ = map.next_value::<serde::de::IgnoredAny>()?;
                         }
@@ -505,6 +926,16 @@ impl<'de> serde::Deserialize<'de> for EventHubConfig {
                     resource_group: resource_group__.unwrap_or_default(),
                     location: location__.unwrap_or_default(),
                     metadata_db: metadata_db__,
+                    partition_key_column: partition_key_column__.unwrap_or_default(),
+                    envelope: envelope__.unwrap_or_default(),
+                    encoding: encoding__.unwrap_or_default(),
+                    schema_registry_url: schema_registry_url__.unwrap_or_default(),
+                    schema_registry_username: schema_registry_username__.unwrap_or_default(),
+                    schema_registry_password: schema_registry_password__.unwrap_or_default(),
+                    hub_routes: hub_routes__.unwrap_or_default(),
+                    partition_count: partition_count__.unwrap_or_default(),
+                    message_retention_days: message_retention_days__.unwrap_or_default(),
+                    disable_hub_creation: disable_hub_creation__.unwrap_or_default(),
                 })
             }
         }
@@ -1091,10 +1522,16 @@ impl serde::Serialize for S3Config {
         if !self.url.is_empty() {
             len += 1;
         }
+        if self.connection.is_some() {
+            len += 1;
+        }
         let mut struct_ser = serializer.serialize_struct("peerdb_peers.S3Config", len)?;
         if !self.url.is_empty() {
             struct_ser.serialize_field("url", &self.url)?;
         }
+        if let Some(v) = self.connection.as_ref() {
+            struct_ser.serialize_field("connection", v)?;
+        }
         struct_ser.end()
     }
 }
@@ -1106,11 +1543,13 @@ impl<'de> serde::Deserialize<'de> for S3Config {
     {
         const FIELDS: &[&str] = &[
             "url",
+            "connection",
         ];
 
         #[allow(clippy::enum_variant_names)]
         enum GeneratedField {
             Url,
+            Connection,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -1134,6 +1573,7 @@ impl<'de> serde::Deserialize<'de> for S3Config {
                     {
                         match value {
                             "url" => Ok(GeneratedField::Url),
+                            "connection" => Ok(GeneratedField::Connection),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -1154,6 +1594,7 @@ impl<'de> serde::Deserialize<'de> for S3Config {
                     V: serde::de::MapAccess<'de>,
             {
                 let mut url__ = None;
+                let mut connection__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
                         GeneratedField::Url => {
@@ -1162,6 +1603,12 @@ impl<'de> serde::Deserialize<'de> for S3Config {
                             }
                             url__ = Some(map.next_value()?);
                         }
+                        GeneratedField::Connection => {
+                            if connection__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("connection"));
+                            }
+                            connection__ = map.next_value()?;
+                        }
                         GeneratedField::__SkipField__ => {
                             let _ = map.next_value::<serde::de::IgnoredAny>()?;
                         }
@@ -1169,12 +1616,197 @@ impl<'de> serde::Deserialize<'de> for S3Config {
                 }
                 Ok(S3Config {
                     url: url__.unwrap_or_default(),
+                    connection: connection__,
                 })
             }
         }
         deserializer.deserialize_struct("peerdb_peers.S3Config", FIELDS, GeneratedVisitor)
     }
 }
+impl serde::Serialize for S3ConnectionConfig {
+    #[allow(deprecated)]
+    fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
+    where
+        S: serde::Serializer,
+    {
+        use serde::ser::SerializeStruct;
+        let mut len = 0;
+        if !self.access_key_id.is_empty() {
+            len += 1;
+        }
+        if !self.secret_access_key.is_empty() {
+            len += 1;
+        }
+        if !self.role_arn.is_empty() {
+            len += 1;
+        }
+        if !self.region.is_empty() {
+            len += 1;
+        }
+        if !self.endpoint.is_empty() {
+            len += 1;
+        }
+        if self.force_path_style {
+            len += 1;
+        }
+        let mut struct_ser = serializer.serialize_struct("peerdb_peers.S3ConnectionConfig", len)?;
+        if !self.access_key_id.is_empty() {
+            struct_ser.serialize_field("accessKeyId", &self.access_key_id)?;
+        }
+        if !self.secret_access_key.is_empty() {
+            struct_ser.serialize_field("secretAccessKey", &self.secret_access_key)?;
+        }
+        if !self.role_arn.is_empty() {
+            struct_ser.serialize_field("roleArn", &self.role_arn)?;
+        }
+        if !self.region.is_empty() {
+            struct_ser.serialize_field("region", &self.region)?;
+        }
+        if !self.endpoint.is_empty() {
+            struct_ser.serialize_field("endpoint", &self.endpoint)?;
+        }
+        if self.force_path_style {
+            struct_ser.serialize_field("forcePathStyle", &self.force_path_style)?;
+        }
+        struct_ser.end()
+    }
+}
+impl<'de> serde::Deserialize<'de> for S3ConnectionConfig {
+    #[allow(deprecated)]
+    fn deserialize<D>(deserializer: D) -> std::result::Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        const FIELDS: &[&str] = &[
+            "access_key_id",
+            "accessKeyId",
+            "secret_access_key",
+            "secretAccessKey",
+            "role_arn",
+            "roleArn",
+            "region",
+            "endpoint",
+            "force_path_style",
+            "forcePathStyle",
+        ];
+
+        #[allow(clippy::enum_variant_names)]
+        enum GeneratedField {
+            AccessKeyId,
+            SecretAccessKey,
+            RoleArn,
+            Region,
+            Endpoint,
+            ForcePathStyle,
+            __SkipField__,
+        }
+        impl<'de> serde::Deserialize<'de> for GeneratedField {
+            fn deserialize<D>(deserializer: D) -> std::result::Result<GeneratedField, D::Error>
+            where
+                D: serde::Deserializer<'de>,
+            {
+                struct GeneratedVisitor;
+
+                impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+                    type Value = GeneratedField;
+
+                    fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                        write!(formatter, "expected one of: {:?}", &FIELDS)
+                    }
+
+                    #[allow(unused_variables)]
+                    fn visit_str<E>(self, value: &str) -> std::result::Result<GeneratedField, E>
+                    where
+                        E: serde::de::Error,
+                    {
+                        match value {
+                            "accessKeyId" | "access_key_id" => Ok(GeneratedField::AccessKeyId),
+                            "secretAccessKey" | "secret_access_key" => Ok(GeneratedField::SecretAccessKey),
+                            "roleArn" | "role_arn" => Ok(GeneratedField::RoleArn),
+                            "region" => Ok(GeneratedField::Region),
+                            "endpoint" => Ok(GeneratedField::Endpoint),
+                            "forcePathStyle" | "force_path_style" => Ok(GeneratedField::ForcePathStyle),
+                            _ => Ok(GeneratedField::__SkipField__),
+                        }
+                    }
+                }
+                deserializer.deserialize_identifier(GeneratedVisitor)
+            }
+        }
+        struct GeneratedVisitor;
+        impl<'de> serde::de::Visitor<'de> for GeneratedVisitor {
+            type Value = S3ConnectionConfig;
+
+            fn expecting(&self, formatter: &mut std::fmt::Formatter<'_>) -> std::fmt::Result {
+                formatter.write_str("struct peerdb_peers.S3ConnectionConfig")
+            }
+
+            fn visit_map<V>(self, mut map: V) -> std::result::Result<S3ConnectionConfig, V::Error>
+                where
+                    V: serde::de::MapAccess<'de>,
+            {
+                let mut access_key_id__ = None;
+                let mut secret_access_key__ = None;
+                let mut role_arn__ = None;
+                let mut region__ = None;
+                let mut endpoint__ = None;
+                let mut force_path_style__ = None;
+                while let Some(k) = map.next_key()? {
+                    match k {
+                        GeneratedField::AccessKeyId => {
+                            if access_key_id__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("accessKeyId"));
+                            }
+                            access_key_id__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::SecretAccessKey => {
+                            if secret_access_key__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("secretAccessKey"));
+                            }
+                            secret_access_key__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::RoleArn => {
+                            if role_arn__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("roleArn"));
+                            }
+                            role_arn__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::Region => {
+                            if region__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("region"));
+                            }
+                            region__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::Endpoint => {
+                            if endpoint__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("endpoint"));
+                            }
+                            endpoint__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::ForcePathStyle => {
+                            if force_path_style__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("forcePathStyle"));
+                            }
+                            force_path_style__ = Some(map.next_value()?);
+                        }
+                        GeneratedField::__SkipField__ => {
+                            let _ = map.next_value::<serde::de::IgnoredAny>()?;
+                        }
+                    }
+                }
+                Ok(S3ConnectionConfig {
+                    access_key_id: access_key_id__.unwrap_or_default(),
+                    secret_access_key: secret_access_key__.unwrap_or_default(),
+                    role_arn: role_arn__.unwrap_or_default(),
+                    region: region__.unwrap_or_default(),
+                    endpoint: endpoint__.unwrap_or_default(),
+                    force_path_style: force_path_style__.unwrap_or_default(),
+                })
+            }
+        }
+        deserializer.deserialize_struct("peerdb_peers.S3ConnectionConfig", FIELDS, GeneratedVisitor)
+    }
+}
 impl serde::Serialize for SnowflakeConfig {
     #[allow(deprecated)]
     fn serialize<S>(&self, serializer: S) -> std::result::Result<S::Ok, S::Error>
@@ -1207,6 +1839,15 @@ impl serde::Serialize for SnowflakeConfig {
         if !self.s3_integration.is_empty() {
             len += 1;
         }
+        if self.staging_s3_connection.is_some() {
+            len += 1;
+        }
+        if self.stage_file_size_mb != 0 {
+            len += 1;
+        }
+        if self.stage_upload_parallelism != 0 {
+            len += 1;
+        }
         let mut struct_ser = serializer.serialize_struct("peerdb_peers.SnowflakeConfig", len)?;
         if !self.account_id.is_empty() {
             struct_ser.serialize_field("accountId", &self.account_id)?;
@@ -1232,6 +1873,15 @@ impl serde::Serialize for SnowflakeConfig {
         if !self.s3_integration.is_empty() {
             struct_ser.serialize_field("s3Integration", &self.s3_integration)?;
         }
+        if let Some(v) = self.staging_s3_connection.as_ref() {
+            struct_ser.serialize_field("stagingS3Connection", v)?;
+        }
+        if self.stage_file_size_mb != 0 {
+            struct_ser.serialize_field("stageFileSizeMb", &self.stage_file_size_mb)?;
+        }
+        if self.stage_upload_parallelism != 0 {
+            struct_ser.serialize_field("stageUploadParallelism", &self.stage_upload_parallelism)?;
+        }
         struct_ser.end()
     }
 }
@@ -1254,6 +1904,12 @@ impl<'de> serde::Deserialize<'de> for SnowflakeConfig {
             "queryTimeout",
             "s3_integration",
             "s3Integration",
+            "staging_s3_connection",
+            "stagingS3Connection",
+            "stage_file_size_mb",
+            "stageFileSizeMb",
+            "stage_upload_parallelism",
+            "stageUploadParallelism",
         ];
 
         #[allow(clippy::enum_variant_names)]
@@ -1266,6 +1922,9 @@ impl<'de> serde::Deserialize<'de> for SnowflakeConfig {
             Role,
             QueryTimeout,
             S3Integration,
+            StagingS3Connection,
+            StageFileSizeMb,
+            StageUploadParallelism,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -1296,6 +1955,9 @@ impl<'de> serde::Deserialize<'de> for SnowflakeConfig {
                             "role" => Ok(GeneratedField::Role),
                             "queryTimeout" | "query_timeout" => Ok(GeneratedField::QueryTimeout),
                             "s3Integration" | "s3_integration" => Ok(GeneratedField::S3Integration),
+                            "stagingS3Connection" | "staging_s3_connection" => Ok(GeneratedField::StagingS3Connection),
+                            "stageFileSizeMb" | "stage_file_size_mb" => Ok(GeneratedField::StageFileSizeMb),
+                            "stageUploadParallelism" | "stage_upload_parallelism" => Ok(GeneratedField::StageUploadParallelism),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -1323,6 +1985,9 @@ impl<'de> serde::Deserialize<'de> for SnowflakeConfig {
                 let mut role__ = None;
                 let mut query_timeout__ = None;
                 let mut s3_integration__ = None;
+                let mut staging_s3_connection__ = None;
+                let mut stage_file_size_mb__ = None;
+                let mut stage_upload_parallelism__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
                         GeneratedField::AccountId => {
@@ -1375,6 +2040,28 @@ impl<'de> serde::Deserialize<'de> for SnowflakeConfig {
                             }
                             s3_integration__ = Some(map.next_value()?);
                         }
+                        GeneratedField::StagingS3Connection => {
+                            if staging_s3_connection__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("stagingS3Connection"));
+                            }
+                            staging_s3_connection__ = map.next_value()?;
+                        }
+                        GeneratedField::StageFileSizeMb => {
+                            if stage_file_size_mb__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("stageFileSizeMb"));
+                            }
+                            stage_file_size_mb__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
+                        GeneratedField::StageUploadParallelism => {
+                            if stage_upload_parallelism__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("stageUploadParallelism"));
+                            }
+                            stage_upload_parallelism__ = 
+                                Some(map.next_value::<::pbjson::private::NumberDeserialize<_>>()?.0)
+                            ;
+                        }
                         GeneratedField::__SkipField__ => {
                             let _ = map.next_value::<serde::de::IgnoredAny>()?;
                         }
@@ -1389,6 +2076,9 @@ impl<'de> serde::Deserialize<'de> for SnowflakeConfig {
                     role: role__.unwrap_or_default(),
                     query_timeout: query_timeout__.unwrap_or_default(),
                     s3_integration: s3_integration__.unwrap_or_default(),
+                    staging_s3_connection: staging_s3_connection__,
+                    stage_file_size_mb: stage_file_size_mb__.unwrap_or_default(),
+                    stage_upload_parallelism: stage_upload_parallelism__.unwrap_or_default(),
                 })
             }
         }
@@ -34,6 +34,8 @@ pub struct ShutdownRequest {
     pub source_peer: ::core::option::Option<super::peerdb_peers::Peer>,
     #[prost(message, optional, tag="4")]
     pub destination_peer: ::core::option::Option<super::peerdb_peers::Peer>,
+    #[prost(message, repeated, tag="5")]
+    pub additional_destination_peers: ::prost::alloc::vec::Vec<super::peerdb_peers::Peer>,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -403,6 +403,9 @@ impl serde::Serialize for ShutdownRequest {
         if self.destination_peer.is_some() {
             len += 1;
         }
+        if !self.additional_destination_peers.is_empty() {
+            len += 1;
+        }
         let mut struct_ser = serializer.serialize_struct("peerdb_route.ShutdownRequest", len)?;
         if !self.workflow_id.is_empty() {
             struct_ser.serialize_field("workflowId", &self.workflow_id)?;
@@ -416,6 +419,9 @@ impl serde::Serialize for ShutdownRequest {
         if let Some(v) = self.destination_peer.as_ref() {
             struct_ser.serialize_field("destinationPeer", v)?;
         }
+        if !self.additional_destination_peers.is_empty() {
+            struct_ser.serialize_field("additionalDestinationPeers", &self.additional_destination_peers)?;
+        }
         struct_ser.end()
     }
 }
@@ -434,6 +440,8 @@ impl<'de> serde::Deserialize<'de> for ShutdownRequest {
             "sourcePeer",
             "destination_peer",
             "destinationPeer",
+            "additional_destination_peers",
+            "additionalDestinationPeers",
         ];
 
         #[allow(clippy::enum_variant_names)]
@@ -442,6 +450,7 @@ impl<'de> serde::Deserialize<'de> for ShutdownRequest {
             FlowJobName,
             SourcePeer,
             DestinationPeer,
+            AdditionalDestinationPeers,
             __SkipField__,
         }
         impl<'de> serde::Deserialize<'de> for GeneratedField {
@@ -468,6 +477,7 @@ impl<'de> serde::Deserialize<'de> for ShutdownRequest {
                             "flowJobName" | "flow_job_name" => Ok(GeneratedField::FlowJobName),
                             "sourcePeer" | "source_peer" => Ok(GeneratedField::SourcePeer),
                             "destinationPeer" | "destination_peer" => Ok(GeneratedField::DestinationPeer),
+                            "additionalDestinationPeers" | "additional_destination_peers" => Ok(GeneratedField::AdditionalDestinationPeers),
                             _ => Ok(GeneratedField::__SkipField__),
                         }
                     }
@@ -491,6 +501,7 @@ impl<'de> serde::Deserialize<'de> for ShutdownRequest {
                 let mut flow_job_name__ = None;
                 let mut source_peer__ = None;
                 let mut destination_peer__ = None;
+                let mut additional_destination_peers__ = None;
                 while let Some(k) = map.next_key()? {
                     match k {
                         GeneratedField::WorkflowId => {
@@ -517,6 +528,12 @@ impl<'de> serde::Deserialize<'de> for ShutdownRequest {
                             }
                             destination_peer__ = map.next_value()?;
                         }
+                        GeneratedField::AdditionalDestinationPeers => {
+                            if additional_destination_peers__.is_some() {
+                                return Err(serde::de::Error::duplicate_field("additionalDestinationPeers"));
+                            }
+                            additional_destination_peers__ = Some(map.next_value()?);
+                        }
                         GeneratedField::__SkipField__ => {
                             let _ = map.next_value::<serde::de::IgnoredAny>()?;
                         }
@@ -527,6 +544,7 @@ impl<'de> serde::Deserialize<'de> for ShutdownRequest {
                     flow_job_name: flow_job_name__.unwrap_or_default(),
                     source_peer: source_peer__,
                     destination_peer: destination_peer__,
+                    additional_destination_peers: additional_destination_peers__.unwrap_or_default(),
                 })
             }
         }
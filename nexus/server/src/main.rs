@@ -237,10 +237,23 @@ impl NexusBackend {
                                 }))
                             })?;
 
+                    let mut additional_dst_peers = vec![];
+                    for peer_name in &flow_job.additional_target_peers {
+                        let peer = catalog.get_peer(peer_name).await.map_err(|err| {
+                            PgWireError::ApiError(Box::new(PgError::Internal {
+                                err_msg: format!(
+                                    "unable to get additional destination peer {}: {:?}",
+                                    peer_name, err
+                                ),
+                            }))
+                        })?;
+                        additional_dst_peers.push(peer);
+                    }
+
                     // make a request to the flow service to start the job.
                     let mut flow_handler = self.flow_handler.as_ref().unwrap().lock().await;
                     let workflow_id = flow_handler
-                        .start_peer_flow_job(&flow_job, src_peer, dst_peer)
+                        .start_peer_flow_job(&flow_job, src_peer, dst_peer, additional_dst_peers)
                         .await
                         .map_err(|err| {
                             PgWireError::ApiError(Box::new(PgError::Internal {